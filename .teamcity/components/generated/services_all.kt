@@ -27,6 +27,7 @@ val services = mapOf(
     "batch" to ServiceSpec("Batch", vpcLock = true),
     "bedrock" to ServiceSpec("Amazon Bedrock"),
     "bedrockagent" to ServiceSpec("Agents for Amazon Bedrock"),
+    "braket" to ServiceSpec("Braket"),
     "budgets" to ServiceSpec("Web Services Budgets"),
     "ce" to ServiceSpec("CE (Cost Explorer)"),
     "chime" to ServiceSpec("Chime"),
@@ -136,6 +137,7 @@ val services = mapOf(
     "lookoutmetrics" to ServiceSpec("Lookout for Metrics"),
     "m2" to ServiceSpec("Mainframe Modernization"),
     "macie2" to ServiceSpec("Macie"),
+    "managedblockchain" to ServiceSpec("Managed Blockchain"),
     "mediaconnect" to ServiceSpec("Elemental MediaConnect"),
     "mediaconvert" to ServiceSpec("Elemental MediaConvert"),
     "medialive" to ServiceSpec("Elemental MediaLive"),
@@ -146,6 +148,7 @@ val services = mapOf(
     "mq" to ServiceSpec("MQ", vpcLock = true),
     "mwaa" to ServiceSpec("MWAA (Managed Workflows for Apache Airflow)", vpcLock = true),
     "neptune" to ServiceSpec("Neptune"),
+    "neptunegraph" to ServiceSpec("Neptune Analytics"),
     "networkfirewall" to ServiceSpec("Network Firewall", vpcLock = true),
     "networkmanager" to ServiceSpec("Network Manager", vpcLock = true),
     "oam" to ServiceSpec("CloudWatch Observability Access Manager"),
@@ -200,6 +203,7 @@ val services = mapOf(
     "shield" to ServiceSpec("Shield"),
     "signer" to ServiceSpec("Signer"),
     "simpledb" to ServiceSpec("SDB (SimpleDB)"),
+    "simspaceweaver" to ServiceSpec("SimSpace Weaver"),
     "sns" to ServiceSpec("SNS (Simple Notification)"),
     "sqs" to ServiceSpec("SQS (Simple Queue)"),
     "ssm" to ServiceSpec("SSM (Systems Manager)", vpcLock = true),
@@ -212,9 +216,11 @@ val services = mapOf(
     "sts" to ServiceSpec("STS (Security Token)"),
     "swf" to ServiceSpec("SWF (Simple Workflow)"),
     "synthetics" to ServiceSpec("CloudWatch Synthetics", parallelismOverride = 10),
+    "timestreaminfluxdb" to ServiceSpec("Timestream for InfluxDB"),
     "timestreamwrite" to ServiceSpec("Timestream Write"),
     "transcribe" to ServiceSpec("Transcribe"),
     "transfer" to ServiceSpec("Transfer Family", vpcLock = true),
+    "translate" to ServiceSpec("Translate"),
     "verifiedpermissions" to ServiceSpec("Verified Permissions"),
     "vpclattice" to ServiceSpec("VPC Lattice"),
     "waf" to ServiceSpec("WAF Classic", regionOverride = "us-east-1"),
@@ -6,8 +6,8 @@ require (
 	github.com/ProtonMail/go-crypto v0.0.0-20230923063757-afb1ddc0824c
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.23.0
-	github.com/aws/aws-sdk-go v1.50.25
-	github.com/aws/aws-sdk-go-v2 v1.25.2
+	github.com/aws/aws-sdk-go v1.54.19
+	github.com/aws/aws-sdk-go-v2 v1.32.7
 	github.com/aws/aws-sdk-go-v2/config v1.27.4
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.15.2
 	github.com/aws/aws-sdk-go-v2/feature/s3/manager v1.16.6
@@ -54,14 +54,14 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/costoptimizationhub v1.4.1
 	github.com/aws/aws-sdk-go-v2/service/customerprofiles v1.36.1
 	github.com/aws/aws-sdk-go-v2/service/directoryservice v1.24.1
-	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.8.1
+	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.14.5
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.30.1
-	github.com/aws/aws-sdk-go-v2/service/ec2 v1.149.1
-	github.com/aws/aws-sdk-go-v2/service/ecr v1.27.1
+	github.com/aws/aws-sdk-go-v2/service/ec2 v1.180.0
+	github.com/aws/aws-sdk-go-v2/service/ecr v1.36.8
 	github.com/aws/aws-sdk-go-v2/service/ecs v1.41.1
-	github.com/aws/aws-sdk-go-v2/service/eks v1.40.1
+	github.com/aws/aws-sdk-go-v2/service/eks v1.56.1
 	github.com/aws/aws-sdk-go-v2/service/elasticache v1.37.1
-	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2 v1.30.1
+	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2 v1.43.3
 	github.com/aws/aws-sdk-go-v2/service/emr v1.39.1
 	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.17.1
 	github.com/aws/aws-sdk-go-v2/service/evidently v1.19.1
@@ -79,7 +79,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/kendra v1.49.1
 	github.com/aws/aws-sdk-go-v2/service/keyspaces v1.10.1
 	github.com/aws/aws-sdk-go-v2/service/kinesis v1.27.1
-	github.com/aws/aws-sdk-go-v2/service/lambda v1.53.1
+	github.com/aws/aws-sdk-go-v2/service/lambda v1.69.3
 	github.com/aws/aws-sdk-go-v2/service/launchwizard v1.3.1
 	github.com/aws/aws-sdk-go-v2/service/lexmodelsv2 v1.42.1
 	github.com/aws/aws-sdk-go-v2/service/lightsail v1.36.1
@@ -91,8 +91,10 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/mediapackage v1.30.1
 	github.com/aws/aws-sdk-go-v2/service/mediapackagev2 v1.9.1
 	github.com/aws/aws-sdk-go-v2/service/mediastore v1.20.1
+	github.com/aws/aws-sdk-go-v2/service/memorydb v1.25.1
 	github.com/aws/aws-sdk-go-v2/service/mq v1.22.1
 	github.com/aws/aws-sdk-go-v2/service/mwaa v1.26.1
+	github.com/aws/aws-sdk-go-v2/service/neptunegraph v1.15.2
 	github.com/aws/aws-sdk-go-v2/service/oam v1.9.1
 	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.11.1
 	github.com/aws/aws-sdk-go-v2/service/osis v1.8.1
@@ -113,8 +115,10 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi v1.21.1
 	github.com/aws/aws-sdk-go-v2/service/rolesanywhere v1.8.1
 	github.com/aws/aws-sdk-go-v2/service/route53domains v1.23.1
+	github.com/aws/aws-sdk-go-v2/service/route53resolver v1.34.3
 	github.com/aws/aws-sdk-go-v2/service/s3 v1.51.1
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.44.1
+	github.com/aws/aws-sdk-go-v2/service/sagemaker v1.170.0
 	github.com/aws/aws-sdk-go-v2/service/scheduler v1.8.1
 	github.com/aws/aws-sdk-go-v2/service/secretsmanager v1.28.1
 	github.com/aws/aws-sdk-go-v2/service/securityhub v1.46.1
@@ -135,6 +139,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/sts v1.28.1
 	github.com/aws/aws-sdk-go-v2/service/swf v1.22.1
 	github.com/aws/aws-sdk-go-v2/service/synthetics v1.24.1
+	github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb v1.7.1
 	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.25.1
 	github.com/aws/aws-sdk-go-v2/service/transcribe v1.36.1
 	github.com/aws/aws-sdk-go-v2/service/transfer v1.43.1
@@ -143,7 +148,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/wellarchitected v1.29.1
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.38.1
 	github.com/aws/aws-sdk-go-v2/service/xray v1.25.1
-	github.com/aws/smithy-go v1.20.1
+	github.com/aws/smithy-go v1.22.1
 	github.com/beevik/etree v1.3.0
 	github.com/davecgh/go-spew v1.1.1
 	github.com/gertd/go-pluralize v0.2.1
@@ -191,17 +196,17 @@ require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
-	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.1 // indirect
+	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.7 // indirect
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.4 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.2 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.2 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.26 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.26 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.0 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.2 // indirect
 	github.com/aws/aws-sdk-go-v2/service/iam v1.30.1 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.11.1 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.11.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.3.2 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.9.2 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.11.2 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.11.20 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.17.2 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.23.1 // indirect
 	github.com/bgentry/speakeasy v0.1.0 // indirect
@@ -223,6 +223,7 @@ variable "service_labels" {
     "mturk",
     "mwaa",
     "neptune",
+    "neptunegraph",
     "networkfirewall",
     "networkmanager",
     "nimble",
@@ -297,6 +298,7 @@ variable "service_labels" {
     "shield",
     "signer",
     "simpledb",
+    "simspaceweaver",
     "sms",
     "snowball",
     "snowdevicemanagement",
@@ -315,6 +317,7 @@ variable "service_labels" {
     "swf",
     "synthetics",
     "textract",
+    "timestreaminfluxdb",
     "timestreamquery",
     "timestreamwrite",
     "transcribe",
@@ -10,6 +10,7 @@ import (
 
 	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	tffwdiag "github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -112,8 +113,8 @@ func LogNotFoundRemoveState(service, action, resource, id string) {
 }
 
 func DiagErrorFramework(service, action, resource, id string, gotError error) fwdiag.Diagnostic {
-	return fwdiag.NewErrorDiagnostic(
+	return tffwdiag.NewErrorDiagnostic(
 		ProblemStandardMessage(service, action, resource, id, nil),
-		gotError.Error(),
+		gotError,
 	)
 }
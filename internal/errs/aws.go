@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+)
+
+// APIErrorMetadata describes the AWS API call that produced an error.
+type APIErrorMetadata struct {
+	Operation string
+	Code      string
+	RequestID string
+}
+
+// serviceRequestIDer is implemented by AWS SDK for Go v2 HTTP response errors.
+type serviceRequestIDer interface {
+	ServiceRequestID() string
+}
+
+// NewAPIErrorMetadata returns the AWS operation name, error code and request ID carried by err.
+// Both AWS SDK for Go v1 and v2 errors are supported. The AWS SDK for Go v1 does not record
+// the operation name in its errors. The second return value is false if err is not an AWS API error.
+func NewAPIErrorMetadata(err error) (APIErrorMetadata, bool) {
+	var m APIErrorMetadata
+
+	if err == nil {
+		return m, false
+	}
+
+	// AWS SDK for Go v2.
+	if v, ok := As[*smithy.OperationError](err); ok {
+		m.Operation = fmt.Sprintf("%s: %s", v.Service(), v.Operation())
+	}
+	if v, ok := As[smithy.APIError](err); ok {
+		m.Code = v.ErrorCode()
+	}
+	var requestIDer serviceRequestIDer
+	if errors.As(err, &requestIDer) {
+		m.RequestID = requestIDer.ServiceRequestID()
+	}
+
+	// AWS SDK for Go v1.
+	if m.Code == "" {
+		if v, ok := As[awserr.Error](err); ok {
+			m.Code = v.Code()
+		}
+	}
+	if m.RequestID == "" {
+		if v, ok := As[awserr.RequestFailure](err); ok {
+			m.RequestID = v.RequestID()
+		}
+	}
+
+	return m, m != APIErrorMetadata{}
+}
+
+// String returns the non-empty metadata fields, one per line.
+func (m APIErrorMetadata) String() string {
+	var lines []string
+
+	if m.Operation != "" {
+		lines = append(lines, "Operation: "+m.Operation)
+	}
+	if m.Code != "" {
+		lines = append(lines, "Error Code: "+m.Code)
+	}
+	if m.RequestID != "" {
+		lines = append(lines, "Request ID: "+m.RequestID)
+	}
+
+	return strings.Join(lines, "\n")
+}
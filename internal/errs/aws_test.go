@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestNewAPIErrorMetadata(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected errs.APIErrorMetadata
+		ok       bool
+	}{
+		"nil error": {},
+		"non-AWS error": {
+			err: errors.New("test"),
+		},
+		"AWS SDK v1 error": {
+			err:      awserr.New("InvalidParameterValue", "test", nil),
+			expected: errs.APIErrorMetadata{Code: "InvalidParameterValue"},
+			ok:       true,
+		},
+		"AWS SDK v1 request failure": {
+			err:      fmt.Errorf("creating: %w", awserr.NewRequestFailure(awserr.New("NotFoundException", "test", nil), http.StatusNotFound, "abc-123")),
+			expected: errs.APIErrorMetadata{Code: "NotFoundException", RequestID: "abc-123"},
+			ok:       true,
+		},
+		"AWS SDK v2 operation error": {
+			err: fmt.Errorf("creating: %w", &smithy.OperationError{
+				ServiceID:     "mq",
+				OperationName: "CreateBroker",
+				Err: &awshttp.ResponseError{
+					ResponseError: &smithyhttp.ResponseError{
+						Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusBadRequest}},
+						Err:      &smithy.GenericAPIError{Code: "BadRequestException", Message: "test"},
+					},
+					RequestID: "def-456",
+				},
+			}),
+			expected: errs.APIErrorMetadata{Operation: "mq: CreateBroker", Code: "BadRequestException", RequestID: "def-456"},
+			ok:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := errs.NewAPIErrorMetadata(testCase.err)
+
+			if got, want := ok, testCase.ok; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("metadata = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestAPIErrorMetadataString(t *testing.T) {
+	t.Parallel()
+
+	m := errs.APIErrorMetadata{Operation: "mq: CreateBroker", Code: "BadRequestException", RequestID: "def-456"}
+
+	if got, want := m.String(), "Operation: mq: CreateBroker\nError Code: BadRequestException\nRequest ID: def-456"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	m = errs.APIErrorMetadata{Code: "NotFoundException"}
+
+	if got, want := m.String(), "Error Code: NotFoundException"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
 // DiagnosticsError returns an error containing all Diagnostic with SeverityError
@@ -38,6 +39,18 @@ func DiagnosticString(d diag.Diagnostic) string {
 	return buf.String()
 }
 
+// NewErrorDiagnostic returns an error diagnostic with the specified summary and err as its detail.
+// If err is an AWS API error, the AWS operation, error code and request ID are appended to the detail.
+func NewErrorDiagnostic(summary string, err error) diag.Diagnostic {
+	detail := err.Error()
+
+	if m, ok := errs.NewAPIErrorMetadata(err); ok {
+		detail = fmt.Sprintf("%s\n\n%s", detail, m)
+	}
+
+	return diag.NewErrorDiagnostic(summary, detail)
+}
+
 func NewResourceNotFoundWarningDiagnostic(err error) diag.Diagnostic {
 	return diag.NewWarningDiagnostic(
 		"AWS resource not found during refresh",
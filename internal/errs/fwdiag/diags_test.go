@@ -4,8 +4,12 @@
 package fwdiag_test
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 )
@@ -69,3 +73,40 @@ func TestDiagnosticsError(t *testing.T) {
 		})
 	}
 }
+
+func TestNewErrorDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err            error
+		expectedDetail string
+	}{
+		"non-AWS error": {
+			err:            errors.New("test"),
+			expectedDetail: "test",
+		},
+		"AWS API error": {
+			err:            fmt.Errorf("creating: %w", awserr.NewRequestFailure(awserr.New("NotFoundException", "test", nil), http.StatusNotFound, "abc-123")),
+			expectedDetail: "creating: NotFoundException: test\n\tstatus code: 404, request id: abc-123\n\nError Code: NotFoundException\nRequest ID: abc-123",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwdiag.NewErrorDiagnostic("summary", testCase.err)
+
+			if got, want := got.Severity(), diag.SeverityError; got != want {
+				t.Errorf("Severity = %s, want %s", got, want)
+			}
+			if got, want := got.Summary(), "summary"; got != want {
+				t.Errorf("Summary = %q, want %q", got, want)
+			}
+			if got, want := got.Detail(), testCase.expectedDetail; got != want {
+				t.Errorf("Detail = %q, want %q", got, want)
+			}
+		})
+	}
+}
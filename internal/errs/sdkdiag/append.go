@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
@@ -17,15 +18,41 @@ func AppendWarningf(diags diag.Diagnostics, format string, a ...any) diag.Diagno
 	})
 }
 
+// AppendErrorf appends an error diagnostic with the formatted summary.
+// If any of the arguments is an AWS API error, the AWS operation, error code and request ID are added to the diagnostic's detail.
 func AppendErrorf(diags diag.Diagnostics, format string, a ...any) diag.Diagnostics {
-	return append(diags, diag.Errorf(format, a...)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
+	return append(diags, withAPIErrorDetail(diag.Errorf(format, a...), a...)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
 }
 
+// AppendFromErr appends an error diagnostic for err.
+// If err is an AWS API error, the AWS operation, error code and request ID are added to the diagnostic's detail.
 func AppendFromErr(diags diag.Diagnostics, err error) diag.Diagnostics {
 	if err == nil {
 		return diags
 	}
-	return append(diags, diag.FromErr(err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
+	return append(diags, withAPIErrorDetail(diag.FromErr(err), err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
+}
+
+func withAPIErrorDetail(diags diag.Diagnostics, a ...any) diag.Diagnostics {
+	for _, v := range a {
+		err, ok := v.(error)
+		if !ok {
+			continue
+		}
+
+		if m, ok := errs.NewAPIErrorMetadata(err); ok {
+			for i := range diags {
+				if diags[i].Detail == "" {
+					diags[i].Detail = m.String()
+				} else {
+					diags[i].Detail = fmt.Sprintf("%s\n\n%s", diags[i].Detail, m)
+				}
+			}
+			break
+		}
+	}
+
+	return diags
 }
 
 func WrapDiagsf(orig diag.Diagnostics, format string, a ...any) diag.Diagnostics {
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkdiag_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func TestAppendFromErr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags          diag.Diagnostics
+		err            error
+		expectedDetail string
+	}{
+		"non-AWS error": {
+			err: errors.New("test"),
+		},
+		"AWS API error": {
+			err:            fmt.Errorf("creating: %w", awserr.NewRequestFailure(awserr.New("NotFoundException", "test", nil), http.StatusNotFound, "abc-123")),
+			expectedDetail: "Error Code: NotFoundException\nRequest ID: abc-123",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := sdkdiag.AppendFromErr(testCase.diags, testCase.err)
+
+			if got, want := len(got), 1; got != want {
+				t.Fatalf("length = %d, want %d", got, want)
+			}
+			if got, want := got[0].Summary, testCase.err.Error(); got != want {
+				t.Errorf("Summary = %q, want %q", got, want)
+			}
+			if got, want := got[0].Detail, testCase.expectedDetail; got != want {
+				t.Errorf("Detail = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAppendErrorf(t *testing.T) {
+	t.Parallel()
+
+	err := awserr.NewRequestFailure(awserr.New("ValidationException", "test", nil), http.StatusBadRequest, "abc-123")
+	got := sdkdiag.AppendErrorf(nil, "creating %s: %s", "thing", err)
+
+	if got, want := len(got), 1; got != want {
+		t.Fatalf("length = %d, want %d", got, want)
+	}
+	if got, want := got[0].Summary, fmt.Sprintf("creating thing: %s", err); got != want {
+		t.Errorf("Summary = %q, want %q", got, want)
+	}
+	if got, want := got[0].Detail, "Error Code: ValidationException\nRequest ID: abc-123"; got != want {
+		t.Errorf("Detail = %q, want %q", got, want)
+	}
+}
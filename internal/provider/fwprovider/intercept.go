@@ -14,6 +14,7 @@ import (
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -435,7 +436,7 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 					}
 
 					if err != nil {
-						diags.AddError(fmt.Sprintf("listing tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
+						diags.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("listing tags for %s %s (%s)", serviceName, resourceName, identifier), err))
 
 						return ctx, diags
 					}
@@ -562,7 +563,7 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 					}
 
 					if err != nil {
-						diags.AddError(fmt.Sprintf("updating tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
+						diags.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating tags for %s %s (%s)", serviceName, resourceName, identifier), err))
 
 						return ctx, diags
 					}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// applyResourceHints returns an interceptor that warns after an update changes a hinted attribute.
+func applyResourceHints(r *schema.Resource, hints []*types.ServicePackageResourceHint) (interceptor, error) {
+	for _, hint := range hints {
+		if _, ok := r.SchemaMap()[hint.Attribute]; !ok {
+			return nil, fmt.Errorf("hint attribute %q: not found", hint.Attribute)
+		}
+	}
+
+	if len(hints) == 0 {
+		return nil, nil
+	}
+
+	return resourceHintsInterceptor{hints: hints}, nil
+}
+
+// resourceHintsInterceptor emits hints for attributes changed by an update.
+type resourceHintsInterceptor struct {
+	hints []*types.ServicePackageResourceHint
+}
+
+func (r resourceHintsInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != After || why != Update {
+		return ctx, diags
+	}
+
+	for _, hint := range r.hints {
+		if d.HasChange(hint.Attribute) {
+			diags = append(diags, hintDiagnostic(hint, cty.GetAttrPath(hint.Attribute)))
+		}
+	}
+
+	return ctx, diags
+}
+
+func hintDiagnostic(hint *types.ServicePackageResourceHint, path cty.Path) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       hint.Summary,
+		Detail:        hint.Detail,
+		AttributePath: path,
+	}
+}
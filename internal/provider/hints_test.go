@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestApplyResourceHints_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*types.ServicePackageResourceHint{
+		"not found": {Attribute: "name"},
+		"nested":    {Attribute: "rule.value"},
+	}
+
+	for name, hint := range testCases {
+		hint := hint
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rule": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"value": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			}
+
+			if _, err := applyResourceHints(r, []*types.ServicePackageResourceHint{hint}); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+type changedResourceData struct {
+	resourceData
+	changed []string
+}
+
+func (d *changedResourceData) HasChange(key string) bool {
+	return slices.Contains(d.changed, key)
+}
+
+func TestApplyResourceHints(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"egress": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ingress": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+	hints := []*types.ServicePackageResourceHint{
+		{Attribute: "egress", Summary: "egress summary", Detail: "egress detail"},
+		{Attribute: "ingress", Summary: "ingress summary", Detail: "ingress detail"},
+	}
+
+	interceptor, err := applyResourceHints(r, hints)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if interceptor == nil {
+		t.Fatal("expected interceptor")
+	}
+
+	ctx := context.Background()
+	d := &changedResourceData{changed: []string{"ingress"}}
+
+	for _, why := range []why{Create, Read, Delete} {
+		if _, diags := interceptor.run(ctx, d, nil, After, why, nil); len(diags) != 0 {
+			t.Errorf("got %d diagnostics for %v, want 0", len(diags), why)
+		}
+	}
+	if _, diags := interceptor.run(ctx, d, nil, OnError, Update, nil); len(diags) != 0 {
+		t.Errorf("got %d diagnostics on error, want 0", len(diags))
+	}
+
+	_, diags := interceptor.run(ctx, d, nil, After, Update, nil)
+	if got, want := len(diags), 1; got != want {
+		t.Fatalf("got %d diagnostics, want %d", got, want)
+	}
+	if got, want := diags[0].Severity, diag.Warning; got != want {
+		t.Errorf("got severity %v, want %v", got, want)
+	}
+	if got, want := diags[0].Summary, "ingress summary"; got != want {
+		t.Errorf("got summary %q, want %q", got, want)
+	}
+	if got, want := diags[0].AttributePath, cty.GetAttrPath("ingress"); !got.Equals(want) {
+		t.Errorf("got path %#v, want %#v", got, want)
+	}
+}
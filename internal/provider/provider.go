@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -334,6 +335,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			provider.DataSourcesMap[typeName] = r
 		}
 
+		var resourceHints map[string][]*types.ServicePackageResourceHint
+		if v, ok := sp.(interface {
+			SDKResourceHints(context.Context) map[string][]*types.ServicePackageResourceHint
+		}); ok {
+			resourceHints = v.SDKResourceHints(ctx)
+		}
+
 		for _, v := range sp.SDKResources(ctx) {
 			v := v
 			typeName := v.TypeName
@@ -410,6 +418,23 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if hints := resourceHints[typeName]; len(hints) > 0 {
+				interceptor, err := applyResourceHints(r, hints)
+
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", typeName, err))
+					continue
+				}
+
+				if interceptor != nil {
+					interceptors = append(interceptors, interceptorItem{
+						when:        After,
+						why:         Update,
+						interceptor: interceptor,
+					})
+				}
+			}
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appintegrations
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_appintegrations_application", name="Application")
+// @Tags(identifierAttribute="arn")
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_url_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_url": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1000),
+									},
+									"approved_origins": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MinItems: 1,
+										MaxItems: 50,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 267),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z\/\._ \-]+$`), "should be not be more than 255 alphanumeric, forward slashes, dots, spaces, underscores, or hyphen characters"),
+				),
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z\/\._\-]+$`), "should be not be more than 255 alphanumeric, forward slashes, dots, underscores, or hyphen characters"),
+				),
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 150,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &appintegrationsservice.CreateApplicationInput{
+		ApplicationSourceConfig: expandApplicationSourceConfig(d.Get("application_source_config").([]interface{})),
+		ClientToken:             aws.String(id.UniqueId()),
+		Name:                    aws.String(name),
+		Namespace:               aws.String(d.Get("namespace").(string)),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("permissions"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	output, err := conn.CreateApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppIntegrations Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn(ctx)
+
+	output, err := findApplicationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppIntegrations Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppIntegrations Application (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("application_source_config", flattenApplicationSourceConfig(output.ApplicationSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting application_source_config: %s", err)
+	}
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+	d.Set("namespace", output.Namespace)
+	d.Set("permissions", aws.StringValueSlice(output.Permissions))
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &appintegrationsservice.UpdateApplicationInput{
+			ApplicationSourceConfig: expandApplicationSourceConfig(d.Get("application_source_config").([]interface{})),
+			Arn:                     aws.String(d.Id()),
+			Name:                    aws.String(d.Get("name").(string)),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("permissions") {
+			if v := d.Get("permissions").(*schema.Set); v.Len() > 0 {
+				input.Permissions = flex.ExpandStringSet(v)
+			} else {
+				input.Permissions = []*string{}
+			}
+		}
+
+		_, err := conn.UpdateApplicationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating AppIntegrations Application (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn(ctx)
+
+	log.Printf("[INFO] Deleting AppIntegrations Application: %s", d.Id())
+	_, err := conn.DeleteApplicationWithContext(ctx, &appintegrationsservice.DeleteApplicationInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appintegrationsservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppIntegrations Application (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findApplicationByARN(ctx context.Context, conn *appintegrationsservice.AppIntegrationsService, arn string) (*appintegrationsservice.GetApplicationOutput, error) {
+	input := &appintegrationsservice.GetApplicationInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetApplicationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appintegrationsservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandApplicationSourceConfig(tfList []interface{}) *appintegrationsservice.ApplicationSourceConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	apiObject := &appintegrationsservice.ApplicationSourceConfig{}
+
+	if v, ok := tfMap["external_url_config"].([]interface{}); ok {
+		apiObject.ExternalUrlConfig = expandExternalURLConfig(v)
+	}
+
+	return apiObject
+}
+
+func expandExternalURLConfig(tfList []interface{}) *appintegrationsservice.ExternalUrlConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	apiObject := &appintegrationsservice.ExternalUrlConfig{
+		AccessUrl: aws.String(tfMap["access_url"].(string)),
+	}
+
+	if v, ok := tfMap["approved_origins"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ApprovedOrigins = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenApplicationSourceConfig(apiObject *appintegrationsservice.ApplicationSourceConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"external_url_config": flattenExternalURLConfig(apiObject.ExternalUrlConfig),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenExternalURLConfig(apiObject *appintegrationsservice.ExternalUrlConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"access_url":       aws.StringValue(apiObject.AccessUrl),
+		"approved_origins": aws.StringValueSlice(apiObject.ApprovedOrigins),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -926,10 +926,40 @@ func ResourceGroup() *schema.Resource {
 			launchTemplateCustomDiff("launch_template", "launch_template.0.name"),
 			launchTemplateCustomDiff("mixed_instances_policy", "mixed_instances_policy.0.launch_template.0.launch_template_specification.0.launch_template_name"),
 			launchTemplateCustomDiff("mixed_instances_policy", "mixed_instances_policy.0.launch_template.0.override"),
+			mixedInstancesPolicyOverrideCustomDiff,
 		),
 	}
 }
 
+// mixedInstancesPolicyOverrideCustomDiff checks that each mixed instances policy override
+// selects instance types either by instance_type or by instance_requirements, but not both.
+func mixedInstancesPolicyOverrideCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	const prefix = "mixed_instances_policy.0.launch_template.0.override"
+
+	overrides, ok := diff.Get(prefix).([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for i := range overrides {
+		instanceTypeKey := fmt.Sprintf("%s.%d.instance_type", prefix, i)
+		instanceRequirementsKey := fmt.Sprintf("%s.%d.instance_requirements", prefix, i)
+
+		if !diff.NewValueKnown(instanceTypeKey) || !diff.NewValueKnown(instanceRequirementsKey) {
+			continue
+		}
+
+		_, hasInstanceType := diff.GetOk(instanceTypeKey)
+		_, hasInstanceRequirements := diff.GetOk(instanceRequirementsKey)
+
+		if hasInstanceType && hasInstanceRequirements {
+			return fmt.Errorf("%s.%d: only one of instance_type or instance_requirements can be specified", prefix, i)
+		}
+	}
+
+	return nil
+}
+
 func instanceMaintenancePolicyDiffSupress(k, old, new string, d *schema.ResourceData) bool {
 	o, n := d.GetChange("instance_maintenance_policy")
 	oList := o.([]interface{})
@@ -1215,11 +1245,16 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if v, ok := d.GetOk("warm_pool"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		_, err := conn.PutWarmPoolWithContext(ctx, expandPutWarmPoolInput(d.Id(), v.([]interface{})[0].(map[string]interface{})))
+		input := expandPutWarmPoolInput(d.Id(), v.([]interface{})[0].(map[string]interface{}))
+		_, err := conn.PutWarmPoolWithContext(ctx, input)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating Auto Scaling Warm Pool (%s): %s", d.Id(), err)
 		}
+
+		if _, err := waitWarmPoolWarmed(ctx, conn, input, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Warm Pool (%s) create: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceGroupRead(ctx, d, meta)...)
@@ -1649,11 +1684,16 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		} else {
-			_, err := conn.PutWarmPoolWithContext(ctx, expandPutWarmPoolInput(d.Id(), w[0].(map[string]interface{})))
+			input := expandPutWarmPoolInput(d.Id(), w[0].(map[string]interface{}))
+			_, err := conn.PutWarmPoolWithContext(ctx, input)
 
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating Auto Scaling Warm Pool (%s): %s", d.Id(), err)
 			}
+
+			if _, err := waitWarmPoolWarmed(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Warm Pool (%s) update: %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -2557,6 +2597,35 @@ func statusWarmPool(ctx context.Context, conn *autoscaling.AutoScaling, name str
 	}
 }
 
+// statusWarmPoolWarmedInstanceCount returns the number of warm pool instances that have reached the specified pool state.
+func statusWarmPoolWarmedInstanceCount(ctx context.Context, conn *autoscaling.AutoScaling, name, poolState string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findWarmPool(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if status := aws.StringValue(output.WarmPoolConfiguration.Status); status != "" {
+			return output, status, nil
+		}
+
+		var count int
+
+		for _, v := range output.Instances {
+			if aws.StringValue(v.LifecycleState) == "Warmed:"+poolState {
+				count++
+			}
+		}
+
+		return output, strconv.Itoa(count), nil
+	}
+}
+
 func statusWarmPoolInstanceCount(ctx context.Context, conn *autoscaling.AutoScaling, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findWarmPool(ctx, conn, name)
@@ -2753,6 +2822,44 @@ func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.AutoScaling, nam
 	return nil, err
 }
 
+// waitWarmPoolWarmed waits until the number of warm pool instances in the requested pool state matches the warm pool size implied by input.
+func waitWarmPoolWarmed(ctx context.Context, conn *autoscaling.AutoScaling, input *autoscaling.PutWarmPoolInput, timeout time.Duration) (*autoscaling.DescribeWarmPoolOutput, error) {
+	name := aws.StringValue(input.AutoScalingGroupName)
+	group, err := FindGroupByName(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The warm pool size is the group's maximum prepared capacity (which defaults to the group's maximum size)
+	// less its desired capacity, but no smaller than the warm pool's minimum size.
+	maxGroupPreparedCapacity := aws.Int64Value(group.MaxSize)
+	if v := aws.Int64Value(input.MaxGroupPreparedCapacity); input.MaxGroupPreparedCapacity != nil && v != DefaultWarmPoolMaxGroupPreparedCapacity {
+		maxGroupPreparedCapacity = v
+	}
+	size := max(maxGroupPreparedCapacity-aws.Int64Value(group.DesiredCapacity), aws.Int64Value(input.MinSize), 0)
+
+	poolState := autoscaling.WarmPoolStateStopped
+	if v := aws.StringValue(input.PoolState); v != "" {
+		poolState = v
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Target:                    []string{strconv.FormatInt(size, 10)},
+		Refresh:                   statusWarmPoolWarmedInstanceCount(ctx, conn, name, poolState),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*autoscaling.DescribeWarmPoolOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitWarmPoolDrained(ctx context.Context, conn *autoscaling.AutoScaling, name string, timeout time.Duration) (*autoscaling.DescribeWarmPoolOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Target:  []string{"0"},
@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/backup"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbackup "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBackupRestoreTestingPlan_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v backup.RestoreTestingPlanForGet
+	rName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(7))
+	resourceName := "aws_backup_restore_testing_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestoreTestingPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreTestingPlanConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRestoreTestingPlanExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "backup", regexache.MustCompile(`restore-testing-plan:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_selection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_selection.0.algorithm", "LATEST_WITHIN_WINDOW"),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_selection.0.exclude_vaults.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_selection.0.include_vaults.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_selection.0.include_vaults.0", "*"),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_selection.0.recovery_point_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_selection.0.recovery_point_types.0", "SNAPSHOT"),
+					resource.TestCheckResourceAttrSet(resourceName, "recovery_point_selection.0.selection_window_days"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression", "cron(0 12 ? * * *)"),
+					resource.TestCheckResourceAttrSet(resourceName, "schedule_expression_timezone"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_window_hours"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBackupRestoreTestingPlan_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v backup.RestoreTestingPlanForGet
+	rName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(7))
+	resourceName := "aws_backup_restore_testing_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestoreTestingPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreTestingPlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreTestingPlanExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfbackup.ResourceRestoreTestingPlan(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBackupRestoreTestingPlan_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v backup.RestoreTestingPlanForGet
+	rName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(7))
+	resourceName := "aws_backup_restore_testing_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestoreTestingPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreTestingPlanConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreTestingPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRestoreTestingPlanConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreTestingPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccRestoreTestingPlanConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreTestingPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBackupRestoreTestingPlan_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v backup.RestoreTestingPlanForGet
+	rName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(7))
+	resourceName := "aws_backup_restore_testing_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestoreTestingPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreTestingPlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreTestingPlanExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccRestoreTestingPlanConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRestoreTestingPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_selection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_selection.0.algorithm", "RANDOM_WITHIN_WINDOW"),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_selection.0.exclude_vaults.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "recovery_point_selection.0.exclude_vaults.*", "aws_backup_vault.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_selection.0.recovery_point_types.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_selection.0.selection_window_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression", "cron(0 1 ? * * *)"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression_timezone", "America/New_York"),
+					resource.TestCheckResourceAttr(resourceName, "start_window_hours", "8"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRestoreTestingPlanDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_backup_restore_testing_plan" {
+				continue
+			}
+
+			_, err := tfbackup.FindRestoreTestingPlanByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Backup Restore Testing Plan %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRestoreTestingPlanExists(ctx context.Context, n string, v *backup.RestoreTestingPlanForGet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Backup Restore Testing Plan ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn(ctx)
+
+		output, err := tfbackup.FindRestoreTestingPlanByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRestoreTestingPlanConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_restore_testing_plan" "test" {
+  name = %[1]q
+
+  recovery_point_selection {
+    algorithm            = "LATEST_WITHIN_WINDOW"
+    include_vaults       = ["*"]
+    recovery_point_types = ["SNAPSHOT"]
+  }
+
+  schedule_expression = "cron(0 12 ? * * *)"
+}
+`, rName)
+}
+
+func testAccRestoreTestingPlanConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_restore_testing_plan" "test" {
+  name = %[1]q
+
+  recovery_point_selection {
+    algorithm            = "LATEST_WITHIN_WINDOW"
+    include_vaults       = ["*"]
+    recovery_point_types = ["SNAPSHOT"]
+  }
+
+  schedule_expression = "cron(0 12 ? * * *)"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccRestoreTestingPlanConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_restore_testing_plan" "test" {
+  name = %[1]q
+
+  recovery_point_selection {
+    algorithm            = "LATEST_WITHIN_WINDOW"
+    include_vaults       = ["*"]
+    recovery_point_types = ["SNAPSHOT"]
+  }
+
+  schedule_expression = "cron(0 12 ? * * *)"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccRestoreTestingPlanConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_backup_restore_testing_plan" "test" {
+  name = %[1]q
+
+  recovery_point_selection {
+    algorithm             = "RANDOM_WITHIN_WINDOW"
+    exclude_vaults        = [aws_backup_vault.test.arn]
+    include_vaults        = ["*"]
+    recovery_point_types  = ["CONTINUOUS", "SNAPSHOT"]
+    selection_window_days = 7
+  }
+
+  schedule_expression          = "cron(0 1 ? * * *)"
+  schedule_expression_timezone = "America/New_York"
+  start_window_hours           = 8
+}
+`, rName)
+}
@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/backup"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbackup "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBackupRestoreTestingSelection_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v backup.RestoreTestingSelectionForGet
+	rName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(7))
+	resourceName := "aws_backup_restore_testing_selection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestoreTestingSelectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreTestingSelectionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRestoreTestingSelectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_arns.0", "*"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_conditions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_type", "EBS"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore_testing_plan_name", "aws_backup_restore_testing_plan.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "validation_window_hours"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBackupRestoreTestingSelection_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v backup.RestoreTestingSelectionForGet
+	rName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(7))
+	resourceName := "aws_backup_restore_testing_selection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestoreTestingSelectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreTestingSelectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreTestingSelectionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfbackup.ResourceRestoreTestingSelection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBackupRestoreTestingSelection_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v backup.RestoreTestingSelectionForGet
+	rName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(7))
+	resourceName := "aws_backup_restore_testing_selection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestoreTestingSelectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreTestingSelectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreTestingSelectionExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccRestoreTestingSelectionConfig_conditions(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRestoreTestingSelectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_conditions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_conditions.0.string_equals.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "protected_resource_conditions.0.string_equals.*", map[string]string{
+						"key":   "aws:ResourceTag/backup",
+						"value": "true",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_conditions.0.string_not_equals.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "protected_resource_conditions.0.string_not_equals.*", map[string]string{
+						"key":   "aws:ResourceTag/environment",
+						"value": "production",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "validation_window_hours", "24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRestoreTestingSelectionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_backup_restore_testing_selection" {
+				continue
+			}
+
+			_, err := tfbackup.FindRestoreTestingSelectionByTwoPartKey(ctx, conn, rs.Primary.Attributes["restore_testing_plan_name"], rs.Primary.Attributes["name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Backup Restore Testing Selection %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRestoreTestingSelectionExists(ctx context.Context, n string, v *backup.RestoreTestingSelectionForGet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Backup Restore Testing Selection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn(ctx)
+
+		output, err := tfbackup.FindRestoreTestingSelectionByTwoPartKey(ctx, conn, rs.Primary.Attributes["restore_testing_plan_name"], rs.Primary.Attributes["name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRestoreTestingSelectionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "backup.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSBackupServiceRolePolicyForRestores"
+  role       = aws_iam_role.test.name
+}
+
+resource "aws_backup_restore_testing_plan" "test" {
+  name = %[1]q
+
+  recovery_point_selection {
+    algorithm            = "LATEST_WITHIN_WINDOW"
+    include_vaults       = ["*"]
+    recovery_point_types = ["SNAPSHOT"]
+  }
+
+  schedule_expression = "cron(0 12 ? * * *)"
+}
+`, rName)
+}
+
+func testAccRestoreTestingSelectionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRestoreTestingSelectionConfig_base(rName), fmt.Sprintf(`
+resource "aws_backup_restore_testing_selection" "test" {
+  name                      = %[1]q
+  restore_testing_plan_name = aws_backup_restore_testing_plan.test.name
+  iam_role_arn              = aws_iam_role.test.arn
+
+  protected_resource_type = "EBS"
+  protected_resource_arns = ["*"]
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccRestoreTestingSelectionConfig_conditions(rName string) string {
+	return acctest.ConfigCompose(testAccRestoreTestingSelectionConfig_base(rName), fmt.Sprintf(`
+resource "aws_backup_restore_testing_selection" "test" {
+  name                      = %[1]q
+  restore_testing_plan_name = aws_backup_restore_testing_plan.test.name
+  iam_role_arn              = aws_iam_role.test.arn
+
+  protected_resource_type = "EBS"
+  protected_resource_arns = ["*"]
+
+  protected_resource_conditions {
+    string_equals {
+      key   = "aws:ResourceTag/backup"
+      value = "true"
+    }
+
+    string_not_equals {
+      key   = "aws:ResourceTag/environment"
+      value = "production"
+    }
+  }
+
+  validation_window_hours = 24
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
@@ -82,6 +82,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceRestoreTestingPlan,
+			TypeName: "aws_backup_restore_testing_plan",
+			Name:     "Restore Testing Plan",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceRestoreTestingSelection,
+			TypeName: "aws_backup_restore_testing_selection",
+			Name:     "Restore Testing Selection",
+		},
 		{
 			Factory:  ResourceSelection,
 			TypeName: "aws_backup_selection",
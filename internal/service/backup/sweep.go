@@ -26,6 +26,19 @@ func RegisterSweepers() {
 		F:    sweepReportPlan,
 	})
 
+	resource.AddTestSweepers("aws_backup_restore_testing_plan", &resource.Sweeper{
+		Name: "aws_backup_restore_testing_plan",
+		F:    sweepRestoreTestingPlans,
+		Dependencies: []string{
+			"aws_backup_restore_testing_selection",
+		},
+	})
+
+	resource.AddTestSweepers("aws_backup_restore_testing_selection", &resource.Sweeper{
+		Name: "aws_backup_restore_testing_selection",
+		F:    sweepRestoreTestingSelections,
+	})
+
 	resource.AddTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
 		Name: "aws_backup_vault_lock_configuration",
 		F:    sweepVaultLockConfiguration,
@@ -138,6 +151,111 @@ func sweepReportPlan(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepRestoreTestingPlans(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("Error getting client: %w", err)
+	}
+	conn := client.BackupConn(ctx)
+	input := &backup.ListRestoreTestingPlansInput{}
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListRestoreTestingPlansPagesWithContext(ctx, input, func(page *backup.ListRestoreTestingPlansOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, plan := range page.RestoreTestingPlans {
+			r := ResourceRestoreTestingPlan()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(plan.RestoreTestingPlanName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Backup Restore Testing Plans sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Backup Restore Testing Plans for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Backup Restore Testing Plans for %s: %w", region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func sweepRestoreTestingSelections(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("Error getting client: %w", err)
+	}
+	conn := client.BackupConn(ctx)
+	input := &backup.ListRestoreTestingPlansInput{}
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListRestoreTestingPlansPagesWithContext(ctx, input, func(page *backup.ListRestoreTestingPlansOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, plan := range page.RestoreTestingPlans {
+			planName := aws.StringValue(plan.RestoreTestingPlanName)
+			input := &backup.ListRestoreTestingSelectionsInput{
+				RestoreTestingPlanName: aws.String(planName),
+			}
+
+			err := conn.ListRestoreTestingSelectionsPagesWithContext(ctx, input, func(page *backup.ListRestoreTestingSelectionsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, selection := range page.RestoreTestingSelections {
+					r := ResourceRestoreTestingSelection()
+					d := r.Data(nil)
+					d.SetId(restoreTestingSelectionCreateResourceID(planName, aws.StringValue(selection.RestoreTestingSelectionName)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Backup Restore Testing Selections (%s) for %s: %w", planName, region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Backup Restore Testing Selections sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Backup Restore Testing Plans for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Backup Restore Testing Selections for %s: %w", region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
 func sweepVaultLockConfiguration(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
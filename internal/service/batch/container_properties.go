@@ -86,6 +86,18 @@ func (cp *containerProperties) Reduce() error {
 		cp.MountPoints = nil
 	}
 
+	// Prevent difference of API response that contains the default Fargate network configuration
+	if cp.NetworkConfiguration != nil {
+		if aws.StringValue(cp.NetworkConfiguration.AssignPublicIp) == batch.AssignPublicIpDisabled {
+			cp.NetworkConfiguration = nil
+		}
+	}
+
+	// Deal with ResourceRequirements objects which may be re-ordered in the API
+	sort.Slice(cp.ResourceRequirements, func(i, j int) bool {
+		return aws.StringValue(cp.ResourceRequirements[i].Type) < aws.StringValue(cp.ResourceRequirements[j].Type)
+	})
+
 	// Prevent difference of API response that adds an empty array when not configured during the request
 	if len(cp.ResourceRequirements) == 0 {
 		cp.ResourceRequirements = nil
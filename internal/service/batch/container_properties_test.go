@@ -449,6 +449,91 @@ func TestEquivalentContainerPropertiesJSON(t *testing.T) {
 }`,
 			ExpectEquivalent: true,
 		},
+		"reordered resourceRequirements, default networkConfiguration": {
+			//lintignore:AWSAT003,AWSAT005
+			ApiJson: `
+{
+	"image": "123.dkr.ecr.us-east-1.amazonaws.com/my-app",
+	"networkConfiguration": {
+		"assignPublicIp": "DISABLED"
+	},
+	"resourceRequirements": [
+		{
+			"type": "MEMORY",
+			"value": "512"
+		},
+		{
+			"type": "VCPU",
+			"value": "0.25"
+		}
+	]
+}
+`,
+			//lintignore:AWSAT003,AWSAT005
+			ConfigurationJson: `
+{
+	"image": "123.dkr.ecr.us-east-1.amazonaws.com/my-app",
+	"resourceRequirements": [
+		{
+			"type": "VCPU",
+			"value": "0.25"
+		},
+		{
+			"type": "MEMORY",
+			"value": "512"
+		}
+	]
+}
+`,
+			ExpectEquivalent: true,
+		},
+		"enabled networkConfiguration": {
+			//lintignore:AWSAT003,AWSAT005
+			ApiJson: `
+{
+	"image": "123.dkr.ecr.us-east-1.amazonaws.com/my-app",
+	"networkConfiguration": {
+		"assignPublicIp": "ENABLED"
+	}
+}
+`,
+			//lintignore:AWSAT003,AWSAT005
+			ConfigurationJson: `
+{
+	"image": "123.dkr.ecr.us-east-1.amazonaws.com/my-app"
+}
+`,
+			ExpectEquivalent: false,
+		},
+		"runtimePlatform and ephemeralStorage": {
+			//lintignore:AWSAT003,AWSAT005
+			ApiJson: `
+{
+	"ephemeralStorage": {
+		"sizeInGiB": 50
+	},
+	"image": "123.dkr.ecr.us-east-1.amazonaws.com/my-app",
+	"runtimePlatform": {
+		"cpuArchitecture": "ARM64",
+		"operatingSystemFamily": "LINUX"
+	}
+}
+`,
+			//lintignore:AWSAT003,AWSAT005
+			ConfigurationJson: `
+{
+	"image": "123.dkr.ecr.us-east-1.amazonaws.com/my-app",
+	"runtimePlatform": {
+		"operatingSystemFamily": "LINUX",
+		"cpuArchitecture": "ARM64"
+	},
+	"ephemeralStorage": {
+		"sizeInGiB": 50
+	}
+}
+`,
+			ExpectEquivalent: true,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -236,6 +236,38 @@ func TestAccBatchJobDefinition_PlatformCapabilities_fargate(t *testing.T) {
 	})
 }
 
+func TestAccBatchJobDefinition_PlatformCapabilitiesFargate_runtimePlatform(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jd batch.JobDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobDefinitionConfig_capabilitiesFargateRuntimePlatform(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobDefinitionExists(ctx, resourceName, &jd),
+					acctest.CheckResourceAttrJMES(resourceName, "container_properties", "ephemeralStorage.sizeInGiB", "50"),
+					acctest.CheckResourceAttrJMES(resourceName, "container_properties", "runtimePlatform.cpuArchitecture", "ARM64"),
+					acctest.CheckResourceAttrJMES(resourceName, "container_properties", "runtimePlatform.operatingSystemFamily", "LINUX"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "platform_capabilities.*", "FARGATE"),
+					resource.TestCheckResourceAttr(resourceName, "revision", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccBatchJobDefinition_ContainerProperties_advanced(t *testing.T) {
 	ctx := acctest.Context(t)
 	var jd batch.JobDefinition
@@ -1029,6 +1061,63 @@ CONTAINER_PROPERTIES
 `, rName)
 }
 
+func testAccJobDefinitionConfig_capabilitiesFargateRuntimePlatform(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "ecs_task_execution_role" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume_role_policy.json
+}
+
+data "aws_iam_policy_document" "assume_role_policy" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["ecs-tasks.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role_policy_attachment" "ecs_task_execution_role_policy" {
+  role       = aws_iam_role.ecs_task_execution_role.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonECSTaskExecutionRolePolicy"
+}
+
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+
+  platform_capabilities = [
+    "FARGATE",
+  ]
+
+  container_properties = jsonencode({
+    command = ["echo", "test"]
+    image   = "busybox"
+
+    ephemeralStorage = {
+      sizeInGiB = 50
+    }
+
+    resourceRequirements = [
+      { type = "MEMORY", value = "512" },
+      { type = "VCPU", value = "0.25" },
+    ]
+
+    runtimePlatform = {
+      cpuArchitecture       = "ARM64"
+      operatingSystemFamily = "LINUX"
+    }
+
+    executionRoleArn = aws_iam_role.ecs_task_execution_role.arn
+  })
+}
+`, rName)
+}
+
 func testAccJobDefinitionConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_batch_job_definition" "test" {
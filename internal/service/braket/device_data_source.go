@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package braket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/braket"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_braket_device", name="Device")
+func dataSourceDevice() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDeviceRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"device_capabilities": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_windows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"execution_day": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"window_end_hour": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"window_start_hour": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"paradigm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provider_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"queue_info": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"queue": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"queue_priority": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"queue_size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDeviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BraketConn(ctx)
+
+	arn := d.Get("arn").(string)
+	device, err := findDeviceByARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Braket Device (%s): %s", arn, err)
+	}
+
+	capabilities, err := json.Marshal(device.DeviceCapabilities)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "encoding Braket Device (%s) capabilities: %s", arn, err)
+	}
+
+	var paradigm string
+
+	if v, ok := device.DeviceCapabilities["paradigm"]; ok {
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "encoding Braket Device (%s) paradigm: %s", arn, err)
+		}
+
+		paradigm = string(b)
+	}
+
+	d.SetId(aws.StringValue(device.DeviceArn))
+	d.Set("arn", device.DeviceArn)
+	d.Set("device_capabilities", string(capabilities))
+	if err := d.Set("execution_windows", flattenDeviceExecutionWindows(device.DeviceCapabilities)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting execution_windows: %s", err)
+	}
+	d.Set("name", device.DeviceName)
+	d.Set("paradigm", paradigm)
+	d.Set("provider_name", device.ProviderName)
+	if err := d.Set("queue_info", flattenDeviceQueueInfos(device.DeviceQueueInfo)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting queue_info: %s", err)
+	}
+	d.Set("status", device.DeviceStatus)
+	d.Set("type", device.DeviceType)
+
+	return diags
+}
+
+func findDeviceByARN(ctx context.Context, conn *braket.Braket, arn string) (*braket.GetDeviceOutput, error) {
+	input := &braket.GetDeviceInput{
+		DeviceArn: aws.String(arn),
+	}
+
+	output, err := conn.GetDeviceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, braket.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// flattenDeviceExecutionWindows returns the availability windows from the "service" section of a device's capabilities document.
+func flattenDeviceExecutionWindows(capabilities aws.JSONValue) []interface{} {
+	service, ok := capabilities["service"].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	windows, ok := service["executionWindows"].([]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range windows {
+		window, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v, ok := window["executionDay"].(string); ok {
+			tfMap["execution_day"] = v
+		}
+
+		if v, ok := window["windowEndHour"].(string); ok {
+			tfMap["window_end_hour"] = v
+		}
+
+		if v, ok := window["windowStartHour"].(string); ok {
+			tfMap["window_start_hour"] = v
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDeviceQueueInfos(apiObjects []*braket.DeviceQueueInfo) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"queue":          aws.StringValue(apiObject.Queue),
+			"queue_priority": aws.StringValue(apiObject.QueuePriority),
+			"queue_size":     aws.StringValue(apiObject.QueueSize),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package braket_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBraketDeviceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_braket_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USWest2RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BraketServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arn", "arn:aws:braket:::device/quantum-simulator/amazon/sv1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "device_capabilities"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "execution_windows.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "SV1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "paradigm"),
+					resource.TestCheckResourceAttr(dataSourceName, "provider_name", "Amazon Braket"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "SIMULATOR"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDeviceDataSourceConfig_basic = `
+data "aws_braket_device" "test" {
+  arn = "arn:aws:braket:::device/quantum-simulator/amazon/sv1"
+}
+`
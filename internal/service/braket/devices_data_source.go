@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package braket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/braket"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_braket_devices", name="Devices")
+func dataSourceDevices() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDevicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"provider_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"statuses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(braket.DeviceStatus_Values(), false),
+				},
+			},
+			"types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(braket.DeviceType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDevicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BraketConn(ctx)
+
+	input := &braket.SearchDevicesInput{
+		Filters: []*braket.SearchDevicesFilter{},
+	}
+
+	for attr, name := range map[string]string{
+		"provider_names": "providerName",
+		"statuses":       "deviceStatus",
+		"types":          "deviceType",
+	} {
+		if v, ok := d.GetOk(attr); ok && v.(*schema.Set).Len() > 0 {
+			input.Filters = append(input.Filters, &braket.SearchDevicesFilter{
+				Name:   aws.String(name),
+				Values: flex.ExpandStringSet(v.(*schema.Set)),
+			})
+		}
+	}
+
+	output, err := findDevices(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Braket Devices: %s", err)
+	}
+
+	var arns, names []string
+
+	for _, v := range output {
+		arns = append(arns, aws.StringValue(v.DeviceArn))
+		names = append(names, aws.StringValue(v.DeviceName))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("names", names)
+
+	return diags
+}
+
+func findDevices(ctx context.Context, conn *braket.Braket, input *braket.SearchDevicesInput) ([]*braket.DeviceSummary, error) {
+	var output []*braket.DeviceSummary
+
+	err := conn.SearchDevicesPagesWithContext(ctx, input, func(page *braket.SearchDevicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Devices {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
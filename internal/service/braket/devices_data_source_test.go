@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package braket_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBraketDevicesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_braket_devices.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USWest2RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BraketServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDevicesDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "arns.#", 0),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "arns.*", "arn:aws:braket:::device/quantum-simulator/amazon/sv1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "names.*", "SV1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDevicesDataSourceConfig_basic = `
+data "aws_braket_devices" "test" {
+  provider_names = ["Amazon Braket"]
+  types          = ["SIMULATOR"]
+}
+`
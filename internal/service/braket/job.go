@@ -0,0 +1,715 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package braket
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/braket"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_braket_job", name="Job")
+// @Tags(identifierAttribute="arn")
+func resourceJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		UpdateWithoutTimeout: resourceJobUpdate,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"algorithm_specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_image": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							AtLeastOneOf: []string{"algorithm_specification.0.container_image", "algorithm_specification.0.script_mode_config"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"uri": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"script_mode_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"compression_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(braket.CompressionType_Values(), false),
+									},
+									"entry_point": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"s3_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billable_duration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"checkpoint_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"local_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"s3_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"device_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ended_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hyper_parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"input_data_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"s3_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"instance_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"instance_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(braket.InstanceType_Values(), false),
+						},
+						"volume_size_in_gb": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 50),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z](-*[0-9A-Za-z]){0,50}$`), "must contain only alphanumeric characters and hyphens"),
+				),
+			},
+			"output_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"s3_path": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stopping_condition": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_runtime_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BraketConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &braket.CreateJobInput{
+		AlgorithmSpecification: expandAlgorithmSpecification(d.Get("algorithm_specification").([]interface{})),
+		ClientToken:            aws.String(id.UniqueId()),
+		DeviceConfig: &braket.DeviceConfig{
+			Device: aws.String(d.Get("device_arn").(string)),
+		},
+		InstanceConfig:   expandInstanceConfig(d.Get("instance_config").([]interface{})),
+		JobName:          aws.String(name),
+		OutputDataConfig: expandJobOutputDataConfig(d.Get("output_data_config").([]interface{})),
+		RoleArn:          aws.String(d.Get("role_arn").(string)),
+		Tags:             getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("checkpoint_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CheckpointConfig = expandJobCheckpointConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("hyper_parameters"); ok && len(v.(map[string]interface{})) > 0 {
+		input.HyperParameters = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("input_data_config"); ok && len(v.([]interface{})) > 0 {
+		input.InputDataConfig = expandInputFileConfigs(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("stopping_condition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.StoppingCondition = expandJobStoppingCondition(v.([]interface{}))
+	}
+
+	output, err := conn.CreateJobWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Braket Job (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.JobArn))
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BraketConn(ctx)
+
+	job, err := findJobByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Braket Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Braket Job (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("algorithm_specification", flattenAlgorithmSpecification(job.AlgorithmSpecification)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting algorithm_specification: %s", err)
+	}
+	d.Set("arn", job.JobArn)
+	d.Set("billable_duration", job.BillableDuration)
+	if err := d.Set("checkpoint_config", flattenJobCheckpointConfig(job.CheckpointConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting checkpoint_config: %s", err)
+	}
+	d.Set("created_at", aws.TimeValue(job.CreatedAt).Format(time.RFC3339))
+	if job.DeviceConfig != nil {
+		d.Set("device_arn", job.DeviceConfig.Device)
+	} else {
+		d.Set("device_arn", nil)
+	}
+	if job.EndedAt != nil {
+		d.Set("ended_at", aws.TimeValue(job.EndedAt).Format(time.RFC3339))
+	} else {
+		d.Set("ended_at", nil)
+	}
+	d.Set("failure_reason", job.FailureReason)
+	d.Set("hyper_parameters", aws.StringValueMap(job.HyperParameters))
+	if err := d.Set("input_data_config", flattenInputFileConfigs(job.InputDataConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input_data_config: %s", err)
+	}
+	if err := d.Set("instance_config", flattenInstanceConfig(job.InstanceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instance_config: %s", err)
+	}
+	d.Set("name", job.JobName)
+	if err := d.Set("output_data_config", flattenJobOutputDataConfig(job.OutputDataConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_data_config: %s", err)
+	}
+	d.Set("role_arn", job.RoleArn)
+	if job.StartedAt != nil {
+		d.Set("started_at", aws.TimeValue(job.StartedAt).Format(time.RFC3339))
+	} else {
+		d.Set("started_at", nil)
+	}
+	d.Set("status", job.Status)
+	if err := d.Set("stopping_condition", flattenJobStoppingCondition(job.StoppingCondition)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting stopping_condition: %s", err)
+	}
+
+	setTagsOut(ctx, job.Tags)
+
+	return diags
+}
+
+func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BraketConn(ctx)
+
+	// Jobs cannot be deleted, only cancelled.
+	job, err := findJobByARN(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Braket Job (%s): %s", d.Id(), err)
+	}
+
+	switch aws.StringValue(job.Status) {
+	case braket.JobPrimaryStatusQueued, braket.JobPrimaryStatusRunning:
+	default:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling Braket Job: %s", d.Id())
+	_, err = conn.CancelJobWithContext(ctx, &braket.CancelJobInput{
+		JobArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, braket.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling Braket Job (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitJobCancelled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Braket Job (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findJobByARN(ctx context.Context, conn *braket.Braket, arn string) (*braket.GetJobOutput, error) {
+	input := &braket.GetJobInput{
+		JobArn: aws.String(arn),
+	}
+
+	output, err := conn.GetJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, braket.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusJob(ctx context.Context, conn *braket.Braket, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJobByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitJobCancelled(ctx context.Context, conn *braket.Braket, arn string, timeout time.Duration) (*braket.GetJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{braket.JobPrimaryStatusQueued, braket.JobPrimaryStatusRunning, braket.JobPrimaryStatusCancelling},
+		Target:  []string{braket.JobPrimaryStatusCancelled, braket.JobPrimaryStatusCompleted, braket.JobPrimaryStatusFailed},
+		Refresh: statusJob(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*braket.GetJobOutput); ok {
+		if status := aws.StringValue(output.Status); status == braket.JobPrimaryStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandAlgorithmSpecification(tfList []interface{}) *braket.AlgorithmSpecification {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &braket.AlgorithmSpecification{}
+
+	if v, ok := tfMap["container_image"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ContainerImage = &braket.ContainerImage{
+			Uri: aws.String(v[0].(map[string]interface{})["uri"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["script_mode_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		scriptModeConfig := &braket.ScriptModeConfig{
+			EntryPoint: aws.String(tfMap["entry_point"].(string)),
+			S3Uri:      aws.String(tfMap["s3_uri"].(string)),
+		}
+
+		if v, ok := tfMap["compression_type"].(string); ok && v != "" {
+			scriptModeConfig.CompressionType = aws.String(v)
+		}
+
+		apiObject.ScriptModeConfig = scriptModeConfig
+	}
+
+	return apiObject
+}
+
+func expandInstanceConfig(tfList []interface{}) *braket.InstanceConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &braket.InstanceConfig{
+		InstanceCount:  aws.Int64(int64(tfMap["instance_count"].(int))),
+		InstanceType:   aws.String(tfMap["instance_type"].(string)),
+		VolumeSizeInGb: aws.Int64(int64(tfMap["volume_size_in_gb"].(int))),
+	}
+}
+
+func expandJobCheckpointConfig(tfList []interface{}) *braket.JobCheckpointConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &braket.JobCheckpointConfig{
+		S3Uri: aws.String(tfMap["s3_uri"].(string)),
+	}
+
+	if v, ok := tfMap["local_path"].(string); ok && v != "" {
+		apiObject.LocalPath = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandInputFileConfigs(tfList []interface{}) []*braket.InputFileConfig {
+	var apiObjects []*braket.InputFileConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &braket.InputFileConfig{
+			ChannelName: aws.String(tfMap["channel_name"].(string)),
+			DataSource: &braket.DataSource{
+				S3DataSource: &braket.S3DataSource{
+					S3Uri: aws.String(tfMap["s3_uri"].(string)),
+				},
+			},
+		}
+
+		if v, ok := tfMap["content_type"].(string); ok && v != "" {
+			apiObject.ContentType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandJobOutputDataConfig(tfList []interface{}) *braket.JobOutputDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &braket.JobOutputDataConfig{
+		S3Path: aws.String(tfMap["s3_path"].(string)),
+	}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobStoppingCondition(tfList []interface{}) *braket.JobStoppingCondition {
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &braket.JobStoppingCondition{}
+
+	if v, ok := tfMap["max_runtime_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaxRuntimeInSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenAlgorithmSpecification(apiObject *braket.AlgorithmSpecification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ContainerImage; v != nil {
+		tfMap["container_image"] = []interface{}{map[string]interface{}{
+			"uri": aws.StringValue(v.Uri),
+		}}
+	}
+
+	if v := apiObject.ScriptModeConfig; v != nil {
+		tfMap["script_mode_config"] = []interface{}{map[string]interface{}{
+			"compression_type": aws.StringValue(v.CompressionType),
+			"entry_point":      aws.StringValue(v.EntryPoint),
+			"s3_uri":           aws.StringValue(v.S3Uri),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInstanceConfig(apiObject *braket.InstanceConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"instance_count":    aws.Int64Value(apiObject.InstanceCount),
+		"instance_type":     aws.StringValue(apiObject.InstanceType),
+		"volume_size_in_gb": aws.Int64Value(apiObject.VolumeSizeInGb),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenJobCheckpointConfig(apiObject *braket.JobCheckpointConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"local_path": aws.StringValue(apiObject.LocalPath),
+		"s3_uri":     aws.StringValue(apiObject.S3Uri),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInputFileConfigs(apiObjects []*braket.InputFileConfig) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"channel_name": aws.StringValue(apiObject.ChannelName),
+			"content_type": aws.StringValue(apiObject.ContentType),
+		}
+
+		if v := apiObject.DataSource; v != nil && v.S3DataSource != nil {
+			tfMap["s3_uri"] = aws.StringValue(v.S3DataSource.S3Uri)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenJobOutputDataConfig(apiObject *braket.JobOutputDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"kms_key_id": aws.StringValue(apiObject.KmsKeyId),
+		"s3_path":    aws.StringValue(apiObject.S3Path),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenJobStoppingCondition(apiObject *braket.JobStoppingCondition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"max_runtime_in_seconds": aws.Int64Value(apiObject.MaxRuntimeInSeconds),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package braket_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/braket"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbraket "github.com/hashicorp/terraform-provider-aws/internal/service/braket"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBraketJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v braket.GetJobOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_braket_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USWest2RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BraketServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Jobs cannot be deleted, only cancelled.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "algorithm_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm_specification.0.container_image.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm_specification.0.script_mode_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm_specification.0.script_mode_config.0.compression_type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm_specification.0.script_mode_config.0.entry_point", "algorithm"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "braket", regexache.MustCompile(`job/.+`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "device_arn", "arn:aws:braket:::device/quantum-simulator/amazon/sv1"),
+					resource.TestCheckResourceAttr(resourceName, "hyper_parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hyper_parameters.shots", "100"),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "instance_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_config.0.instance_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_config.0.instance_type", "ml.m5.large"),
+					resource.TestCheckResourceAttr(resourceName, "instance_config.0.volume_size_in_gb", "30"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "output_data_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "stopping_condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stopping_condition.0.max_runtime_in_seconds", "600"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The job may change status between the import and the verification.
+				ImportStateVerifyIgnore: []string{"billable_duration", "ended_at", "started_at", "status"},
+			},
+		},
+	})
+}
+
+func TestAccBraketJob_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v braket.GetJobOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_braket_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USWest2RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BraketServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccJobConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccJobConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobExists(ctx context.Context, n string, v *braket.GetJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BraketConn(ctx)
+
+		output, err := tfbraket.FindJobByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJobConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+# The AmazonBraketJobsExecutionPolicy managed policy only grants access to buckets prefixed with "amazon-braket-".
+resource "aws_s3_bucket" "test" {
+  bucket        = "amazon-braket-%[1]s"
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "script/algorithm.py"
+  content = "print('hello')"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "braket.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonBraketJobsExecutionPolicy"
+}
+`, rName)
+}
+
+func testAccJobConfig_job(rName, tags string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_braket_job" "test" {
+  name       = %[1]q
+  device_arn = "arn:aws:braket:::device/quantum-simulator/amazon/sv1"
+  role_arn   = aws_iam_role.test.arn
+
+  algorithm_specification {
+    container_image {
+      uri = "292282985366.dkr.ecr.${data.aws_region.current.name}.amazonaws.com/amazon-braket-base-jobs:1.0-cpu-py310-ubuntu22.04"
+    }
+
+    script_mode_config {
+      compression_type = "NONE"
+      entry_point      = "algorithm"
+      s3_uri           = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+    }
+  }
+
+  hyper_parameters = {
+    shots = "100"
+  }
+
+  instance_config {
+    instance_type     = "ml.m5.large"
+    volume_size_in_gb = 30
+  }
+
+  output_data_config {
+    s3_path = "s3://${aws_s3_bucket.test.bucket}/output"
+  }
+
+  stopping_condition {
+    max_runtime_in_seconds = 600
+  }
+
+%[2]s
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tags))
+}
+
+func testAccJobConfig_basic(rName string) string {
+	return testAccJobConfig_job(rName, "")
+}
+
+func testAccJobConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return testAccJobConfig_job(rName, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1))
+}
+
+func testAccJobConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccJobConfig_job(rName, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -5,13 +5,15 @@ package cloudwatch
 
 // Exports for use in tests only.
 var (
-	ResourceCompositeAlarm = resourceCompositeAlarm
-	ResourceDashboard      = resourceDashboard
-	ResourceMetricAlarm    = resourceMetricAlarm
-	ResourceMetricStream   = resourceMetricStream
+	ResourceCompositeAlarm            = resourceCompositeAlarm
+	ResourceDashboard                 = resourceDashboard
+	ResourceMetricAlarm               = resourceMetricAlarm
+	ResourceMetricMathAnomalyDetector = resourceMetricMathAnomalyDetector
+	ResourceMetricStream              = resourceMetricStream
 
-	FindCompositeAlarmByName = findCompositeAlarmByName
-	FindDashboardByName      = findDashboardByName
-	FindMetricAlarmByName    = findMetricAlarmByName
-	FindMetricStreamByName   = findMetricStreamByName
+	FindCompositeAlarmByName          = findCompositeAlarmByName
+	FindDashboardByName               = findDashboardByName
+	FindMetricAlarmByName             = findMetricAlarmByName
+	FindMetricMathAnomalyDetectorByID = findMetricMathAnomalyDetectorByID
+	FindMetricStreamByName            = findMetricStreamByName
 )
@@ -0,0 +1,418 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_cloudwatch_metric_math_anomaly_detector", name="Metric Math Anomaly Detector")
+func resourceMetricMathAnomalyDetector() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMetricMathAnomalyDetectorCreate,
+		ReadWithoutTimeout:   resourceMetricMathAnomalyDetectorRead,
+		UpdateWithoutTimeout: resourceMetricMathAnomalyDetectorUpdate,
+		DeleteWithoutTimeout: resourceMetricMathAnomalyDetectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"excluded_time_range": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidUTCTimestamp,
+									},
+									"start_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidUTCTimestamp,
+									},
+								},
+							},
+						},
+						"metric_timezone": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 50),
+						},
+					},
+				},
+			},
+			"metric_query": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"expression": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimensions": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"metric_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"namespace": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 255),
+											validation.StringMatch(regexache.MustCompile(`[^:].*`), "must not contain colon characters"),
+										),
+									},
+									"period": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.Any(
+											validation.IntInSlice([]int{1, 5, 10, 30}),
+											validation.IntDivisibleBy(60),
+										),
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"unit": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.StandardUnit](),
+									},
+								},
+							},
+						},
+						"period": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.Any(
+								validation.IntInSlice([]int{1, 5, 10, 30}),
+								validation.IntDivisibleBy(60),
+							),
+						},
+						"return_data": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"state_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMetricMathAnomalyDetectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	metricDataQueries := expandMetricAlarmMetrics(d.Get("metric_query").(*schema.Set).List())
+	id := metricMathAnomalyDetectorID(metricDataQueries)
+	input := &cloudwatch.PutAnomalyDetectorInput{
+		MetricMathAnomalyDetector: &types.MetricMathAnomalyDetector{
+			MetricDataQueries: metricDataQueries,
+		},
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandAnomalyDetectorConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.PutAnomalyDetector(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudWatch Metric Math Anomaly Detector (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceMetricMathAnomalyDetectorRead(ctx, d, meta)...)
+}
+
+func resourceMetricMathAnomalyDetectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	anomalyDetector, err := findMetricMathAnomalyDetectorByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Metric Math Anomaly Detector %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Metric Math Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("configuration", flattenAnomalyDetectorConfiguration(anomalyDetector.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	if err := d.Set("metric_query", flattenMetricAlarmMetrics(anomalyDetector.MetricMathAnomalyDetector.MetricDataQueries)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting metric_query: %s", err)
+	}
+	d.Set("state_value", anomalyDetector.StateValue)
+
+	return diags
+}
+
+func resourceMetricMathAnomalyDetectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	input := &cloudwatch.PutAnomalyDetectorInput{
+		Configuration: &types.AnomalyDetectorConfiguration{},
+		MetricMathAnomalyDetector: &types.MetricMathAnomalyDetector{
+			MetricDataQueries: expandMetricAlarmMetrics(d.Get("metric_query").(*schema.Set).List()),
+		},
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandAnomalyDetectorConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.PutAnomalyDetector(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating CloudWatch Metric Math Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceMetricMathAnomalyDetectorRead(ctx, d, meta)...)
+}
+
+func resourceMetricMathAnomalyDetectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	log.Printf("[INFO] Deleting CloudWatch Metric Math Anomaly Detector: %s", d.Id())
+	_, err := conn.DeleteAnomalyDetector(ctx, &cloudwatch.DeleteAnomalyDetectorInput{
+		MetricMathAnomalyDetector: &types.MetricMathAnomalyDetector{
+			MetricDataQueries: expandMetricAlarmMetrics(d.Get("metric_query").(*schema.Set).List()),
+		},
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Metric Math Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMetricMathAnomalyDetectorByID(ctx context.Context, conn *cloudwatch.Client, id string) (*types.AnomalyDetector, error) {
+	input := &cloudwatch.DescribeAnomalyDetectorsInput{
+		AnomalyDetectorTypes: []types.AnomalyDetectorType{types.AnomalyDetectorTypeMetricMath},
+	}
+
+	pages := cloudwatch.NewDescribeAnomalyDetectorsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AnomalyDetectors {
+			if v.MetricMathAnomalyDetector == nil {
+				continue
+			}
+
+			if metricMathAnomalyDetectorID(v.MetricMathAnomalyDetector.MetricDataQueries) == id {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+// metricMathAnomalyDetectorID returns a stable identifier for the anomaly detector defined by the specified metric data queries.
+// CloudWatch doesn't assign identifiers to anomaly detectors; they are identified by their metric math expression and its inputs.
+func metricMathAnomalyDetectorID(apiObjects []types.MetricDataQuery) string {
+	var parts []string
+
+	for _, apiObject := range apiObjects {
+		part := []string{
+			aws.ToString(apiObject.Id),
+			aws.ToString(apiObject.AccountId),
+			aws.ToString(apiObject.Expression),
+		}
+
+		if v := apiObject.MetricStat; v != nil {
+			part = append(part, strconv.Itoa(int(aws.ToInt32(v.Period))), aws.ToString(v.Stat), string(v.Unit))
+
+			if v := v.Metric; v != nil {
+				part = append(part, aws.ToString(v.Namespace), aws.ToString(v.MetricName))
+
+				var dimensions []string
+				for _, v := range v.Dimensions {
+					dimensions = append(dimensions, fmt.Sprintf("%s=%s", aws.ToString(v.Name), aws.ToString(v.Value)))
+				}
+				slices.Sort(dimensions)
+
+				part = append(part, dimensions...)
+			}
+		}
+
+		parts = append(parts, strings.Join(part, "|"))
+	}
+
+	slices.Sort(parts)
+
+	return strconv.Itoa(create.StringHashcode(strings.Join(parts, "\n")))
+}
+
+func expandAnomalyDetectorConfiguration(tfMap map[string]interface{}) *types.AnomalyDetectorConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.AnomalyDetectorConfiguration{}
+
+	if v, ok := tfMap["excluded_time_range"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ExcludedTimeRanges = expandRanges(v)
+	}
+
+	if v, ok := tfMap["metric_timezone"].(string); ok && v != "" {
+		apiObject.MetricTimezone = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRanges(tfList []interface{}) []types.Range {
+	var apiObjects []types.Range
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.Range{}
+
+		if v, ok := tfMap["end_time"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.EndTime = aws.Time(v)
+		}
+
+		if v, ok := tfMap["start_time"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.StartTime = aws.Time(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAnomalyDetectorConfiguration(apiObject *types.AnomalyDetectorConfiguration) []interface{} {
+	if apiObject == nil || (len(apiObject.ExcludedTimeRanges) == 0 && aws.ToString(apiObject.MetricTimezone) == "") {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"excluded_time_range": flattenRanges(apiObject.ExcludedTimeRanges),
+		"metric_timezone":     aws.ToString(apiObject.MetricTimezone),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRanges(apiObjects []types.Range) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"end_time":   aws.ToTime(apiObject.EndTime).Format(time.RFC3339),
+			"start_time": aws.ToTime(apiObject.StartTime).Format(time.RFC3339),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudwatch "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchMetricMathAnomalyDetector_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_metric_math_anomaly_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricMathAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricMathAnomalyDetectorConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricMathAnomalyDetectorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "metric_query.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metric_query.*", map[string]string{
+						"id":                 "m1",
+						"metric.#":           "1",
+						"metric.0.namespace": "AWS/EC2",
+						"return_data":        "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metric_query.*", map[string]string{
+						"id":          "e1",
+						"expression":  "m1 * 2",
+						"return_data": "true",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "state_value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricMathAnomalyDetector_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_metric_math_anomaly_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricMathAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricMathAnomalyDetectorConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricMathAnomalyDetectorExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcloudwatch.ResourceMetricMathAnomalyDetector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricMathAnomalyDetector_configuration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_metric_math_anomaly_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricMathAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricMathAnomalyDetectorConfig_configuration(rName, "UTC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricMathAnomalyDetectorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.end_time", "2024-01-02T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.start_time", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.metric_timezone", "UTC"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMetricMathAnomalyDetectorConfig_configuration(rName, "Europe/London"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricMathAnomalyDetectorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.metric_timezone", "Europe/London"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMetricMathAnomalyDetectorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_metric_math_anomaly_detector" {
+				continue
+			}
+
+			_, err := tfcloudwatch.FindMetricMathAnomalyDetectorByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Metric Math Anomaly Detector %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMetricMathAnomalyDetectorExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchClient(ctx)
+
+		_, err := tfcloudwatch.FindMetricMathAnomalyDetectorByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccMetricMathAnomalyDetectorConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_math_anomaly_detector" "test" {
+  metric_query {
+    id = "m1"
+
+    metric {
+      metric_name = "CPUUtilization"
+      namespace   = "AWS/EC2"
+      period      = 300
+      stat        = "Average"
+
+      dimensions = {
+        InstanceId = %[1]q
+      }
+    }
+  }
+
+  metric_query {
+    id          = "e1"
+    expression  = "m1 * 2"
+    return_data = true
+  }
+}
+`, rName)
+}
+
+func testAccMetricMathAnomalyDetectorConfig_configuration(rName, timezone string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_math_anomaly_detector" "test" {
+  metric_query {
+    id = "m1"
+
+    metric {
+      metric_name = "CPUUtilization"
+      namespace   = "AWS/EC2"
+      period      = 300
+      stat        = "Average"
+
+      dimensions = {
+        InstanceId = %[1]q
+      }
+    }
+  }
+
+  metric_query {
+    id          = "e1"
+    expression  = "m1 * 2"
+    return_data = true
+  }
+
+  configuration {
+    metric_timezone = %[2]q
+
+    excluded_time_range {
+      start_time = "2024-01-01T00:00:00Z"
+      end_time   = "2024-01-02T00:00:00Z"
+    }
+  }
+}
+`, rName, timezone)
+}
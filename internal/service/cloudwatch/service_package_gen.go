@@ -49,6 +49,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  resourceMetricMathAnomalyDetector,
+			TypeName: "aws_cloudwatch_metric_math_anomaly_detector",
+			Name:     "Metric Math Anomaly Detector",
+		},
 		{
 			Factory:  resourceMetricStream,
 			TypeName: "aws_cloudwatch_metric_stream",
@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeguruprofiler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeguruprofiler"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeguruprofiler/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Agent Permission")
+func newResourceAgentPermission(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceAgentPermission{}
+
+	return r, nil
+}
+
+const (
+	ResNameAgentPermission = "Agent Permission"
+)
+
+type resourceAgentPermission struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceAgentPermission) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_codeguruprofiler_agent_permission"
+}
+
+func (r *resourceAgentPermission) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"policy": schema.StringAttribute{
+				Computed: true,
+			},
+			"principals": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"profiling_group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"revision_id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceAgentPermission) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().CodeGuruProfilerClient(ctx)
+
+	var plan resourceAgentPermissionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.ProfilingGroupName.ValueString()
+
+	// An existing resource policy must be referenced by its revision ID.
+	var revisionID *string
+	policy, err := findPolicyByProfilingGroupName(ctx, conn, name)
+
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		resp.Diagnostics.Append(create.DiagErrorFramework(names.CodeGuruProfiler, create.ErrActionCreating, ResNameAgentPermission, name, err))
+		return
+	default:
+		revisionID = policy.RevisionId
+	}
+
+	in := &codeguruprofiler.PutPermissionInput{
+		ActionGroup:        awstypes.ActionGroupAgentPermissions,
+		Principals:         flex.ExpandFrameworkStringValueSet(ctx, plan.Principals),
+		ProfilingGroupName: aws.String(name),
+		RevisionId:         revisionID,
+	}
+
+	out, err := conn.PutPermission(ctx, in)
+	if err != nil {
+		resp.Diagnostics.Append(create.DiagErrorFramework(names.CodeGuruProfiler, create.ErrActionCreating, ResNameAgentPermission, name, err))
+		return
+	}
+
+	plan.ID = flex.StringValueToFramework(ctx, name)
+	plan.Policy = flex.StringToFramework(ctx, out.Policy)
+	plan.RevisionID = flex.StringToFramework(ctx, out.RevisionId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceAgentPermission) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().CodeGuruProfilerClient(ctx)
+
+	var state resourceAgentPermissionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findPolicyByProfilingGroupName(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(create.DiagErrorFramework(names.CodeGuruProfiler, create.ErrActionSetting, ResNameAgentPermission, state.ID.ValueString(), err))
+		return
+	}
+
+	principals, err := agentPermissionsPrincipals(aws.ToString(out.Policy))
+	if err != nil {
+		resp.Diagnostics.Append(create.DiagErrorFramework(names.CodeGuruProfiler, create.ErrActionSetting, ResNameAgentPermission, state.ID.ValueString(), err))
+		return
+	}
+
+	if len(principals) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Policy = flex.StringToFramework(ctx, out.Policy)
+	state.Principals = flex.FlattenFrameworkStringValueSet(ctx, principals)
+	state.ProfilingGroupName = flex.StringValueToFramework(ctx, state.ID.ValueString())
+	state.RevisionID = flex.StringToFramework(ctx, out.RevisionId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceAgentPermission) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().CodeGuruProfilerClient(ctx)
+
+	var plan, state resourceAgentPermissionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Principals.Equal(state.Principals) {
+		in := &codeguruprofiler.PutPermissionInput{
+			ActionGroup:        awstypes.ActionGroupAgentPermissions,
+			Principals:         flex.ExpandFrameworkStringValueSet(ctx, plan.Principals),
+			ProfilingGroupName: flex.StringFromFramework(ctx, state.ID),
+			RevisionId:         flex.StringFromFramework(ctx, state.RevisionID),
+		}
+
+		out, err := conn.PutPermission(ctx, in)
+		if err != nil {
+			resp.Diagnostics.Append(create.DiagErrorFramework(names.CodeGuruProfiler, create.ErrActionUpdating, ResNameAgentPermission, state.ID.ValueString(), err))
+			return
+		}
+
+		plan.Policy = flex.StringToFramework(ctx, out.Policy)
+		plan.RevisionID = flex.StringToFramework(ctx, out.RevisionId)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceAgentPermission) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().CodeGuruProfilerClient(ctx)
+
+	var state resourceAgentPermissionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &codeguruprofiler.RemovePermissionInput{
+		ActionGroup:        awstypes.ActionGroupAgentPermissions,
+		ProfilingGroupName: flex.StringFromFramework(ctx, state.ID),
+		RevisionId:         flex.StringFromFramework(ctx, state.RevisionID),
+	}
+
+	_, err := conn.RemovePermission(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(create.DiagErrorFramework(names.CodeGuruProfiler, create.ErrActionDeleting, ResNameAgentPermission, state.ID.String(), err))
+		return
+	}
+}
+
+func (r *resourceAgentPermission) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func findPolicyByProfilingGroupName(ctx context.Context, conn *codeguruprofiler.Client, name string) (*codeguruprofiler.GetPolicyOutput, error) {
+	in := &codeguruprofiler.GetPolicyInput{
+		ProfilingGroupName: aws.String(name),
+	}
+
+	out, err := conn.GetPolicy(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || aws.ToString(out.Policy) == "" {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+// agentPermissionsPrincipals returns the principals granted the agentPermissions action group by the specified resource policy.
+func agentPermissionsPrincipals(policy string) ([]string, error) {
+	var document struct {
+		Statement []struct {
+			Sid       string
+			Principal struct {
+				AWS any
+			}
+		}
+	}
+
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	var principals []string
+
+	for _, statement := range document.Statement {
+		if statement.Sid != string(awstypes.ActionGroupAgentPermissions)+"-statement" {
+			continue
+		}
+
+		switch v := statement.Principal.AWS.(type) {
+		case string:
+			principals = append(principals, v)
+		case []any:
+			for _, v := range v {
+				if v, ok := v.(string); ok {
+					principals = append(principals, v)
+				}
+			}
+		}
+	}
+
+	return principals, nil
+}
+
+type resourceAgentPermissionData struct {
+	ID                 types.String `tfsdk:"id"`
+	Policy             types.String `tfsdk:"policy"`
+	Principals         types.Set    `tfsdk:"principals"`
+	ProfilingGroupName types.String `tfsdk:"profiling_group_name"`
+	RevisionID         types.String `tfsdk:"revision_id"`
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeguruprofiler_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcodeguruprofiler "github.com/hashicorp/terraform-provider-aws/internal/service/codeguruprofiler"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCodeGuruProfilerAgentPermission_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_agent_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruProfilerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentPermissionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentPermissionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "profiling_group_name", "aws_codeguruprofiler_profiling_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "principals.*", "aws_iam_role.test.0", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruProfilerAgentPermission_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_agent_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruProfilerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentPermissionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentPermissionExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcodeguruprofiler.ResourceAgentPermission, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruProfilerAgentPermission_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_agent_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruProfilerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentPermissionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentPermissionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "1"),
+				),
+			},
+			{
+				Config: testAccAgentPermissionConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentPermissionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "principals.*", "aws_iam_role.test.0", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "principals.*", "aws_iam_role.test.1", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAgentPermissionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruProfilerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeguruprofiler_agent_permission" {
+				continue
+			}
+
+			_, err := tfcodeguruprofiler.FindPolicyByProfilingGroupName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.CodeGuruProfiler, create.ErrActionCheckingDestroyed, tfcodeguruprofiler.ResNameAgentPermission, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.CodeGuruProfiler, create.ErrActionCheckingDestroyed, tfcodeguruprofiler.ResNameAgentPermission, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAgentPermissionExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CodeGuruProfiler, create.ErrActionCheckingExistence, tfcodeguruprofiler.ResNameAgentPermission, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.CodeGuruProfiler, create.ErrActionCheckingExistence, tfcodeguruprofiler.ResNameAgentPermission, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruProfilerClient(ctx)
+
+		_, err := tfcodeguruprofiler.FindPolicyByProfilingGroupName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.CodeGuruProfiler, create.ErrActionCheckingExistence, tfcodeguruprofiler.ResNameAgentPermission, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAgentPermissionConfig_base(rName string, roleCount int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name             = %[1]q
+  compute_platform = "Default"
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+}
+`, rName, roleCount)
+}
+
+func testAccAgentPermissionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAgentPermissionConfig_base(rName, 1), `
+resource "aws_codeguruprofiler_agent_permission" "test" {
+  profiling_group_name = aws_codeguruprofiler_profiling_group.test.name
+  principals           = [aws_iam_role.test[0].arn]
+}
+`)
+}
+
+func testAccAgentPermissionConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccAgentPermissionConfig_base(rName, 2), `
+resource "aws_codeguruprofiler_agent_permission" "test" {
+  profiling_group_name = aws_codeguruprofiler_profiling_group.test.name
+  principals           = aws_iam_role.test[*].arn
+}
+`)
+}
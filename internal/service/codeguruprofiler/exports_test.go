@@ -5,7 +5,11 @@ package codeguruprofiler
 
 // Exports for use in tests only.
 var (
-	ResourceProfilingGroup = newResourceProfilingGroup
+	ResourceAgentPermission     = newResourceAgentPermission
+	ResourceNotificationChannel = newResourceNotificationChannel
+	ResourceProfilingGroup      = newResourceProfilingGroup
 
-	FindProfilingGroupByName = findProfilingGroupByName
+	FindNotificationChannelByTwoPartKey = findNotificationChannelByTwoPartKey
+	FindPolicyByProfilingGroupName      = findPolicyByProfilingGroupName
+	FindProfilingGroupByName            = findProfilingGroupByName
 )
@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeguruprofiler
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeguruprofiler"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeguruprofiler/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Notification Channel")
+func newResourceNotificationChannel(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceNotificationChannel{}
+
+	return r, nil
+}
+
+const (
+	ResNameNotificationChannel = "Notification Channel"
+
+	notificationChannelIDPartCount = 2
+)
+
+type resourceNotificationChannel struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+}
+
+func (r *resourceNotificationChannel) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_codeguruprofiler_notification_channel"
+}
+
+func (r *resourceNotificationChannel) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"event_publishers": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.EventPublisher]()),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"profiling_group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"uri": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceNotificationChannel) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().CodeGuruProfilerClient(ctx)
+
+	var plan resourceNotificationChannelData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel := awstypes.Channel{
+		EventPublishers: []awstypes.EventPublisher{awstypes.EventPublisherAnomalyDetection},
+		Uri:             flex.StringFromFramework(ctx, plan.URI),
+	}
+
+	if v := flex.ExpandFrameworkStringValueSet(ctx, plan.EventPublishers); len(v) > 0 {
+		channel.EventPublishers = tfslices.ApplyToAll(v, func(v string) awstypes.EventPublisher {
+			return awstypes.EventPublisher(v)
+		})
+	}
+
+	name := plan.ProfilingGroupName.ValueString()
+	in := &codeguruprofiler.AddNotificationChannelsInput{
+		Channels:           []awstypes.Channel{channel},
+		ProfilingGroupName: aws.String(name),
+	}
+
+	out, err := conn.AddNotificationChannels(ctx, in)
+	if err != nil {
+		resp.Diagnostics.Append(create.DiagErrorFramework(names.CodeGuruProfiler, create.ErrActionCreating, ResNameNotificationChannel, name, err))
+		return
+	}
+
+	var created *awstypes.Channel
+	if out.NotificationConfiguration != nil {
+		for _, v := range out.NotificationConfiguration.Channels {
+			if aws.ToString(v.Uri) == aws.ToString(channel.Uri) {
+				created = &v
+				break
+			}
+		}
+	}
+
+	if created == nil {
+		err := tfresource.NewEmptyResultError(in)
+		resp.Diagnostics.Append(create.DiagErrorFramework(names.CodeGuruProfiler, create.ErrActionCreating, ResNameNotificationChannel, name, err))
+		return
+	}
+
+	id, err := intflex.FlattenResourceId([]string{name, aws.ToString(created.Id)}, notificationChannelIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.Append(create.DiagErrorFramework(names.CodeGuruProfiler, create.ErrActionCreating, ResNameNotificationChannel, name, err))
+		return
+	}
+
+	plan.ChannelID = flex.StringToFramework(ctx, created.Id)
+	plan.EventPublishers = flex.FlattenFrameworkStringValueSet(ctx, created.EventPublishers)
+	plan.ID = flex.StringValueToFramework(ctx, id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceNotificationChannel) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().CodeGuruProfilerClient(ctx)
+
+	var state resourceNotificationChannelData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := intflex.ExpandResourceId(state.ID.ValueString(), notificationChannelIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.Append(create.DiagErrorFramework(names.CodeGuruProfiler, create.ErrActionSetting, ResNameNotificationChannel, state.ID.ValueString(), err))
+		return
+	}
+
+	out, err := findNotificationChannelByTwoPartKey(ctx, conn, parts[0], parts[1])
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(create.DiagErrorFramework(names.CodeGuruProfiler, create.ErrActionSetting, ResNameNotificationChannel, state.ID.ValueString(), err))
+		return
+	}
+
+	state.ChannelID = flex.StringToFramework(ctx, out.Id)
+	state.EventPublishers = flex.FlattenFrameworkStringValueSet(ctx, out.EventPublishers)
+	state.ProfilingGroupName = flex.StringValueToFramework(ctx, parts[0])
+	state.URI = fwtypes.ARNValue(aws.ToString(out.Uri))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceNotificationChannel) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().CodeGuruProfilerClient(ctx)
+
+	var state resourceNotificationChannelData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &codeguruprofiler.RemoveNotificationChannelInput{
+		ChannelId:          flex.StringFromFramework(ctx, state.ChannelID),
+		ProfilingGroupName: flex.StringFromFramework(ctx, state.ProfilingGroupName),
+	}
+
+	_, err := conn.RemoveNotificationChannel(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(create.DiagErrorFramework(names.CodeGuruProfiler, create.ErrActionDeleting, ResNameNotificationChannel, state.ID.String(), err))
+		return
+	}
+}
+
+func (r *resourceNotificationChannel) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func findNotificationChannelByTwoPartKey(ctx context.Context, conn *codeguruprofiler.Client, profilingGroupName, channelID string) (*awstypes.Channel, error) {
+	in := &codeguruprofiler.GetNotificationConfigurationInput{
+		ProfilingGroupName: aws.String(profilingGroupName),
+	}
+
+	out, err := conn.GetNotificationConfiguration(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.NotificationConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	for _, v := range out.NotificationConfiguration.Channels {
+		if aws.ToString(v.Id) == channelID {
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+type resourceNotificationChannelData struct {
+	ChannelID          types.String `tfsdk:"channel_id"`
+	EventPublishers    types.Set    `tfsdk:"event_publishers"`
+	ID                 types.String `tfsdk:"id"`
+	ProfilingGroupName types.String `tfsdk:"profiling_group_name"`
+	URI                fwtypes.ARN  `tfsdk:"uri"`
+}
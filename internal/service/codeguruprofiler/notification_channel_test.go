@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeguruprofiler_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeguruprofiler/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcodeguruprofiler "github.com/hashicorp/terraform-provider-aws/internal/service/codeguruprofiler"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCodeGuruProfilerNotificationChannel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var channel awstypes.Channel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_notification_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruProfilerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNotificationChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttrSet(resourceName, "channel_id"),
+					resource.TestCheckResourceAttr(resourceName, "event_publishers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "event_publishers.*", "AnomalyDetection"),
+					resource.TestCheckResourceAttrPair(resourceName, "profiling_group_name", "aws_codeguruprofiler_profiling_group.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "uri", "aws_sns_topic.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruProfilerNotificationChannel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var channel awstypes.Channel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_notification_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruProfilerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNotificationChannelExists(ctx, resourceName, &channel),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcodeguruprofiler.ResourceNotificationChannel, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNotificationChannelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruProfilerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeguruprofiler_notification_channel" {
+				continue
+			}
+
+			_, err := tfcodeguruprofiler.FindNotificationChannelByTwoPartKey(ctx, conn, rs.Primary.Attributes["profiling_group_name"], rs.Primary.Attributes["channel_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.CodeGuruProfiler, create.ErrActionCheckingDestroyed, tfcodeguruprofiler.ResNameNotificationChannel, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.CodeGuruProfiler, create.ErrActionCheckingDestroyed, tfcodeguruprofiler.ResNameNotificationChannel, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNotificationChannelExists(ctx context.Context, name string, channel *awstypes.Channel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CodeGuruProfiler, create.ErrActionCheckingExistence, tfcodeguruprofiler.ResNameNotificationChannel, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.CodeGuruProfiler, create.ErrActionCheckingExistence, tfcodeguruprofiler.ResNameNotificationChannel, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruProfilerClient(ctx)
+
+		resp, err := tfcodeguruprofiler.FindNotificationChannelByTwoPartKey(ctx, conn, rs.Primary.Attributes["profiling_group_name"], rs.Primary.Attributes["channel_id"])
+
+		if err != nil {
+			return create.Error(names.CodeGuruProfiler, create.ErrActionCheckingExistence, tfcodeguruprofiler.ResNameNotificationChannel, rs.Primary.ID, err)
+		}
+
+		*channel = *resp
+
+		return nil
+	}
+}
+
+func testAccNotificationChannelConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name             = %[1]q
+  compute_platform = "Default"
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+}
+
+resource "aws_codeguruprofiler_notification_channel" "test" {
+  profiling_group_name = aws_codeguruprofiler_profiling_group.test.name
+  uri                  = aws_sns_topic.test.arn
+}
+`, rName)
+}
@@ -25,6 +25,14 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceAgentPermission,
+			Name:    "Agent Permission",
+		},
+		{
+			Factory: newResourceNotificationChannel,
+			Name:    "Notification Channel",
+		},
 		{
 			Factory: newResourceProfilingGroup,
 			Name:    "Profiling Group",
@@ -92,6 +92,61 @@ func ResourceTask() *schema.Resource {
 					},
 				},
 			},
+			"manifest_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(datasync.ManifestAction_Values(), false),
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(datasync.ManifestFormat_Values(), false),
+						},
+						"source": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_access_role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"manifest_object_path": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"manifest_object_version_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"s3_bucket_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -327,6 +382,10 @@ func resourceTaskCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.Includes = expandFilterRules(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("manifest_config"); ok {
+		input.ManifestConfig = expandManifestConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("name"); ok {
 		input.Name = aws.String(v.(string))
 	}
@@ -379,6 +438,9 @@ func resourceTaskRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	if err := d.Set("includes", flattenFilterRules(output.Includes)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting includes: %s", err)
 	}
+	if err := d.Set("manifest_config", flattenManifestConfig(output.ManifestConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting manifest_config: %s", err)
+	}
 	d.Set("name", output.Name)
 	if err := d.Set("options", flattenOptions(output.Options)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting options: %s", err)
@@ -415,6 +477,15 @@ func resourceTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.Includes = expandFilterRules(d.Get("includes").([]interface{}))
 		}
 
+		if d.HasChanges("manifest_config") {
+			// An empty manifest configuration removes it from the task.
+			input.ManifestConfig = &datasync.ManifestConfig{}
+
+			if v := expandManifestConfig(d.Get("manifest_config").([]interface{})); v != nil {
+				input.ManifestConfig = v
+			}
+		}
+
 		if d.HasChanges("name") {
 			input.Name = aws.String(d.Get("name").(string))
 		}
@@ -547,6 +618,47 @@ func flattenOptions(options *datasync.Options) []interface{} {
 	return []interface{}{m}
 }
 
+func flattenManifestConfig(apiObject *datasync.ManifestConfig) []interface{} {
+	if apiObject == nil || apiObject.Source == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"action": aws.StringValue(apiObject.Action),
+		"format": aws.StringValue(apiObject.Format),
+		"source": flattenSourceManifestConfig(apiObject.Source),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenSourceManifestConfig(apiObject *datasync.SourceManifestConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"s3": flattenS3ManifestConfig(apiObject.S3),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenS3ManifestConfig(apiObject *datasync.S3ManifestConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"bucket_access_role_arn":     aws.StringValue(apiObject.BucketAccessRoleArn),
+		"manifest_object_path":       aws.StringValue(apiObject.ManifestObjectPath),
+		"manifest_object_version_id": aws.StringValue(apiObject.ManifestObjectVersionId),
+		"s3_bucket_arn":              aws.StringValue(apiObject.S3BucketArn),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenTaskReportConfig(options *datasync.TaskReportConfig) []interface{} {
 	if options == nil {
 		return []interface{}{}
@@ -663,6 +775,60 @@ func flattenTaskSchedule(schedule *datasync.TaskSchedule) []interface{} {
 	return []interface{}{m}
 }
 
+func expandManifestConfig(l []interface{}) *datasync.ManifestConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	manifestConfig := &datasync.ManifestConfig{
+		Source: expandSourceManifestConfig(m["source"].([]interface{})),
+	}
+
+	if v, ok := m["action"].(string); ok && v != "" {
+		manifestConfig.Action = aws.String(v)
+	}
+
+	if v, ok := m["format"].(string); ok && v != "" {
+		manifestConfig.Format = aws.String(v)
+	}
+
+	return manifestConfig
+}
+
+func expandSourceManifestConfig(l []interface{}) *datasync.SourceManifestConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &datasync.SourceManifestConfig{
+		S3: expandS3ManifestConfig(m["s3"].([]interface{})),
+	}
+}
+
+func expandS3ManifestConfig(l []interface{}) *datasync.S3ManifestConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	s3ManifestConfig := &datasync.S3ManifestConfig{
+		BucketAccessRoleArn: aws.String(m["bucket_access_role_arn"].(string)),
+		ManifestObjectPath:  aws.String(m["manifest_object_path"].(string)),
+		S3BucketArn:         aws.String(m["s3_bucket_arn"].(string)),
+	}
+
+	if v, ok := m["manifest_object_version_id"].(string); ok && v != "" {
+		s3ManifestConfig.ManifestObjectVersionId = aws.String(v)
+	}
+
+	return s3ManifestConfig
+}
+
 func expandTaskReportConfig(l []interface{}) *datasync.TaskReportConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -429,7 +429,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 				NewReplicationFactor: aws.Int64(int64(nraw.(int))),
 			})
 			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "increasing nodes in DAX cluster %s, error: %s", d.Id(), err)
+				return sdkdiag.AppendErrorf(diags, "decreasing nodes in DAX cluster %s, error: %s", d.Id(), err)
 			}
 			awaitUpdate = true
 		}
@@ -448,7 +448,9 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 	if awaitUpdate {
 		log.Printf("[DEBUG] Waiting for update: %s", d.Id())
-		pending := []string{"modifying"}
+		// Nodes being added or removed by a replication factor change are
+		// reported as "creating" until they settle.
+		pending := []string{"creating", "modifying"}
 		stateConf := &retry.StateChangeConf{
 			Pending:    pending,
 			Target:     []string{"available"},
@@ -301,3 +301,26 @@ func FindLocations(ctx context.Context, conn *directconnect.DirectConnect, input
 
 	return output.Locations, nil
 }
+
+func FindMacSecKeyByConnectionIDAndSecretARN(ctx context.Context, conn *directconnect.DirectConnect, connectionID, secretARN string) (*directconnect.MacSecKey, error) {
+	connection, err := FindConnectionByID(ctx, conn, connectionID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range connection.MacSecKeys {
+		if v != nil && aws.StringValue(v.SecretARN) == secretARN {
+			// There is no enum for MACsec key states.
+			if state := aws.StringValue(v.State); state == "disassociated" {
+				return nil, &retry.NotFoundError{
+					Message: state,
+				}
+			}
+
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
@@ -12,12 +12,15 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_dx_macsec_key_association")
@@ -37,7 +40,7 @@ func ResourceMacSecKeyAssociation() *schema.Resource {
 				Optional: true,
 				// CAK requires CKN
 				RequiredWith: []string{"ckn"},
-				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9A-Fa-f]{64}$`), "Must be 64-character hex code string"),
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Fa-f]{64}$`), "Must be 64-character hex code string"),
 				ForceNew:     true,
 			},
 			"ckn": {
@@ -45,7 +48,7 @@ func ResourceMacSecKeyAssociation() *schema.Resource {
 				Computed:     true,
 				Optional:     true,
 				AtLeastOneOf: []string{"ckn", "secret_arn"},
-				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9A-Fa-f]{64}$`), "Must be 64-character hex code string"),
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Fa-f]{64}$`), "Must be 64-character hex code string"),
 				ForceNew:     true,
 			},
 			"connection_id": {
@@ -54,11 +57,13 @@ func ResourceMacSecKeyAssociation() *schema.Resource {
 				ForceNew: true,
 			},
 			"secret_arn": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				AtLeastOneOf: []string{"ckn", "secret_arn"},
-				ForceNew:     true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"ckn", "secret_arn"},
+				ConflictsWith: []string{"ckn"},
+				ForceNew:      true,
+				ValidateFunc:  verify.ValidARN,
 			},
 			"start_on": {
 				Type:     schema.TypeString,
@@ -115,25 +120,24 @@ func resourceMacSecKeyRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "unexpected format of ID (%s), expected secretArn_connectionId", d.Id())
 	}
 
-	connection, err := FindConnectionByID(ctx, conn, connId)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Direct Connect Connection (%s): %s", d.Id(), err)
-	}
+	key, err := FindMacSecKeyByConnectionIDAndSecretARN(ctx, conn, connId, secretArn)
 
-	if connection.MacSecKeys == nil {
-		return sdkdiag.AppendErrorf(diags, "no MACSec keys found on Direct Connect Connection (%s)", d.Id())
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Direct Connect MACSec Key Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
 	}
 
-	for _, key := range connection.MacSecKeys {
-		if aws.StringValue(key.SecretARN) == aws.StringValue(&secretArn) {
-			d.Set("ckn", key.Ckn)
-			d.Set("connection_id", connId)
-			d.Set("secret_arn", key.SecretARN)
-			d.Set("start_on", key.StartOn)
-			d.Set("state", key.State)
-		}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Direct Connect MACSec Key Association (%s): %s", d.Id(), err)
 	}
 
+	d.Set("ckn", key.Ckn)
+	d.Set("connection_id", connId)
+	d.Set("secret_arn", key.SecretARN)
+	d.Set("start_on", key.StartOn)
+	d.Set("state", key.State)
+
 	return diags
 }
 
@@ -149,6 +153,10 @@ func resourceMacSecKeyDelete(ctx context.Context, d *schema.ResourceData, meta i
 	log.Printf("[DEBUG] Disassociating MACSec secret key on Direct Connect Connection: %s", *input.ConnectionId)
 	_, err := conn.DisassociateMacSecKeyWithContext(ctx, input)
 
+	if tfawserr.ErrMessageContains(err, directconnect.ErrCodeClientException, "Could not find Connection with ID") {
+		return diags
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "Unable to disassociate MACSec secret key on Direct Connect Connection (%s): %s", *input.ConnectionId, err)
 	}
@@ -169,13 +177,14 @@ func MacSecKeyParseSecretARN(output *directconnect.AssociateMacSecKeyOutput) str
 	return result
 }
 
-// MacSecKeyParseID parses the resource ID and returns the secret ARN and connection ID
+// MacSecKeyParseID parses the resource ID and returns the secret ARN and connection ID.
+// Secret names may contain underscores, so the ID is split at the last one.
 func MacSecKeyParseID(id string) (string, string, error) {
-	parts := strings.SplitN(id, "_", 2)
+	i := strings.LastIndex(id, "_")
 
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+	if i <= 0 || i == len(id)-1 {
 		return "", "", &retry.NotFoundError{}
 	}
 
-	return parts[0], parts[1], nil
+	return id[:i], id[i+1:], nil
 }
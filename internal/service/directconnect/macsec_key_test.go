@@ -13,9 +13,66 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfdirectconnect "github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestMacSecKeyParseID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		id                   string
+		expectedSecretARN    string
+		expectedConnectionID string
+		expectError          bool
+	}{
+		{
+			id:          "",
+			expectError: true,
+		},
+		{
+			id:          "dxcon-fg5678gh",
+			expectError: true,
+		},
+		{
+			id:          "_dxcon-fg5678gh",
+			expectError: true,
+		},
+		{
+			id:          "arn:aws:secretsmanager:us-east-1:123456789012:secret:example-AbCdEf_",
+			expectError: true,
+		},
+		{
+			id:                   "arn:aws:secretsmanager:us-east-1:123456789012:secret:example-AbCdEf_dxcon-fg5678gh",
+			expectedSecretARN:    "arn:aws:secretsmanager:us-east-1:123456789012:secret:example-AbCdEf",
+			expectedConnectionID: "dxcon-fg5678gh",
+		},
+		{
+			id:                   "arn:aws:secretsmanager:us-east-1:123456789012:secret:directconnect!prod/us-east-1/directconnect/my_ckn-AbCdEf_dxcon-fg5678gh",
+			expectedSecretARN:    "arn:aws:secretsmanager:us-east-1:123456789012:secret:directconnect!prod/us-east-1/directconnect/my_ckn-AbCdEf",
+			expectedConnectionID: "dxcon-fg5678gh",
+		},
+	}
+
+	for _, testCase := range testCases {
+		secretARN, connectionID, err := tfdirectconnect.MacSecKeyParseID(testCase.id)
+
+		if err == nil && testCase.expectError {
+			t.Errorf("%q: expected error", testCase.id)
+			continue
+		}
+
+		if err != nil && !testCase.expectError {
+			t.Errorf("%q: unexpected error: %s", testCase.id, err)
+			continue
+		}
+
+		if secretARN != testCase.expectedSecretARN || connectionID != testCase.expectedConnectionID {
+			t.Errorf("%q: got (%q, %q), expected (%q, %q)", testCase.id, secretARN, connectionID, testCase.expectedSecretARN, testCase.expectedConnectionID)
+		}
+	}
+}
+
 func TestAccDirectConnectMacSecKey_withCkn(t *testing.T) {
 	ctx := acctest.Context(t)
 	// Requires an existing MACsec-capable DX connection set as environmental variable
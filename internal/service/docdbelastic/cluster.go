@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -74,13 +75,20 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 			"arn": framework.ARNAttributeComputedOnly(),
 			"auth_type": schema.StringAttribute{
 				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 				Validators: []validator.String{
 					enum.FrameworkValidate[awstypes.Auth](),
 				},
 			},
+			"backup_retention_period": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 35),
+				},
+			},
 			"endpoint": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -102,6 +110,13 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"preferred_backup_window": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"preferred_maintenance_window": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -111,6 +126,9 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 			},
 			"shard_capacity": schema.Int64Attribute{
 				Required: true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(2, 4, 8, 16, 32, 64),
+				},
 			},
 			"shard_count": schema.Int64Attribute{
 				Required: true,
@@ -172,10 +190,18 @@ func (r *resourceCluster) Create(ctx context.Context, request resource.CreateReq
 		Tags:              getTagsIn(ctx),
 	}
 
+	if !plan.BackupRetentionPeriod.IsNull() && !plan.BackupRetentionPeriod.IsUnknown() {
+		input.BackupRetentionPeriod = flex.Int32FromFramework(ctx, plan.BackupRetentionPeriod)
+	}
+
 	if !plan.KmsKeyID.IsNull() || !plan.KmsKeyID.IsUnknown() {
 		input.KmsKeyId = flex.StringFromFramework(ctx, plan.KmsKeyID)
 	}
 
+	if !plan.PreferredBackupWindow.IsNull() && !plan.PreferredBackupWindow.IsUnknown() {
+		input.PreferredBackupWindow = flex.StringFromFramework(ctx, plan.PreferredBackupWindow)
+	}
+
 	if !plan.PreferredMaintenanceWindow.IsNull() || !plan.PreferredMaintenanceWindow.IsUnknown() {
 		input.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, plan.PreferredMaintenanceWindow)
 	}
@@ -276,6 +302,14 @@ func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateReq
 			input.AuthType = awstypes.Auth(plan.AuthType.ValueString())
 		}
 
+		if !plan.BackupRetentionPeriod.Equal(state.BackupRetentionPeriod) {
+			input.BackupRetentionPeriod = flex.Int32FromFramework(ctx, plan.BackupRetentionPeriod)
+		}
+
+		if !plan.PreferredBackupWindow.Equal(state.PreferredBackupWindow) {
+			input.PreferredBackupWindow = flex.StringFromFramework(ctx, plan.PreferredBackupWindow)
+		}
+
 		if !plan.PreferredMaintenanceWindow.Equal(state.PreferredMaintenanceWindow) {
 			input.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, plan.PreferredMaintenanceWindow)
 		}
@@ -381,10 +415,12 @@ type resourceClusterData struct {
 	AdminUserPassword          types.String   `tfsdk:"admin_user_password"`
 	ARN                        types.String   `tfsdk:"arn"`
 	AuthType                   types.String   `tfsdk:"auth_type"`
+	BackupRetentionPeriod      types.Int64    `tfsdk:"backup_retention_period"`
 	Endpoint                   types.String   `tfsdk:"endpoint"`
 	ID                         types.String   `tfsdk:"id"`
 	KmsKeyID                   types.String   `tfsdk:"kms_key_id"`
 	Name                       types.String   `tfsdk:"name"`
+	PreferredBackupWindow      types.String   `tfsdk:"preferred_backup_window"`
 	PreferredMaintenanceWindow types.String   `tfsdk:"preferred_maintenance_window"`
 	ShardCapacity              types.Int64    `tfsdk:"shard_capacity"`
 	ShardCount                 types.Int64    `tfsdk:"shard_count"`
@@ -490,9 +526,11 @@ func (r *resourceClusterData) refreshFromOutput(ctx context.Context, output *aws
 	r.AdminUserName = flex.StringToFrameworkLegacy(ctx, output.AdminUserName)
 	r.AuthType = flex.StringValueToFramework(ctx, string(output.AuthType))
 	r.ARN = flex.StringToFramework(ctx, output.ClusterArn)
+	r.BackupRetentionPeriod = flex.Int32ToFramework(ctx, output.BackupRetentionPeriod)
 	r.Endpoint = flex.StringToFramework(ctx, output.ClusterEndpoint)
 	r.KmsKeyID = flex.StringToFramework(ctx, output.KmsKeyId)
 	r.Name = flex.StringToFramework(ctx, output.ClusterName)
+	r.PreferredBackupWindow = flex.StringToFramework(ctx, output.PreferredBackupWindow)
 	r.PreferredMaintenanceWindow = flex.StringToFramework(ctx, output.PreferredMaintenanceWindow)
 	r.ShardCapacity = flex.Int32ToFramework(ctx, output.ShardCapacity)
 	r.ShardCount = flex.Int32ToFramework(ctx, output.ShardCount)
@@ -504,6 +542,8 @@ func clusterHasChanges(_ context.Context, plan, state resourceClusterData) bool
 	return !plan.Name.Equal(state.Name) ||
 		!plan.AdminUserPassword.Equal(state.AdminUserPassword) ||
 		!plan.AuthType.Equal(state.AuthType) ||
+		!plan.BackupRetentionPeriod.Equal(state.BackupRetentionPeriod) ||
+		!plan.PreferredBackupWindow.Equal(state.PreferredBackupWindow) ||
 		!plan.PreferredMaintenanceWindow.Equal(state.PreferredMaintenanceWindow) ||
 		!plan.ShardCapacity.Equal(state.ShardCapacity) ||
 		!plan.ShardCount.Equal(state.ShardCount) ||
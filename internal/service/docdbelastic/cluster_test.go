@@ -13,6 +13,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/docdbelastic/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -206,6 +207,103 @@ func TestAccDocDBElasticCluster_update(t *testing.T) {
 	})
 }
 
+func TestAccDocDBElasticCluster_backup(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var cluster awstypes.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdbelastic_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBElasticServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_backup(rName, 1, "01:00-01:30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "1"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_backup_window", "01:00-01:30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_user_password",
+				},
+			},
+			{
+				Config: testAccClusterConfig_backup(rName, 7, "02:00-02:30"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "7"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_backup_window", "02:00-02:30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDocDBElasticCluster_authType(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var cluster awstypes.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdbelastic_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBElasticServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "PLAIN_TEXT"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_authTypeSecretARN(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "SECRET_ARN"),
+					resource.TestCheckResourceAttrPair(resourceName, "admin_user_password", "aws_secretsmanager_secret.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckClusterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBElasticClient(ctx)
@@ -337,6 +435,77 @@ resource "aws_docdbelastic_cluster" "test" {
 `, rName, shardCapacity))
 }
 
+func testAccClusterConfig_backup(rName string, backupRetentionPeriod int, preferredBackupWindow string) string {
+	return acctest.ConfigCompose(
+		testAccClusterBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_docdbelastic_cluster" "test" {
+  name           = %[1]q
+  shard_capacity = 2
+  shard_count    = 1
+
+  admin_user_name     = "testuser"
+  admin_user_password = "testpassword"
+  auth_type           = "PLAIN_TEXT"
+
+  backup_retention_period = %[2]d
+  preferred_backup_window = %[3]q
+
+  preferred_maintenance_window = "tue:04:00-tue:04:30"
+
+  vpc_security_group_ids = [
+    aws_security_group.test.id
+  ]
+
+  subnet_ids = [
+    aws_subnet.test[0].id,
+    aws_subnet.test[1].id
+  ]
+}
+`, rName, backupRetentionPeriod, preferredBackupWindow))
+}
+
+func testAccClusterConfig_authTypeSecretARN(rName string) string {
+	return acctest.ConfigCompose(
+		testAccClusterBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({
+    username = "testuser"
+    password = "testpassword"
+  })
+}
+
+resource "aws_docdbelastic_cluster" "test" {
+  name           = %[1]q
+  shard_capacity = 2
+  shard_count    = 1
+
+  admin_user_name     = "testuser"
+  admin_user_password = aws_secretsmanager_secret.test.arn
+  auth_type           = "SECRET_ARN"
+
+  preferred_maintenance_window = "tue:04:00-tue:04:30"
+
+  vpc_security_group_ids = [
+    aws_security_group.test.id
+  ]
+
+  subnet_ids = [
+    aws_subnet.test[0].id,
+    aws_subnet.test[1].id
+  ]
+
+  depends_on = [aws_secretsmanager_secret_version.test]
+}
+`, rName))
+}
+
 func testAccClusterConfig_tags1(rName, key1, value1 string) string {
 	return acctest.ConfigCompose(
 		testAccClusterBaseConfig(rName),
@@ -6,8 +6,10 @@ package dynamodb
 // Exports for use in tests only.
 var (
 	ResourceKinesisStreamingDestination = resourceKinesisStreamingDestination
+	ResourceResourcePolicy              = resourceResourcePolicy
 	ResourceTag                         = resourceTag
 
 	FindKinesisDataStreamDestinationByTwoPartKey = findKinesisDataStreamDestinationByTwoPartKey
+	FindResourcePolicyByARN                      = findResourcePolicyByARN
 	ListTags                                     = listTags
 )
@@ -53,6 +53,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_dynamodb_kinesis_streaming_destination",
 			Name:     "Kinesis Streaming Destination",
 		},
+		{
+			Factory:  resourceResourcePolicy,
+			TypeName: "aws_dynamodb_resource_policy",
+			Name:     "Resource Policy",
+		},
 		{
 			Factory:  ResourceTable,
 			TypeName: "aws_dynamodb_table",
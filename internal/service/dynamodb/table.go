@@ -173,6 +173,7 @@ func ResourceTable() *schema.Resource {
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"on_demand_throughput": onDemandThroughputSchema(),
 						"projection_type": {
 							Type:         schema.TypeString,
 							Required:     true,
@@ -241,6 +242,7 @@ func ResourceTable() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"on_demand_throughput": onDemandThroughputSchema(),
 			"point_in_time_recovery": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -474,6 +476,28 @@ func ResourceTable() *schema.Resource {
 	}
 }
 
+func onDemandThroughputSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_read_request_units": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_write_request_units": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
 func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
@@ -575,6 +599,10 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 		tcp.ProvisionedThroughput = expandProvisionedThroughput(capacityMap, billingMode)
 
+		if v, ok := d.GetOk("on_demand_throughput"); ok {
+			tcp.OnDemandThroughput = expandOnDemandThroughput(v.([]interface{}))
+		}
+
 		if v, ok := d.GetOk("attribute"); ok {
 			aSet := v.(*schema.Set)
 			tcp.AttributeDefinitions = expandAttributes(aSet.List())
@@ -658,6 +686,10 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.LocalSecondaryIndexes = expandLocalSecondaryIndexes(lsiSet.List(), keySchemaMap)
 		}
 
+		if v, ok := d.GetOk("on_demand_throughput"); ok {
+			input.OnDemandThroughput = expandOnDemandThroughput(v.([]interface{}))
+		}
+
 		if v, ok := d.GetOk("global_secondary_index"); ok {
 			globalSecondaryIndexes := []*dynamodb.GlobalSecondaryIndex{}
 			gsiSet := v.(*schema.Set)
@@ -809,6 +841,10 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return create.AppendDiagSettingError(diags, names.DynamoDB, ResNameTable, d.Id(), "global_secondary_index", err)
 	}
 
+	if err := d.Set("on_demand_throughput", flattenOnDemandThroughput(table.OnDemandThroughput)); err != nil {
+		return create.AppendDiagSettingError(diags, names.DynamoDB, ResNameTable, d.Id(), "on_demand_throughput", err)
+	}
+
 	if table.StreamSpecification != nil {
 		d.Set("stream_enabled", table.StreamSpecification.StreamEnabled)
 		d.Set("stream_view_type", table.StreamSpecification.StreamViewType)
@@ -963,6 +999,11 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.DeletionProtectionEnabled = aws.Bool(d.Get("deletion_protection_enabled").(bool))
 	}
 
+	if d.HasChange("on_demand_throughput") {
+		hasTableUpdate = true
+		input.OnDemandThroughput = expandOnDemandThroughputUpdate(d.Get("on_demand_throughput").([]interface{}))
+	}
+
 	// make change when
 	//   stream_enabled has change (below) OR
 	//   stream_view_type has change and stream_enabled is true (special case)
@@ -990,17 +1031,20 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	// Phase 2 of Global Secondary Index Operations: Update Only
 	// Cannot create or delete index while updating table ProvisionedThroughput
-	// Must skip all index updates when switching BillingMode from PROVISIONED to PAY_PER_REQUEST
+	// Must skip all index capacity updates when switching BillingMode from PROVISIONED to PAY_PER_REQUEST
 	// Must update all indexes when switching BillingMode from PAY_PER_REQUEST to PROVISIONED
-	if billingMode == dynamodb.BillingModeProvisioned {
-		for _, gsiUpdate := range gsiUpdates {
-			if gsiUpdate.Update == nil {
-				continue
-			}
+	// Index OnDemandThroughput updates only apply with BillingMode PAY_PER_REQUEST
+	for _, gsiUpdate := range gsiUpdates {
+		if gsiUpdate.Update == nil {
+			continue
+		}
 
-			hasTableUpdate = true
-			input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, gsiUpdate)
+		if billingMode != dynamodb.BillingModeProvisioned && gsiUpdate.Update.OnDemandThroughput == nil {
+			continue
 		}
+
+		hasTableUpdate = true
+		input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, gsiUpdate)
 	}
 
 	if hasTableUpdate {
@@ -1578,6 +1622,7 @@ func UpdateDiffGSI(oldGsi, newGsi []interface{}, billingMode string) ([]*dynamod
 				Create: &dynamodb.CreateGlobalSecondaryIndexAction{
 					IndexName:             aws.String(idxName),
 					KeySchema:             expandKeySchema(m),
+					OnDemandThroughput:    expandOnDemandThroughputFromMap(m),
 					ProvisionedThroughput: expandProvisionedThroughput(m, billingMode),
 					Projection:            expandProjection(m),
 				},
@@ -1597,6 +1642,7 @@ func UpdateDiffGSI(oldGsi, newGsi []interface{}, billingMode string) ([]*dynamod
 			oldWriteCapacity, oldReadCapacity := oldMap["write_capacity"].(int), oldMap["read_capacity"].(int)
 			newWriteCapacity, newReadCapacity := newMap["write_capacity"].(int), newMap["read_capacity"].(int)
 			capacityChanged := (oldWriteCapacity != newWriteCapacity || oldReadCapacity != newReadCapacity)
+			onDemandThroughputChanged := !reflect.DeepEqual(oldMap["on_demand_throughput"], newMap["on_demand_throughput"])
 
 			// pluck non_key_attributes from oldAttributes and newAttributes as reflect.DeepEquals will compare
 			// ordinal of elements in its equality (which we actually don't care about)
@@ -1620,14 +1666,20 @@ func UpdateDiffGSI(oldGsi, newGsi []interface{}, billingMode string) ([]*dynamod
 			}
 			otherAttributesChanged := nonKeyAttributesChanged || !reflect.DeepEqual(oldAttributes, newAttributes)
 
-			if capacityChanged && !otherAttributesChanged {
-				update := &dynamodb.GlobalSecondaryIndexUpdate{
-					Update: &dynamodb.UpdateGlobalSecondaryIndexAction{
-						IndexName:             aws.String(idxName),
-						ProvisionedThroughput: expandProvisionedThroughput(newMap, billingMode),
-					},
+			if (capacityChanged || onDemandThroughputChanged) && !otherAttributesChanged {
+				action := &dynamodb.UpdateGlobalSecondaryIndexAction{
+					IndexName: aws.String(idxName),
+				}
+				if capacityChanged {
+					action.ProvisionedThroughput = expandProvisionedThroughput(newMap, billingMode)
 				}
-				ops = append(ops, update)
+				if onDemandThroughputChanged {
+					v, _ := newMap["on_demand_throughput"].([]interface{})
+					action.OnDemandThroughput = expandOnDemandThroughputUpdate(v)
+				}
+				ops = append(ops, &dynamodb.GlobalSecondaryIndexUpdate{
+					Update: action,
+				})
 			} else if otherAttributesChanged {
 				// Other attributes cannot be updated
 				ops = append(ops, &dynamodb.GlobalSecondaryIndexUpdate{
@@ -1640,6 +1692,7 @@ func UpdateDiffGSI(oldGsi, newGsi []interface{}, billingMode string) ([]*dynamod
 					Create: &dynamodb.CreateGlobalSecondaryIndexAction{
 						IndexName:             aws.String(idxName),
 						KeySchema:             expandKeySchema(newMap),
+						OnDemandThroughput:    expandOnDemandThroughputFromMap(newMap),
 						ProvisionedThroughput: expandProvisionedThroughput(newMap, billingMode),
 						Projection:            expandProjection(newMap),
 					},
@@ -2041,6 +2094,8 @@ func flattenTableGlobalSecondaryIndex(gsi []*dynamodb.GlobalSecondaryIndexDescri
 			gsi["non_key_attributes"] = aws.StringValueSlice(g.Projection.NonKeyAttributes)
 		}
 
+		gsi["on_demand_throughput"] = flattenOnDemandThroughput(g.OnDemandThroughput)
+
 		output = append(output, gsi)
 	}
 
@@ -2195,11 +2250,81 @@ func expandGlobalSecondaryIndex(data map[string]interface{}, billingMode string)
 	return &dynamodb.GlobalSecondaryIndex{
 		IndexName:             aws.String(data[names.AttrName].(string)),
 		KeySchema:             expandKeySchema(data),
+		OnDemandThroughput:    expandOnDemandThroughputFromMap(data),
 		Projection:            expandProjection(data),
 		ProvisionedThroughput: expandProvisionedThroughput(data, billingMode),
 	}
 }
 
+func expandOnDemandThroughputFromMap(data map[string]interface{}) *dynamodb.OnDemandThroughput {
+	if v, ok := data["on_demand_throughput"].([]interface{}); ok {
+		return expandOnDemandThroughput(v)
+	}
+
+	return nil
+}
+
+func expandOnDemandThroughput(tfList []interface{}) *dynamodb.OnDemandThroughput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &dynamodb.OnDemandThroughput{}
+
+	if v, ok := tfMap["max_read_request_units"].(int); ok && v != 0 {
+		apiObject.MaxReadRequestUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_write_request_units"].(int); ok && v != 0 {
+		apiObject.MaxWriteRequestUnits = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+// expandOnDemandThroughputUpdate returns the OnDemandThroughput for an update,
+// removing (-1) any maximum that is no longer configured.
+func expandOnDemandThroughputUpdate(tfList []interface{}) *dynamodb.OnDemandThroughput {
+	apiObject := expandOnDemandThroughput(tfList)
+	if apiObject == nil {
+		apiObject = &dynamodb.OnDemandThroughput{}
+	}
+
+	if apiObject.MaxReadRequestUnits == nil {
+		apiObject.MaxReadRequestUnits = aws.Int64(-1)
+	}
+
+	if apiObject.MaxWriteRequestUnits == nil {
+		apiObject.MaxWriteRequestUnits = aws.Int64(-1)
+	}
+
+	return apiObject
+}
+
+func flattenOnDemandThroughput(apiObject *dynamodb.OnDemandThroughput) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	// A value of -1 means that no maximum is set.
+	if v := aws.Int64Value(apiObject.MaxReadRequestUnits); v > 0 {
+		tfMap["max_read_request_units"] = v
+	}
+
+	if v := aws.Int64Value(apiObject.MaxWriteRequestUnits); v > 0 {
+		tfMap["max_write_request_units"] = v
+	}
+
+	if len(tfMap) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandProvisionedThroughput(data map[string]interface{}, billingMode string) *dynamodb.ProvisionedThroughput {
 	return expandProvisionedThroughputUpdate("", data, billingMode, "")
 }
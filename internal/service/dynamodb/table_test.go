@@ -309,6 +309,89 @@ func TestUpdateDiffGSI(t *testing.T) {
 				},
 			},
 		},
+
+		{ // Update on-demand throughput
+			Old: []interface{}{
+				map[string]interface{}{
+					names.AttrName:    "att1-index",
+					"hash_key":        "att1",
+					"write_capacity":  10,
+					"read_capacity":   10,
+					"projection_type": "ALL",
+					"on_demand_throughput": []interface{}{
+						map[string]interface{}{
+							"max_read_request_units":  5,
+							"max_write_request_units": 5,
+						},
+					},
+				},
+			},
+			New: []interface{}{
+				map[string]interface{}{
+					names.AttrName:    "att1-index",
+					"hash_key":        "att1",
+					"write_capacity":  10,
+					"read_capacity":   10,
+					"projection_type": "ALL",
+					"on_demand_throughput": []interface{}{
+						map[string]interface{}{
+							"max_read_request_units":  10,
+							"max_write_request_units": 5,
+						},
+					},
+				},
+			},
+			ExpectedUpdates: []*dynamodb.GlobalSecondaryIndexUpdate{
+				{
+					Update: &dynamodb.UpdateGlobalSecondaryIndexAction{
+						IndexName: aws.String("att1-index"),
+						OnDemandThroughput: &dynamodb.OnDemandThroughput{
+							MaxReadRequestUnits:  aws.Int64(10),
+							MaxWriteRequestUnits: aws.Int64(5),
+						},
+					},
+				},
+			},
+		},
+
+		{ // Remove on-demand throughput
+			Old: []interface{}{
+				map[string]interface{}{
+					names.AttrName:    "att1-index",
+					"hash_key":        "att1",
+					"write_capacity":  10,
+					"read_capacity":   10,
+					"projection_type": "ALL",
+					"on_demand_throughput": []interface{}{
+						map[string]interface{}{
+							"max_read_request_units":  5,
+							"max_write_request_units": 5,
+						},
+					},
+				},
+			},
+			New: []interface{}{
+				map[string]interface{}{
+					names.AttrName:         "att1-index",
+					"hash_key":             "att1",
+					"write_capacity":       10,
+					"read_capacity":        10,
+					"projection_type":      "ALL",
+					"on_demand_throughput": []interface{}{},
+				},
+			},
+			ExpectedUpdates: []*dynamodb.GlobalSecondaryIndexUpdate{
+				{
+					Update: &dynamodb.UpdateGlobalSecondaryIndexAction{
+						IndexName: aws.String("att1-index"),
+						OnDemandThroughput: &dynamodb.OnDemandThroughput{
+							MaxReadRequestUnits:  aws.Int64(-1),
+							MaxWriteRequestUnits: aws.Int64(-1),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -836,6 +919,66 @@ func TestAccDynamoDBTable_BillingModeGSI_provisionedToPayPerRequest(t *testing.T
 	})
 }
 
+func TestAccDynamoDBTable_onDemandThroughput(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf dynamodb.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_onDemandThroughput(rName, 5, 5, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.0.max_read_request_units", "5"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.0.max_write_request_units", "5"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						names.AttrName:           "TestTableGSI",
+						"on_demand_throughput.#": "1",
+						"on_demand_throughput.0.max_read_request_units": "5",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableConfig_onDemandThroughput(rName, 10, 6, 8),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.0.max_read_request_units", "10"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.0.max_write_request_units", "6"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						names.AttrName:           "TestTableGSI",
+						"on_demand_throughput.#": "1",
+						"on_demand_throughput.0.max_read_request_units": "8",
+					}),
+				),
+			},
+			{
+				Config: testAccTableConfig_billingPayPerRequestGSI(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.#", "0"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						names.AttrName:           "TestTableGSI",
+						"on_demand_throughput.#": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_streamSpecification(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf dynamodb.TableDescription
@@ -2916,6 +3059,41 @@ resource "aws_dynamodb_table" "test" {
 `, rName)
 }
 
+func testAccTableConfig_onDemandThroughput(rName string, maxRead, maxWrite, gsiMaxRead int) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "TestTableHashKey"
+
+  on_demand_throughput {
+    max_read_request_units  = %[2]d
+    max_write_request_units = %[3]d
+  }
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestTableGSIKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "TestTableGSI"
+    hash_key        = "TestTableGSIKey"
+    projection_type = "KEYS_ONLY"
+
+    on_demand_throughput {
+      max_read_request_units = %[4]d
+    }
+  }
+}
+`, rName, maxRead, maxWrite, gsiMaxRead)
+}
+
 func testAccTableConfig_billingPayPerRequestGSI(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "test" {
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -59,11 +61,16 @@ func waitImportComplete(ctx context.Context, conn *dynamodb.DynamoDB, importArn
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
+	output, ok := outputRaw.(*dynamodb.DescribeImportOutput)
+	if ok && output.ImportTableDescription != nil && output.ImportTableDescription.FailureCode != nil {
+		tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.ImportTableDescription.FailureCode), aws.StringValue(output.ImportTableDescription.FailureMessage)))
+	}
+
 	if err != nil {
 		err = fmt.Errorf("ImportArn %q : %w", importArn, err)
 	}
 
-	if output, ok := outputRaw.(*dynamodb.DescribeImportOutput); ok {
+	if ok {
 		return output, err
 	}
 
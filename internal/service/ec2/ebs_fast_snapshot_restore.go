@@ -6,6 +6,7 @@ package ec2
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -127,9 +128,13 @@ func (r *resourceEBSFastSnapshotRestore) Create(ctx context.Context, req resourc
 		return
 	}
 	if len(out.Unsuccessful) > 0 || len(out.Successful) != 1 {
+		err := errors.New("enable fast snapshot restore was unsuccessful")
+		if v := enableFastSnapshotRestoreErrors(out.Unsuccessful); v != nil {
+			err = v
+		}
 		resp.Diagnostics.AddError(
 			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameEBSFastSnapshotRestore, plan.SnapshotID.String(), nil),
-			errors.New("enable fast snapshot restore was unsuccessful").Error(),
+			err.Error(),
 		)
 		return
 	}
@@ -159,7 +164,7 @@ func (r *resourceEBSFastSnapshotRestore) Read(ctx context.Context, req resource.
 	}
 
 	out, err := findEBSFastSnapshotRestoreByID(ctx, conn, state.ID.ValueString())
-	if errors.Is(err, tfresource.ErrEmptyResult) || out.State == awstypes.FastSnapshotRestoreStateCodeDisabled {
+	if tfresource.NotFound(err) || (err == nil && out.State == awstypes.FastSnapshotRestoreStateCodeDisabled) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -230,6 +235,11 @@ func waitEBSFastSnapshotRestoreCreated(ctx context.Context, conn *ec2.Client, id
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*awstypes.DescribeFastSnapshotRestoreSuccessItem); ok {
+		// A failed enable transitions back to disabled with the reason recorded.
+		if out.State == awstypes.FastSnapshotRestoreStateCodeDisabled {
+			tfresource.SetLastError(err, errors.New(aws.ToString(out.StateTransitionReason)))
+		}
+
 		return out, err
 	}
 
@@ -297,6 +307,22 @@ func findEBSFastSnapshotRestoreByID(ctx context.Context, conn *ec2.Client, id st
 	return &out.FastSnapshotRestores[0], nil
 }
 
+func enableFastSnapshotRestoreErrors(apiObjects []awstypes.EnableFastSnapshotRestoreErrorItem) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+			if v.Error == nil {
+				continue
+			}
+
+			errs = append(errs, fmt.Errorf("%s (%s): %s: %s", aws.ToString(apiObject.SnapshotId), aws.ToString(v.AvailabilityZone), aws.ToString(v.Error.Code), aws.ToString(v.Error.Message)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 type resourceEBSFastSnapshotRestoreData struct {
 	AvailabilityZone types.String   `tfsdk:"availability_zone"`
 	ID               types.String   `tfsdk:"id"`
@@ -41,6 +41,7 @@ func TestAccEC2EBSFastSnapshotRestore_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckEBSFastSnapshotRestoreExists(ctx, resourceName),
 					resource.TestCheckResourceAttrPair(resourceName, "snapshot_id", snapshotResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "enabled"),
 				),
 			},
 			{
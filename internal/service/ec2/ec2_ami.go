@@ -81,6 +81,23 @@ func ResourceAMI() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"with_cooldown": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -381,6 +398,14 @@ func resourceAMICreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if v, ok := d.GetOk("deregistration_protection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if tfMap := v.([]interface{})[0].(map[string]interface{}); tfMap["enabled"].(bool) {
+			if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), tfMap["with_cooldown"].(bool)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s): %s", name, err)
+			}
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -428,6 +453,9 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("boot_mode", image.BootMode)
 	d.Set("description", image.Description)
 	d.Set("deprecation_time", image.DeprecationTime)
+	if err := d.Set("deregistration_protection", flattenImageDeregistrationProtection(aws.StringValue(image.DeregistrationProtection), d.Get("deregistration_protection").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting deregistration_protection: %s", err)
+	}
 	d.Set("ena_support", image.EnaSupport)
 	d.Set("hypervisor", image.Hypervisor)
 	d.Set("image_location", image.ImageLocation)
@@ -484,6 +512,24 @@ func resourceAMIUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.HasChange("deregistration_protection") {
+		var enabled, withCooldown bool
+		if v, ok := d.GetOk("deregistration_protection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+			enabled, withCooldown = tfMap["enabled"].(bool), tfMap["with_cooldown"].(bool)
+		}
+
+		if enabled {
+			if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), withCooldown); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		} else {
+			if err := disableImageDeregistrationProtection(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -601,6 +647,72 @@ func disableImageDeprecation(ctx context.Context, conn *ec2.EC2, id string) erro
 	return nil
 }
 
+func enableImageDeregistrationProtection(ctx context.Context, conn *ec2.EC2, id string, withCooldown bool) error {
+	input := &ec2.EnableImageDeregistrationProtectionInput{
+		ImageId:      aws.String(id),
+		WithCooldown: aws.Bool(withCooldown),
+	}
+
+	_, err := conn.EnableImageDeregistrationProtectionWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("enabling deregistration protection: %w", err)
+	}
+
+	err = waitImageDeregistrationProtectionUpdated(ctx, conn, id, true)
+
+	if err != nil {
+		return fmt.Errorf("enabling deregistration protection: waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+func disableImageDeregistrationProtection(ctx context.Context, conn *ec2.EC2, id string) error {
+	input := &ec2.DisableImageDeregistrationProtectionInput{
+		ImageId: aws.String(id),
+	}
+
+	_, err := conn.DisableImageDeregistrationProtectionWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("disabling deregistration protection: %w", err)
+	}
+
+	err = waitImageDeregistrationProtectionUpdated(ctx, conn, id, false)
+
+	if err != nil {
+		return fmt.Errorf("disabling deregistration protection: waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+// imageDeregistrationProtectionEnabled reports whether the DescribeImages DeregistrationProtection value
+// ("enabled", "enabled-with-cooldown", "disabled" or "disabled-until <timestamp>") indicates protection is on.
+func imageDeregistrationProtectionEnabled(v string) bool {
+	return strings.HasPrefix(v, "enabled")
+}
+
+func flattenImageDeregistrationProtection(v string, configured []interface{}) []interface{} {
+	if imageDeregistrationProtectionEnabled(v) {
+		return []interface{}{map[string]interface{}{
+			"enabled":       true,
+			"with_cooldown": strings.HasSuffix(v, "with-cooldown"),
+		}}
+	}
+
+	// Only report disabled protection if it has been explicitly configured.
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"enabled":       false,
+		"with_cooldown": configured[0].(map[string]interface{})["with_cooldown"],
+	}}
+}
+
 func expandBlockDeviceMappingForAMIEBSBlockDevice(tfMap map[string]interface{}) *ec2.BlockDeviceMapping {
 	if tfMap == nil {
 		return nil
@@ -890,6 +1002,27 @@ func waitImageDeprecationTimeUpdated(ctx context.Context, conn *ec2.EC2, imageID
 	)
 }
 
+func waitImageDeregistrationProtectionUpdated(ctx context.Context, conn *ec2.EC2, imageID string, expectedValue bool) error {
+	return tfresource.WaitUntil(ctx, imageDeprecationPropagationTimeout, func() (bool, error) {
+		output, err := FindImageByID(ctx, conn, imageID)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return imageDeregistrationProtectionEnabled(aws.StringValue(output.DeregistrationProtection)) == expectedValue, nil
+	},
+		tfresource.WaitOpts{
+			Delay:      amiRetryDelay,
+			MinTimeout: amiRetryMinTimeout,
+		},
+	)
+}
+
 func waitImageDeprecationTimeDisabled(ctx context.Context, conn *ec2.EC2, imageID string) error {
 	return tfresource.WaitUntil(ctx, imageDeprecationPropagationTimeout, func() (bool, error) {
 		output, err := FindImageByID(ctx, conn, imageID)
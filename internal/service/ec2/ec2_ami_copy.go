@@ -61,6 +61,23 @@ func ResourceAMICopy() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"with_cooldown": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -315,5 +332,13 @@ func resourceAMICopyCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if v, ok := d.GetOk("deregistration_protection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if tfMap := v.([]interface{})[0].(map[string]interface{}); tfMap["enabled"].(bool) {
+			if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), tfMap["with_cooldown"].(bool)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s) from source EC2 AMI (%s): %s", name, sourceImageID, err)
+			}
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
@@ -60,6 +60,23 @@ func ResourceAMIFromInstance() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"with_cooldown": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -283,5 +300,13 @@ func resourceAMIFromInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if v, ok := d.GetOk("deregistration_protection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if tfMap := v.([]interface{})[0].(map[string]interface{}); tfMap["enabled"].(bool) {
+			if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), tfMap["with_cooldown"].(bool)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s) from EC2 Instance (%s): %s", name, instanceID, err)
+			}
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
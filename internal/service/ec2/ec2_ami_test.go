@@ -147,6 +147,48 @@ func TestAccEC2AMI_deprecateAt(t *testing.T) {
 	})
 }
 
+func TestAccEC2AMI_deregistrationProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ami ec2.Image
+	resourceName := "aws_ami.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAMIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIConfig_deregistrationProtection(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAMIExists(ctx, resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection.0.with_cooldown", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"manage_ebs_snapshots",
+				},
+			},
+			{
+				// Protection must be disabled before the AMI can be deregistered.
+				Config: testAccAMIConfig_deregistrationProtection(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAMIExists(ctx, resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2AMI_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ami ec2.Image
@@ -660,6 +702,28 @@ resource "aws_ami" "test" {
 `, rName))
 }
 
+func testAccAMIConfig_deregistrationProtection(rName string, enabled bool) string {
+	return acctest.ConfigCompose(
+		testAccAMIConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_ami" "test" {
+  ena_support         = true
+  name                = %[1]q
+  root_device_name    = "/dev/sda1"
+  virtualization_type = "hvm"
+
+  deregistration_protection {
+    enabled = %[2]t
+  }
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = aws_ebs_snapshot.test.id
+  }
+}
+`, rName, enabled))
+}
+
 func testAccAMIConfig_desc(rName, desc string) string {
 	return acctest.ConfigCompose(
 		testAccAMIConfig_base(rName),
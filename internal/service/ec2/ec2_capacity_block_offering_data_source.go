@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Capacity Block Offering")
+func newDataSourceCapacityBlockOffering(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceCapacityBlockOffering{}, nil
+}
+
+type dataSourceCapacityBlockOffering struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceCapacityBlockOffering) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_ec2_capacity_block_offering"
+}
+
+func (d *dataSourceCapacityBlockOffering) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"availability_zone": schema.StringAttribute{
+				Computed: true,
+			},
+			"capacity_block_offering_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"capacity_duration_hours": schema.Int64Attribute{
+				Required: true,
+			},
+			"currency_code": schema.StringAttribute{
+				Computed: true,
+			},
+			"end_date_range": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"instance_count": schema.Int64Attribute{
+				Required: true,
+			},
+			"instance_type": schema.StringAttribute{
+				Required: true,
+			},
+			"start_date_range": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Optional:   true,
+			},
+			"tenancy": schema.StringAttribute{
+				Computed: true,
+			},
+			"upfront_fee": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceCapacityBlockOffering) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceCapacityBlockOfferingData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Client(ctx)
+
+	input := &ec2.DescribeCapacityBlockOfferingsInput{}
+
+	response.Diagnostics.Append(flex.Expand(ctx, &data, input)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findCapacityBlockOffering(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("reading EC2 Capacity Block Offering", tfresource.SingularDataSourceFindError("EC2 Capacity Block Offering", err)))
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = flex.StringToFramework(ctx, output.CapacityBlockOfferingId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findCapacityBlockOffering(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCapacityBlockOfferingsInput) (*awstypes.CapacityBlockOffering, error) {
+	output, err := findCapacityBlockOfferings(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCapacityBlockOfferings(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCapacityBlockOfferingsInput) ([]awstypes.CapacityBlockOffering, error) {
+	var output []awstypes.CapacityBlockOffering
+
+	pages := ec2.NewDescribeCapacityBlockOfferingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CapacityBlockOfferings...)
+	}
+
+	return output, nil
+}
+
+type dataSourceCapacityBlockOfferingData struct {
+	AvailabilityZone        types.String      `tfsdk:"availability_zone"`
+	CapacityBlockOfferingId types.String      `tfsdk:"capacity_block_offering_id"`
+	CapacityDurationHours   types.Int64       `tfsdk:"capacity_duration_hours"`
+	CurrencyCode            types.String      `tfsdk:"currency_code"`
+	EndDateRange            fwtypes.Timestamp `tfsdk:"end_date_range"`
+	ID                      types.String      `tfsdk:"id"`
+	InstanceCount           types.Int64       `tfsdk:"instance_count"`
+	InstanceType            types.String      `tfsdk:"instance_type"`
+	StartDateRange          fwtypes.Timestamp `tfsdk:"start_date_range"`
+	Tenancy                 types.String      `tfsdk:"tenancy"`
+	UpfrontFee              types.String      `tfsdk:"upfront_fee"`
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2CapacityBlockOfferingDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_capacity_block_offering.test"
+	startDate := time.Now().UTC().Add(25 * time.Hour).Format(time.RFC3339)
+	endDate := time.Now().UTC().Add(720 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityBlockOfferingConfig_basic(startDate, endDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "availability_zone"),
+					resource.TestCheckResourceAttr(dataSourceName, "capacity_duration_hours", "24"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "capacity_block_offering_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "currency_code"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_type", "p4d.24xlarge"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "tenancy"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "upfront_fee"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCapacityBlockOfferingConfig_basic(startDate, endDate string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_capacity_block_offering" "test" {
+  capacity_duration_hours = 24
+  end_date_range          = %[2]q
+  instance_count          = 1
+  instance_type           = "p4d.24xlarge"
+  start_date_range        = %[1]q
+}
+`, startDate, endDate)
+}
@@ -0,0 +1,383 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Capacity Block Reservation")
+// @Tags(identifierAttribute="id")
+func newResourceCapacityBlockReservation(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceCapacityBlockReservation{}
+	r.SetDefaultCreateTimeout(40 * time.Minute)
+
+	return r, nil
+}
+
+type resourceCapacityBlockReservation struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceCapacityBlockReservation) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ec2_capacity_block_reservation"
+}
+
+func (r *resourceCapacityBlockReservation) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"availability_zone": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"capacity_block_offering_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_date": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ebs_optimized": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"end_date": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"end_date_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"instance_count": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_platform": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CapacityReservationInstancePlatform](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"outpost_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"placement_group_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reservation_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"tenancy": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceCapacityBlockReservation) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceCapacityBlockReservationData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := &ec2.PurchaseCapacityBlockInput{
+		TagSpecifications: getTagSpecificationsInV2(ctx, awstypes.ResourceTypeCapacityReservation),
+	}
+
+	response.Diagnostics.Append(flex.Expand(ctx, &data, input)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.PurchaseCapacityBlock(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("purchasing EC2 Capacity Block Reservation", err))
+
+		return
+	}
+
+	data.CapacityReservationId = types.StringPointerValue(output.CapacityReservation.CapacityReservationId)
+	id := data.CapacityReservationId.ValueString()
+
+	createTimeout := r.CreateTimeout(ctx, data.Timeouts)
+	capacityReservation, err := waitCapacityBlockReservationActive(ctx, conn, id, createTimeout)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for EC2 Capacity Block Reservation (%s) create", id), err))
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(flex.Flatten(ctx, capacityReservation, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCapacityBlockReservation) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceCapacityBlockReservationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.CapacityReservationId.ValueString()
+	capacityReservation, err := findCapacityBlockReservationByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading EC2 Capacity Block Reservation (%s)", id), err))
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, capacityReservation, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOutV2(ctx, capacityReservation.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCapacityBlockReservation) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Tags only.
+}
+
+func (r *resourceCapacityBlockReservation) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	// Capacity Block Reservations cannot be cancelled; they expire at their end date.
+	response.Diagnostics.AddWarning(
+		"EC2 Capacity Block Reservation cannot be deleted",
+		"Capacity Block Reservations cannot be cancelled and remain in effect until their end date. The reservation has been removed from Terraform state only.",
+	)
+}
+
+func (r *resourceCapacityBlockReservation) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findCapacityBlockReservation(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCapacityReservationsInput) (*awstypes.CapacityReservation, error) {
+	output, err := findCapacityBlockReservations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCapacityBlockReservations(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCapacityReservationsInput) ([]awstypes.CapacityReservation, error) {
+	var output []awstypes.CapacityReservation
+
+	pages := ec2.NewDescribeCapacityReservationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidCapacityReservationIdNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CapacityReservations...)
+	}
+
+	return output, nil
+}
+
+func findCapacityBlockReservationByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.CapacityReservation, error) {
+	input := &ec2.DescribeCapacityReservationsInput{
+		CapacityReservationIds: []string{id},
+	}
+
+	output, err := findCapacityBlockReservation(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/capacity-reservations-using.html#capacity-reservations-view.
+	if state := output.State; state == awstypes.CapacityReservationStateCancelled || state == awstypes.CapacityReservationStateExpired {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.CapacityReservationId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusCapacityBlockReservation(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCapacityBlockReservationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitCapacityBlockReservationActive(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.CapacityReservation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CapacityReservationStatePaymentPending),
+		Target:  enum.Slice(awstypes.CapacityReservationStateActive, awstypes.CapacityReservationStateScheduled),
+		Refresh: statusCapacityBlockReservation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CapacityReservation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type resourceCapacityBlockReservationData struct {
+	CapacityReservationArn  types.String                                                     `tfsdk:"arn"`
+	AvailabilityZone        types.String                                                     `tfsdk:"availability_zone"`
+	CapacityBlockOfferingId types.String                                                     `tfsdk:"capacity_block_offering_id"`
+	CreateDate              fwtypes.Timestamp                                                `tfsdk:"created_date"`
+	EbsOptimized            types.Bool                                                       `tfsdk:"ebs_optimized"`
+	EndDate                 fwtypes.Timestamp                                                `tfsdk:"end_date"`
+	EndDateType             types.String                                                     `tfsdk:"end_date_type"`
+	CapacityReservationId   types.String                                                     `tfsdk:"id"`
+	TotalInstanceCount      types.Int64                                                      `tfsdk:"instance_count"`
+	InstancePlatform        fwtypes.StringEnum[awstypes.CapacityReservationInstancePlatform] `tfsdk:"instance_platform"`
+	InstanceType            types.String                                                     `tfsdk:"instance_type"`
+	OutpostArn              types.String                                                     `tfsdk:"outpost_arn"`
+	PlacementGroupArn       types.String                                                     `tfsdk:"placement_group_arn"`
+	ReservationType         types.String                                                     `tfsdk:"reservation_type"`
+	StartDate               fwtypes.Timestamp                                                `tfsdk:"start_date"`
+	Tags                    types.Map                                                        `tfsdk:"tags"`
+	TagsAll                 types.Map                                                        `tfsdk:"tags_all"`
+	Tenancy                 types.String                                                     `tfsdk:"tenancy"`
+	Timeouts                timeouts.Value                                                   `tfsdk:"timeouts"`
+}
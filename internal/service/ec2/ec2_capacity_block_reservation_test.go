@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2CapacityBlockReservation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	acctest.SkipIfEnvVarNotSet(t, "RUN_EC2_CAPACITY_BLOCK_RESERVATION_TESTS")
+
+	startDate := time.Now().UTC().Add(25 * time.Hour).Format(time.RFC3339)
+	endDate := time.Now().UTC().Add(720 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_ec2_capacity_block_reservation.test"
+	dataSourceName := "data.aws_ec2_capacity_block_offering.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityBlockReservationConfig_basic(startDate, endDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityBlockReservationExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexache.MustCompile(`capacity-reservation/cr-.+`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "availability_zone", resourceName, "availability_zone"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "capacity_block_offering_id", resourceName, "capacity_block_offering_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_count", resourceName, "instance_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_type", resourceName, "instance_type"),
+					resource.TestCheckResourceAttr(resourceName, "instance_platform", "Linux/UNIX"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_type", "capacity-block"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tenancy", resourceName, "tenancy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityBlockReservationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindCapacityBlockReservationByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCapacityBlockReservationConfig_basic(startDate, endDate string) string {
+	return acctest.ConfigCompose(testAccCapacityBlockOfferingConfig_basic(startDate, endDate), `
+resource "aws_ec2_capacity_block_reservation" "test" {
+  capacity_block_offering_id = data.aws_ec2_capacity_block_offering.test.capacity_block_offering_id
+  instance_platform          = "Linux/UNIX"
+
+  tags = {
+    "Environment" = "dev"
+  }
+}
+`)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_eip_transfer_accepter", name="EIP Transfer Accepter")
+func ResourceEIPTransferAccepter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEIPTransferAccepterCreate,
+		ReadWithoutTimeout:   resourceEIPTransferAccepterRead,
+		DeleteWithoutTimeout: resourceEIPTransferAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"allocation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ipv4_pool": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEIPTransferAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	address := d.Get("address").(string)
+	input := &ec2.AcceptAddressTransferInput{
+		Address: aws.String(address),
+	}
+
+	_, err := conn.AcceptAddressTransferWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "accepting EC2 EIP (%s) transfer: %s", address, err)
+	}
+
+	// The transferred address is allocated a new ID in the accepting account.
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, ec2PropagationTimeout, func() (interface{}, error) {
+		return FindEIPByPublicIP(ctx, conn, address)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 EIP (%s): %s", address, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*ec2.Address).AllocationId))
+
+	return append(diags, resourceEIPTransferAccepterRead(ctx, d, meta)...)
+}
+
+func resourceEIPTransferAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	address, err := FindEIPByAllocationID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 EIP Transfer Accepter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 EIP Transfer Accepter (%s): %s", d.Id(), err)
+	}
+
+	d.Set("address", address.PublicIp)
+	d.Set("allocation_id", address.AllocationId)
+	d.Set("domain", address.Domain)
+	d.Set("public_ipv4_pool", address.PublicIpv4Pool)
+
+	return diags
+}
+
+func resourceEIPTransferAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	log.Printf("[INFO] Releasing transferred EC2 EIP: %s", d.Id())
+	_, err := conn.ReleaseAddressWithContext(ctx, &ec2.ReleaseAddressInput{
+		AllocationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "releasing transferred EC2 EIP (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2EIPTransferAccepter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_eip_transfer_accepter.test"
+	eipResourceName := "aws_eip.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckEIPTransferDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPTransferAccepterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "address", eipResourceName, "public_ip"),
+					resource.TestMatchResourceAttr(resourceName, "allocation_id", regexache.MustCompile(`^eipalloc-`)),
+					resource.TestCheckResourceAttr(resourceName, "domain", "vpc"),
+				),
+				// The source account's aws_eip no longer exists once the transfer has been accepted.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccEIPTransferAccepterConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEIPTransferConfig_basic(rName), `
+resource "aws_eip_transfer_accepter" "test" {
+  provider = "awsalternate"
+
+  address = aws_eip_transfer.test.public_ip
+}
+`)
+}
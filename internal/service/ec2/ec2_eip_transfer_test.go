@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2EIPTransfer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.AddressTransfer
+	resourceName := "aws_eip_transfer.test"
+	eipResourceName := "aws_eip.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckEIPTransferDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPTransferConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPTransferExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "address_transfer_status", "pending"),
+					resource.TestCheckResourceAttrPair(resourceName, "allocation_id", eipResourceName, "allocation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", eipResourceName, "public_ip"),
+					resource.TestCheckResourceAttrPair(resourceName, "transfer_account_id", "data.aws_caller_identity.target", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "transfer_offer_accepted_timestamp", ""),
+					acctest.CheckResourceAttrRFC3339(resourceName, "transfer_offer_expiration_timestamp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2EIPTransfer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.AddressTransfer
+	resourceName := "aws_eip_transfer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckEIPTransferDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPTransferConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPTransferExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceEIPTransfer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEIPTransferExists(ctx context.Context, n string, v *ec2.AddressTransfer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindAddressTransferByAllocationID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEIPTransferDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_eip_transfer" {
+				continue
+			}
+
+			output, err := tfec2.FindAddressTransferByAllocationID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if aws.StringValue(output.AddressTransferStatus) == ec2.AddressTransferStatusAccepted {
+				continue
+			}
+
+			return fmt.Errorf("EC2 EIP Transfer %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEIPTransferConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "target" {
+  provider = "awsalternate"
+}
+
+resource "aws_eip" "test" {
+  domain = "vpc"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccEIPTransferConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEIPTransferConfig_base(rName), `
+resource "aws_eip_transfer" "test" {
+  allocation_id       = aws_eip.test.allocation_id
+  transfer_account_id = data.aws_caller_identity.target.account_id
+}
+`)
+}
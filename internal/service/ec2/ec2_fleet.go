@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -482,21 +483,22 @@ func ResourceFleet() *schema.Resource {
 							Default:      FleetOnDemandAllocationStrategyLowestPrice,
 							ValidateFunc: validation.StringInSlice(FleetOnDemandAllocationStrategy_Values(), false),
 						},
-						// Pending AWS to provide this attribute back in the `Describe` call
-						// "capacity_reservation_options": {
-						// 	Type:     schema.TypeList,
-						// 	Optional: true,
-						// 	MaxItems: 1,
-						// 	Elem: &schema.Resource{
-						// 		Schema: map[string]*schema.Schema{
-						// 			"usage_strategy": {
-						// 				Type:         schema.TypeString,
-						// 				Optional:     true,
-						// 				ValidateFunc: validation.StringInSlice(ec2.FleetCapacityReservationUsageStrategy_Values(), false),
-						// 			},
-						// 		},
-						// 	},
-						// },
+						"capacity_reservation_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"usage_strategy": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(ec2.FleetCapacityReservationUsageStrategy_Values(), false),
+									},
+								},
+							},
+						},
 						"max_total_price": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -817,7 +819,12 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendErrorf(diags, "setting launch_template_config: %s", err)
 	}
 	if fleet.OnDemandOptions != nil {
-		if err := d.Set("on_demand_options", []interface{}{flattenOnDemandOptions(fleet.OnDemandOptions)}); err != nil {
+		tfMap := flattenOnDemandOptions(fleet.OnDemandOptions)
+		// DescribeFleets does not return CapacityReservationOptions, so keep the configured value.
+		if fleet.OnDemandOptions.CapacityReservationOptions == nil {
+			tfMap["capacity_reservation_options"] = d.Get("on_demand_options.0.capacity_reservation_options")
+		}
+		if err := d.Set("on_demand_options", []interface{}{tfMap}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting on_demand_options: %s", err)
 		}
 	} else {
@@ -896,10 +903,14 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
+	fleetType := d.Get("type").(string)
+	// Instances launched by an instant fleet are always terminated when the fleet is deleted.
+	terminateInstances := d.Get("terminate_instances").(bool) || fleetType == ec2.FleetTypeInstant
+
 	log.Printf("[DEBUG] Deleting EC2 Fleet: %s", d.Id())
 	output, err := conn.DeleteFleetsWithContext(ctx, &ec2.DeleteFleetsInput{
 		FleetIds:           aws.StringSlice([]string{d.Id()}),
-		TerminateInstances: aws.Bool(d.Get("terminate_instances").(bool)),
+		TerminateInstances: aws.Bool(terminateInstances),
 	})
 
 	if err == nil && output != nil {
@@ -915,8 +926,22 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	// Limiting waiter to non-instant fleet types.
-	// `instant` fleet state is eventually consistent and can take 48 hours to update.
-	if d.Get("type") != "instant" {
+	// `instant` fleet state is eventually consistent and can take 48 hours to update,
+	// so wait for the launched instances to terminate instead.
+	if fleetType == ec2.FleetTypeInstant {
+		for _, tfMapRaw := range d.Get("fleet_instance_set").([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			for _, instanceID := range flex.ExpandStringValueList(tfMap["instance_ids"].([]interface{})) {
+				if _, err := waitInstanceDeleted(ctx, conn, instanceID, d.Timeout(schema.TimeoutDelete)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for EC2 Fleet (%s) instance (%s) terminate: %s", d.Id(), instanceID, err)
+				}
+			}
+		}
+	} else {
 		delay := 0 * time.Second
 		pendingStates := []string{ec2.FleetStateCodeActive}
 		targetStates := []string{ec2.FleetStateCodeDeleted}
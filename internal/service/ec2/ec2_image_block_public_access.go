@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"slices"
 	"time"
@@ -28,6 +29,17 @@ func ResourceImageBlockPublicAccess() *schema.Resource {
 		UpdateWithoutTimeout: resourceImageBlockPublicAccessPut,
 		DeleteWithoutTimeout: schema.NoopContext,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// The resource is a singleton in each Region.
+				if region := meta.(*conns.AWSClient).Region; d.Id() != region {
+					return nil, fmt.Errorf("importing EC2 Image Block Public Access: ID (%s) must be the provider Region (%s)", d.Id(), region)
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
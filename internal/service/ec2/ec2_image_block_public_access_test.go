@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -36,6 +37,17 @@ func testAccImageBlockPublicAccess_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "state", "unblocked"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: acctest.AlternateRegion(),
+				ExpectError:   regexache.MustCompile(`must be the provider Region`),
+			},
 			{
 				Config: testAccImageBlockPublicAccessConfig_basic("block-new-sharing"),
 				Check: resource.ComposeTestCheckFunc(
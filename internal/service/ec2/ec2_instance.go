@@ -17,6 +17,8 @@ import (
 	"time"
 
 	"github.com/YakDriver/regexache"
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -150,14 +152,12 @@ func ResourceInstance() *schema.Resource {
 							Type:          schema.TypeInt,
 							Optional:      true,
 							Computed:      true,
-							ForceNew:      true,
 							ConflictsWith: []string{"cpu_core_count"},
 						},
 						"threads_per_core": {
 							Type:          schema.TypeInt,
 							Optional:      true,
 							Computed:      true,
-							ForceNew:      true,
 							ConflictsWith: []string{"cpu_threads_per_core"},
 						},
 					},
@@ -305,7 +305,6 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 			"enclave_options": {
 				Type:     schema.TypeList,
@@ -1665,25 +1664,29 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChanges("instance_type", "user_data", "user_data_base64") && !d.IsNewResource() {
-		// For each argument change, we start and stop the instance
-		// to account for behaviors occurring outside terraform.
+	if d.HasChanges("cpu_options.0.core_count", "cpu_options.0.threads_per_core", "ebs_optimized", "instance_type", "user_data", "user_data_base64") && !d.IsNewResource() {
+		// The instance is stopped once, each change is applied and the instance is started again.
 		// Only one attribute can be modified at a time, else we get
 		// "InvalidParameterCombination: Fields for multiple attribute types specified"
-		if d.HasChange("instance_type") {
-			if !d.HasChange("capacity_reservation_specification.0.capacity_reservation_target.0.capacity_reservation_id") {
-				instanceType := d.Get("instance_type").(string)
-				input := &ec2.ModifyInstanceAttributeInput{
-					InstanceId: aws.String(d.Id()),
-					InstanceType: &ec2.AttributeValue{
-						Value: aws.String(instanceType),
-					},
-				}
+		var modifications []func(context.Context) error
 
-				if err := modifyInstanceAttributeWithStopStart(ctx, conn, input, fmt.Sprintf("InstanceType (%s)", instanceType)); err != nil {
-					return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) type: %s", d.Id(), err)
-				}
-			}
+		// An instance type change that accompanies a capacity reservation target change is made below.
+		if d.HasChange("instance_type") && !d.HasChange("capacity_reservation_specification.0.capacity_reservation_target.0.capacity_reservation_id") {
+			modifications = append(modifications, modifyInstanceAttributeFunc(conn, &ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				InstanceType: &ec2.AttributeValue{
+					Value: aws.String(d.Get("instance_type").(string)),
+				},
+			}, "InstanceType"))
+		}
+
+		if d.HasChange("ebs_optimized") {
+			modifications = append(modifications, modifyInstanceAttributeFunc(conn, &ec2.ModifyInstanceAttributeInput{
+				EbsOptimized: &ec2.AttributeBooleanValue{
+					Value: aws.Bool(d.Get("ebs_optimized").(bool)),
+				},
+				InstanceId: aws.String(d.Id()),
+			}, "EbsOptimized"))
 		}
 
 		// From the API reference:
@@ -1692,8 +1695,6 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		// Otherwise, you must provide base64-encoded text".
 
 		if d.HasChange("user_data") {
-			log.Printf("[INFO] Modifying user data %s", d.Id())
-
 			// Decode so the AWS SDK doesn't double encode
 			userData, err := base64.StdEncoding.DecodeString(d.Get("user_data").(string))
 			if err != nil {
@@ -1701,21 +1702,15 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				userData = []byte(d.Get("user_data").(string))
 			}
 
-			input := &ec2.ModifyInstanceAttributeInput{
+			modifications = append(modifications, modifyInstanceAttributeFunc(conn, &ec2.ModifyInstanceAttributeInput{
 				InstanceId: aws.String(d.Id()),
 				UserData: &ec2.BlobAttributeValue{
 					Value: userData,
 				},
-			}
-
-			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input, "UserData"); err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) user data: %s", d.Id(), err)
-			}
+			}, "UserData"))
 		}
 
 		if d.HasChange("user_data_base64") {
-			log.Printf("[INFO] Modifying user data base64 %s", d.Id())
-
 			// Schema validation technically ensures the data is Base64 encoded.
 			// Decode so the AWS SDK doesn't double encode
 			userData, err := base64.StdEncoding.DecodeString(d.Get("user_data_base64").(string))
@@ -1724,15 +1719,35 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				userData = []byte(d.Get("user_data_base64").(string))
 			}
 
-			input := &ec2.ModifyInstanceAttributeInput{
+			modifications = append(modifications, modifyInstanceAttributeFunc(conn, &ec2.ModifyInstanceAttributeInput{
 				InstanceId: aws.String(d.Id()),
 				UserData: &ec2.BlobAttributeValue{
 					Value: userData,
 				},
+			}, "UserData (base64)"))
+		}
+
+		if d.HasChanges("cpu_options.0.core_count", "cpu_options.0.threads_per_core") {
+			// ModifyInstanceCpuOptions requires both values.
+			input := &ec2_sdkv2.ModifyInstanceCpuOptionsInput{
+				CoreCount:      aws_sdkv2.Int32(int32(d.Get("cpu_options.0.core_count").(int))),
+				InstanceId:     aws_sdkv2.String(d.Id()),
+				ThreadsPerCore: aws_sdkv2.Int32(int32(d.Get("cpu_options.0.threads_per_core").(int))),
 			}
+			connV2 := meta.(*conns.AWSClient).EC2Client(ctx)
+
+			modifications = append(modifications, func(ctx context.Context) error {
+				if _, err := connV2.ModifyInstanceCpuOptions(ctx, input); err != nil {
+					return fmt.Errorf("modifying EC2 Instance (%s) CPU options: %w", d.Id(), err)
+				}
+
+				return nil
+			})
+		}
 
-			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input, "UserData (base64)"); err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) user data base64: %s", d.Id(), err)
+		if len(modifications) > 0 {
+			if err := modifyInstanceWithStopStart(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), modifications...); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
 			}
 		}
 	}
@@ -2103,22 +2118,33 @@ func disableInstanceAPITermination(ctx context.Context, conn *ec2.EC2, id string
 	return nil
 }
 
-// modifyInstanceAttributeWithStopStart modifies a specific attribute provided
-// as input by first stopping the EC2 instance before the modification
-// and then starting up the EC2 instance after modification.
-// Reference: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/Stop_Start.html
-func modifyInstanceAttributeWithStopStart(ctx context.Context, conn *ec2.EC2, input *ec2.ModifyInstanceAttributeInput, attrName string) error {
-	id := aws.StringValue(input.InstanceId)
+// modifyInstanceAttributeFunc returns a function that makes the attribute
+// modification provided as input, for use with modifyInstanceWithStopStart.
+func modifyInstanceAttributeFunc(conn *ec2.EC2, input *ec2.ModifyInstanceAttributeInput, attrName string) func(context.Context) error {
+	return func(ctx context.Context) error {
+		if _, err := conn.ModifyInstanceAttributeWithContext(ctx, input); err != nil {
+			return fmt.Errorf("modifying EC2 Instance (%s) %s attribute: %w", aws.StringValue(input.InstanceId), attrName, err)
+		}
 
-	if err := stopInstance(ctx, conn, id, false, InstanceStopTimeout); err != nil {
+		return nil
+	}
+}
+
+// modifyInstanceWithStopStart stops the EC2 instance, makes each of the
+// modifications provided as input and then starts the EC2 instance again.
+// Reference: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/Stop_Start.html
+func modifyInstanceWithStopStart(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration, modifications ...func(context.Context) error) error {
+	if err := stopInstance(ctx, conn, id, false, timeout); err != nil {
 		return err
 	}
 
-	if _, err := conn.ModifyInstanceAttributeWithContext(ctx, input); err != nil {
-		return fmt.Errorf("modifying EC2 Instance (%s) %s attribute: %w", id, attrName, err)
+	for _, modify := range modifications {
+		if err := modify(ctx); err != nil {
+			return err
+		}
 	}
 
-	if err := startInstance(ctx, conn, id, true, InstanceStartTimeout); err != nil {
+	if err := startInstance(ctx, conn, id, true, timeout); err != nil {
 		return err
 	}
 
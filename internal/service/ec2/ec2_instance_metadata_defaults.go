@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// instanceMetadataDefaultsNoPreference is used to remove an account-level instance metadata default.
+	instanceMetadataDefaultsNoPreference = "no-preference"
+	// instanceMetadataDefaultsHopLimitNoPreference is used to remove the account-level default hop limit.
+	instanceMetadataDefaultsHopLimitNoPreference = -1
+)
+
+// @SDKResource("aws_ec2_instance_metadata_defaults", name="Instance Metadata Defaults")
+func ResourceInstanceMetadataDefaults() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceMetadataDefaultsPut,
+		ReadWithoutTimeout:   resourceInstanceMetadataDefaultsRead,
+		UpdateWithoutTimeout: resourceInstanceMetadataDefaultsPut,
+		DeleteWithoutTimeout: resourceInstanceMetadataDefaultsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"http_endpoint": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          instanceMetadataDefaultsNoPreference,
+				ValidateDiagFunc: enum.Validate[types.DefaultInstanceMetadataEndpointState](),
+			},
+			"http_put_response_hop_limit": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  instanceMetadataDefaultsHopLimitNoPreference,
+				ValidateFunc: validation.Any(
+					validation.IntInSlice([]int{instanceMetadataDefaultsHopLimitNoPreference}),
+					validation.IntBetween(1, 64),
+				),
+			},
+			"http_tokens": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          instanceMetadataDefaultsNoPreference,
+				ValidateDiagFunc: enum.Validate[types.MetadataDefaultHttpTokensState](),
+			},
+			"instance_metadata_tags": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          instanceMetadataDefaultsNoPreference,
+				ValidateDiagFunc: enum.Validate[types.DefaultInstanceMetadataTagsState](),
+			},
+		},
+	}
+}
+
+func resourceInstanceMetadataDefaultsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.ModifyInstanceMetadataDefaultsInput{
+		HttpEndpoint:            types.DefaultInstanceMetadataEndpointState(d.Get("http_endpoint").(string)),
+		HttpPutResponseHopLimit: aws.Int32(int32(d.Get("http_put_response_hop_limit").(int))),
+		HttpTokens:              types.MetadataDefaultHttpTokensState(d.Get("http_tokens").(string)),
+		InstanceMetadataTags:    types.DefaultInstanceMetadataTagsState(d.Get("instance_metadata_tags").(string)),
+	}
+
+	_, err := conn.ModifyInstanceMetadataDefaults(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "modifying EC2 Instance Metadata Defaults: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return append(diags, resourceInstanceMetadataDefaultsRead(ctx, d, meta)...)
+}
+
+func resourceInstanceMetadataDefaultsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	output, err := FindInstanceMetadataDefaults(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Instance Metadata Defaults %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance Metadata Defaults (%s): %s", d.Id(), err)
+	}
+
+	// Defaults that have not been set are not returned.
+	httpEndpoint := string(output.HttpEndpoint)
+	if httpEndpoint == "" {
+		httpEndpoint = instanceMetadataDefaultsNoPreference
+	}
+	d.Set("http_endpoint", httpEndpoint)
+	if v := output.HttpPutResponseHopLimit; v != nil {
+		d.Set("http_put_response_hop_limit", v)
+	} else {
+		d.Set("http_put_response_hop_limit", instanceMetadataDefaultsHopLimitNoPreference)
+	}
+	httpTokens := string(output.HttpTokens)
+	if httpTokens == "" {
+		httpTokens = instanceMetadataDefaultsNoPreference
+	}
+	d.Set("http_tokens", httpTokens)
+	instanceMetadataTags := string(output.InstanceMetadataTags)
+	if instanceMetadataTags == "" {
+		instanceMetadataTags = instanceMetadataDefaultsNoPreference
+	}
+	d.Set("instance_metadata_tags", instanceMetadataTags)
+
+	return diags
+}
+
+func resourceInstanceMetadataDefaultsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// Removing the resource removes all account-level defaults.
+	log.Printf("[DEBUG] Deleting EC2 Instance Metadata Defaults: %s", d.Id())
+	_, err := conn.ModifyInstanceMetadataDefaults(ctx, &ec2.ModifyInstanceMetadataDefaultsInput{
+		HttpEndpoint:            types.DefaultInstanceMetadataEndpointStateNoPreference,
+		HttpPutResponseHopLimit: aws.Int32(instanceMetadataDefaultsHopLimitNoPreference),
+		HttpTokens:              types.MetadataDefaultHttpTokensStateNoPreference,
+		InstanceMetadataTags:    types.DefaultInstanceMetadataTagsStateNoPreference,
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "resetting EC2 Instance Metadata Defaults (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
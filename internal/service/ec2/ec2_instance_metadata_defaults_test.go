@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2InstanceMetadataDefaults_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		"basic": testAccInstanceMetadataDefaults_basic,
+		"empty": testAccInstanceMetadataDefaults_empty,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccInstanceMetadataDefaults_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_metadata_defaults.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceMetadataDefaultsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceMetadataDefaultsConfig_basic("required", 1, "enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "http_endpoint", "enabled"),
+					resource.TestCheckResourceAttr(resourceName, "http_put_response_hop_limit", "1"),
+					resource.TestCheckResourceAttr(resourceName, "http_tokens", "required"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", "enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceMetadataDefaultsConfig_basic("optional", 2, "disabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "http_endpoint", "enabled"),
+					resource.TestCheckResourceAttr(resourceName, "http_put_response_hop_limit", "2"),
+					resource.TestCheckResourceAttr(resourceName, "http_tokens", "optional"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceMetadataDefaults_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_metadata_defaults.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceMetadataDefaultsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceMetadataDefaultsConfig_empty(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "http_endpoint", "no-preference"),
+					resource.TestCheckResourceAttr(resourceName, "http_put_response_hop_limit", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "http_tokens", "no-preference"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", "no-preference"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceMetadataDefaultsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_instance_metadata_defaults" {
+				continue
+			}
+
+			output, err := tfec2.FindInstanceMetadataDefaults(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if output.HttpEndpoint != "" || output.HttpPutResponseHopLimit != nil || output.HttpTokens != "" || output.InstanceMetadataTags != "" {
+				return fmt.Errorf("EC2 Instance Metadata Defaults %s still set", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccInstanceMetadataDefaultsConfig_basic(httpTokens string, hopLimit int, instanceMetadataTags string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_instance_metadata_defaults" "test" {
+  http_endpoint               = "enabled"
+  http_tokens                 = %[1]q
+  http_put_response_hop_limit = %[2]d
+  instance_metadata_tags      = %[3]q
+}
+`, httpTokens, hopLimit, instanceMetadataTags)
+}
+
+func testAccInstanceMetadataDefaultsConfig_empty() string {
+	return `
+resource "aws_ec2_instance_metadata_defaults" "test" {}
+`
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -745,6 +746,30 @@ func ResourceLaunchTemplate() *schema.Resource {
 							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
 							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
 						},
+						"connection_tracking_specification": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tcp_established_timeout": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(60, 432000),
+									},
+									"udp_stream_timeout": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(60, 180),
+									},
+									"udp_timeout": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(30, 60),
+									},
+								},
+							},
+						},
 						"delete_on_termination": {
 							Type:             nullable.TypeNullableBool,
 							Optional:         true,
@@ -759,6 +784,32 @@ func ResourceLaunchTemplate() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 						},
+						"ena_srd_specification": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ena_srd_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"ena_srd_udp_specification": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ena_srd_udp_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"interface_type": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -820,6 +871,12 @@ func ResourceLaunchTemplate() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"primary_ipv6": {
+							Type:             nullable.TypeNullableBool,
+							Optional:         true,
+							DiffSuppressFunc: nullable.DiffSuppressNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
+						},
 						"private_ip_address": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -971,6 +1028,9 @@ func ResourceLaunchTemplate() *schema.Resource {
 				}
 				return false
 			}),
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return validateInstanceRequirementsRanges(diff)
+			},
 			verify.SetTagsDiff,
 		),
 	}
@@ -1564,6 +1624,103 @@ func expandLaunchTemplateInstanceMarketOptionsRequest(tfMap map[string]interface
 	return apiObject
 }
 
+// instanceRequirementsRangeKeys are the instance_requirements blocks with min and max arguments.
+var instanceRequirementsRangeKeys = []string{
+	"accelerator_count",
+	"accelerator_total_memory_mib",
+	"baseline_ebs_bandwidth_mbps",
+	"memory_gib_per_vcpu",
+	"memory_mib",
+	"network_bandwidth_gbps",
+	"network_interface_count",
+	"total_local_storage_gb",
+	"vcpu_count",
+}
+
+// validateInstanceRequirementsRanges checks at plan time that each min/max range in
+// the instance_requirements block has a min no greater than its max.
+// The raw configuration is used so that an explicit zero, e.g. an accelerator_count
+// max of 0, is validated rather than treated as not set.
+func validateInstanceRequirementsRanges(diff *schema.ResourceDiff) error {
+	configRaw := diff.GetRawConfig()
+	if !configRaw.IsKnown() || configRaw.IsNull() {
+		return nil
+	}
+
+	instanceRequirements := configRaw.GetAttr("instance_requirements")
+	if !instanceRequirements.IsKnown() || instanceRequirements.IsNull() || instanceRequirements.LengthInt() == 0 {
+		return nil
+	}
+
+	tfMap := instanceRequirements.Index(cty.NumberIntVal(0))
+	if !tfMap.IsKnown() || tfMap.IsNull() {
+		return nil
+	}
+
+	for _, k := range instanceRequirementsRangeKeys {
+		v := tfMap.GetAttr(k)
+		if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+			continue
+		}
+
+		v = v.Index(cty.NumberIntVal(0))
+		if !v.IsKnown() || v.IsNull() {
+			continue
+		}
+
+		minValue, maxValue := v.GetAttr("min"), v.GetAttr("max")
+		if !minValue.IsKnown() || minValue.IsNull() || !maxValue.IsKnown() || maxValue.IsNull() {
+			continue
+		}
+
+		minBound, _ := minValue.AsBigFloat().Float64()
+		maxBound, _ := maxValue.AsBigFloat().Float64()
+
+		if minBound > maxBound {
+			return fmt.Errorf("instance_requirements.0.%s: min (%v) must be less than or equal to max (%v)", k, minBound, maxBound)
+		}
+	}
+
+	return nil
+}
+
+// validateInstanceRequirementsMapRanges is the equivalent of validateInstanceRequirementsRanges
+// for an instance_requirements block nested in a set, which can't be addressed by key.
+// A zero min or max can't be told apart from an unset one here, so it is treated as not set.
+func validateInstanceRequirementsMapRanges(tfMap map[string]interface{}, prefix string) error {
+	for _, k := range instanceRequirementsRangeKeys {
+		v, ok := tfMap[k].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		tfMap := v[0].(map[string]interface{})
+		minRaw, maxRaw := tfMap["min"], tfMap["max"]
+		minValue, maxValue := instanceRequirementsRangeBound(minRaw), instanceRequirementsRangeBound(maxRaw)
+
+		if minValue == 0 || maxValue == 0 {
+			continue
+		}
+
+		if minValue > maxValue {
+			return fmt.Errorf("%s.%s: min (%v) must be less than or equal to max (%v)", prefix, k, minRaw, maxRaw)
+		}
+	}
+
+	return nil
+}
+
+func instanceRequirementsRangeBound(v interface{}) float64 {
+	switch v := v.(type) {
+	case int:
+		return float64(v)
+	case float64:
+		return v
+	default:
+		return 0
+	}
+}
+
 func expandInstanceRequirementsRequest(tfMap map[string]interface{}) *ec2.InstanceRequirementsRequest {
 	if tfMap == nil {
 		return nil
@@ -1977,6 +2134,10 @@ func expandLaunchTemplateInstanceNetworkInterfaceSpecificationRequest(tfMap map[
 		apiObject.AssociatePublicIpAddress = aws.Bool(v)
 	}
 
+	if v, ok := tfMap["connection_tracking_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ConnectionTrackingSpecification = expandConnectionTrackingSpecificationRequest(v[0].(map[string]interface{}))
+	}
+
 	if v, null, _ := nullable.Bool(tfMap["delete_on_termination"].(string)).Value(); !null {
 		apiObject.DeleteOnTermination = aws.Bool(v)
 	}
@@ -1989,6 +2150,10 @@ func expandLaunchTemplateInstanceNetworkInterfaceSpecificationRequest(tfMap map[
 		apiObject.DeviceIndex = aws.Int64(int64(v))
 	}
 
+	if v, ok := tfMap["ena_srd_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EnaSrdSpecification = expandEnaSrdSpecificationRequest(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["interface_type"].(string); ok && v != "" {
 		apiObject.InterfaceType = aws.String(v)
 	}
@@ -2049,6 +2214,10 @@ func expandLaunchTemplateInstanceNetworkInterfaceSpecificationRequest(tfMap map[
 		apiObject.NetworkInterfaceId = aws.String(v)
 	}
 
+	if v, null, _ := nullable.Bool(tfMap["primary_ipv6"].(string)).Value(); !null {
+		apiObject.PrimaryIpv6 = aws.Bool(v)
+	}
+
 	if v, ok := tfMap["security_groups"].(*schema.Set); ok && v.Len() > 0 {
 		for _, v := range v.List() {
 			apiObject.Groups = append(apiObject.Groups, aws.String(v.(string)))
@@ -2062,6 +2231,51 @@ func expandLaunchTemplateInstanceNetworkInterfaceSpecificationRequest(tfMap map[
 	return apiObject
 }
 
+func expandConnectionTrackingSpecificationRequest(tfMap map[string]interface{}) *ec2.ConnectionTrackingSpecificationRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.ConnectionTrackingSpecificationRequest{}
+
+	if v, ok := tfMap["tcp_established_timeout"].(int); ok && v != 0 {
+		apiObject.TcpEstablishedTimeout = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["udp_stream_timeout"].(int); ok && v != 0 {
+		apiObject.UdpStreamTimeout = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["udp_timeout"].(int); ok && v != 0 {
+		apiObject.UdpTimeout = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandEnaSrdSpecificationRequest(tfMap map[string]interface{}) *ec2.EnaSrdSpecificationRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.EnaSrdSpecificationRequest{}
+
+	if v, ok := tfMap["ena_srd_enabled"].(bool); ok {
+		apiObject.EnaSrdEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["ena_srd_udp_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.EnaSrdUdpSpecification = &ec2.EnaSrdUdpSpecificationRequest{}
+
+		if v, ok := tfMap["ena_srd_udp_enabled"].(bool); ok {
+			apiObject.EnaSrdUdpSpecification.EnaSrdUdpEnabled = aws.Bool(v)
+		}
+	}
+
+	return apiObject
+}
+
 func expandLaunchTemplateInstanceNetworkInterfaceSpecificationRequests(tfList []interface{}) []*ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest {
 	if len(tfList) == 0 {
 		return nil
@@ -2968,6 +3182,10 @@ func flattenLaunchTemplateInstanceNetworkInterfaceSpecification(apiObject *ec2.L
 		tfMap["associate_public_ip_address"] = strconv.FormatBool(aws.BoolValue(v))
 	}
 
+	if v := apiObject.ConnectionTrackingSpecification; v != nil {
+		tfMap["connection_tracking_specification"] = []interface{}{flattenConnectionTrackingSpecification(v)}
+	}
+
 	if v := apiObject.DeleteOnTermination; v != nil {
 		tfMap["delete_on_termination"] = strconv.FormatBool(aws.BoolValue(v))
 	}
@@ -2980,6 +3198,10 @@ func flattenLaunchTemplateInstanceNetworkInterfaceSpecification(apiObject *ec2.L
 		tfMap["device_index"] = aws.Int64Value(v)
 	}
 
+	if v := apiObject.EnaSrdSpecification; v != nil {
+		tfMap["ena_srd_specification"] = []interface{}{flattenLaunchTemplateEnaSrdSpecification(v)}
+	}
+
 	if v := apiObject.InterfaceType; v != nil {
 		tfMap["interface_type"] = aws.StringValue(v)
 	}
@@ -3048,6 +3270,10 @@ func flattenLaunchTemplateInstanceNetworkInterfaceSpecification(apiObject *ec2.L
 		tfMap["network_interface_id"] = aws.StringValue(v)
 	}
 
+	if v := apiObject.PrimaryIpv6; v != nil {
+		tfMap["primary_ipv6"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+
 	if v := apiObject.PrivateIpAddress; v != nil {
 		tfMap["private_ip_address"] = aws.StringValue(v)
 	}
@@ -3063,6 +3289,48 @@ func flattenLaunchTemplateInstanceNetworkInterfaceSpecification(apiObject *ec2.L
 	return tfMap
 }
 
+func flattenConnectionTrackingSpecification(apiObject *ec2.ConnectionTrackingSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TcpEstablishedTimeout; v != nil {
+		tfMap["tcp_established_timeout"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.UdpStreamTimeout; v != nil {
+		tfMap["udp_stream_timeout"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.UdpTimeout; v != nil {
+		tfMap["udp_timeout"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenLaunchTemplateEnaSrdSpecification(apiObject *ec2.LaunchTemplateEnaSrdSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EnaSrdEnabled; v != nil {
+		tfMap["ena_srd_enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.EnaSrdUdpSpecification; v != nil {
+		tfMap["ena_srd_udp_specification"] = []interface{}{map[string]interface{}{
+			"ena_srd_udp_enabled": aws.BoolValue(v.EnaSrdUdpEnabled),
+		}}
+	}
+
+	return tfMap
+}
+
 func flattenLaunchTemplateInstanceNetworkInterfaceSpecifications(apiObjects []*ec2.LaunchTemplateInstanceNetworkInterfaceSpecification) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
@@ -606,6 +606,26 @@ func DataSourceLaunchTemplate() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"connection_tracking_specification": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tcp_established_timeout": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"udp_stream_timeout": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"udp_timeout": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
 						"delete_on_termination": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -618,6 +638,30 @@ func DataSourceLaunchTemplate() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"ena_srd_specification": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ena_srd_enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"ena_srd_udp_specification": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ena_srd_udp_enabled": {
+													Type:     schema.TypeBool,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"interface_type": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -666,6 +710,10 @@ func DataSourceLaunchTemplate() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"primary_ipv6": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"private_ip_address": {
 							Type:     schema.TypeString,
 							Computed: true,
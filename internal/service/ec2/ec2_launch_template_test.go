@@ -1097,6 +1097,108 @@ func TestAccEC2LaunchTemplate_networkInterfaceIPv6Prefixes(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_networkInterfacePrimaryIPv6(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template ec2.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_networkInterfacePrimaryIPv6(rName, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.ipv6_address_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.primary_ipv6", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLaunchTemplateConfig_networkInterfacePrimaryIPv6(rName, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.primary_ipv6", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplate_networkInterfaceConnectionTrackingSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template ec2.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_networkInterfaceConnectionTrackingSpecification(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.connection_tracking_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.connection_tracking_specification.0.tcp_established_timeout", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.connection_tracking_specification.0.udp_stream_timeout", "120"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.connection_tracking_specification.0.udp_timeout", "45"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplate_networkInterfaceENASRDSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template ec2.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_networkInterfaceENASRDSpecification(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.ena_srd_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.ena_srd_specification.0.ena_srd_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.ena_srd_specification.0.ena_srd_udp_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_interfaces.0.ena_srd_specification.0.ena_srd_udp_specification.0.ena_srd_udp_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2LaunchTemplate_associatePublicIPAddress(t *testing.T) {
 	ctx := acctest.Context(t)
 	var template ec2.LaunchTemplate
@@ -1456,6 +1558,59 @@ func TestAccEC2LaunchTemplate_instanceRequirements_memoryMiBAndVCPUCount(t *test
 	})
 }
 
+func TestAccEC2LaunchTemplate_instanceRequirements_invalidRange(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_instanceRequirements(rName,
+					`memory_mib {
+                       min = 2048
+                       max = 1024
+                     }
+                     vcpu_count {
+                       min = 2
+                     }`),
+				ExpectError: regexache.MustCompile(`memory_mib: min \(2048\) must be less than or equal to max \(1024\)`),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_instanceRequirements(rName,
+					`memory_mib {
+                       min = 500
+                     }
+                     memory_gib_per_vcpu {
+                       min = 1.5
+                       max = 0.5
+                     }
+                     vcpu_count {
+                       min = 2
+                     }`),
+				ExpectError: regexache.MustCompile(`memory_gib_per_vcpu: min \(1.5\) must be less than or equal to max \(0.5\)`),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_instanceRequirements(rName,
+					`accelerator_count {
+                       min = 1
+                       max = 0
+                     }
+                     memory_mib {
+                       min = 500
+                     }
+                     vcpu_count {
+                       min = 2
+                     }`),
+				ExpectError: regexache.MustCompile(`accelerator_count: min \(1\) must be less than or equal to max \(0\)`),
+			},
+		},
+	})
+}
+
 func TestAccEC2LaunchTemplate_instanceRequirements_acceleratorCount(t *testing.T) {
 	ctx := acctest.Context(t)
 	var template ec2.LaunchTemplate
@@ -3940,6 +4095,53 @@ resource "aws_launch_template" "test" {
 `, rName)
 }
 
+func testAccLaunchTemplateConfig_networkInterfacePrimaryIPv6(rName, primaryIPv6 string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name = %[1]q
+
+  network_interfaces {
+    ipv6_address_count = 1
+    primary_ipv6       = %[2]q
+  }
+}
+`, rName, primaryIPv6)
+}
+
+func testAccLaunchTemplateConfig_networkInterfaceConnectionTrackingSpecification(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name = %[1]q
+
+  network_interfaces {
+    connection_tracking_specification {
+      tcp_established_timeout = 3600
+      udp_stream_timeout      = 120
+      udp_timeout             = 45
+    }
+  }
+}
+`, rName)
+}
+
+func testAccLaunchTemplateConfig_networkInterfaceENASRDSpecification(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name = %[1]q
+
+  network_interfaces {
+    ena_srd_specification {
+      ena_srd_enabled = true
+
+      ena_srd_udp_specification {
+        ena_srd_udp_enabled = true
+      }
+    }
+  }
+}
+`, rName)
+}
+
 func testAccLaunchTemplateConfig_asgBasic(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
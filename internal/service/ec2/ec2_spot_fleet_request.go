@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -866,10 +867,43 @@ func ResourceSpotFleetRequest() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceSpotFleetRequestCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceSpotFleetRequestCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Unknown min and max values are read as zero and skipped.
+	for _, tfMapRaw := range diff.Get("launch_template_config").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		overrides, ok := tfMap["overrides"].(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		for _, tfMapRaw := range overrides.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if v, ok := tfMap["instance_requirements"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				if err := validateInstanceRequirementsMapRanges(v[0].(map[string]interface{}), "launch_template_config.overrides.instance_requirements"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceSpotFleetRequestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
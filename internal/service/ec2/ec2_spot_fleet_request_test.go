@@ -375,6 +375,25 @@ func TestAccEC2SpotFleetRequest_launchTemplateWithInstanceTypeOverrides(t *testi
 	})
 }
 
+func TestAccEC2SpotFleetRequest_launchTemplateWithInstanceRequirementsOverrides_invalidRange(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsOverridesInvalidRange(rName, validUntil),
+				ExpectError: regexache.MustCompile(`vcpu_count: min \(8\) must be less than or equal to max \(1\)`),
+			},
+		},
+	})
+}
+
 func TestAccEC2SpotFleetRequest_launchTemplateWithInstanceRequirementsOverrides(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr ec2.SpotFleetRequestConfig
@@ -2378,6 +2397,38 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
+func testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsOverridesInvalidRange(rName, validUntil string) string {
+	return fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role  = "arn:${data.aws_partition.current.partition}:iam::123456789012:role/%[1]s"
+  target_capacity = 2
+  valid_until     = %[2]q
+
+  launch_template_config {
+    launch_template_specification {
+      name    = %[1]q
+      version = "1"
+    }
+
+    overrides {
+      instance_requirements {
+        vcpu_count {
+          min = 8
+          max = 1
+        }
+
+        memory_mib {
+          min = 500
+        }
+      }
+    }
+  }
+}
+
+data "aws_partition" "current" {}
+`, rName, validUntil)
+}
+
 func testAccSpotFleetRequestConfig_excessCapacityTermination(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_spot_fleet_request" "test" {
@@ -5,16 +5,20 @@ package ec2
 
 // Exports for use in tests only.
 var (
+	ResourceCapacityBlockReservation = newResourceCapacityBlockReservation
 	ResourceEBSFastSnapshotRestore   = newResourceEBSFastSnapshotRestore
 	ResourceInstanceConnectEndpoint  = newResourceInstanceConnectEndpoint
 	ResourceSecurityGroupEgressRule  = newResourceSecurityGroupEgressRule
 	ResourceSecurityGroupIngressRule = newResourceSecurityGroupIngressRule
 	ResourceTag                      = resourceTag
 
-	FindEBSFastSnapshotRestoreByID = findEBSFastSnapshotRestoreByID
+	FindCapacityBlockReservationByID = findCapacityBlockReservationByID
+	FindEBSFastSnapshotRestoreByID   = findEBSFastSnapshotRestoreByID
 
 	UpdateTags   = updateTags
 	UpdateTagsV2 = updateTagsV2
 
-	StopInstance = stopInstance
+	IsAmazonS3VPCEndpoint                           = isAmazonS3VPCEndpoint
+	NATGatewaySecondaryPrivateIPAddressesToUnassign = natGatewaySecondaryPrivateIPAddressesToUnassign
+	StopInstance                                    = stopInstance
 )
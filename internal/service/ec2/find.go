@@ -797,6 +797,104 @@ func FindEIPByAssociationID(ctx context.Context, conn *ec2.EC2, id string) (*ec2
 	return output, nil
 }
 
+func FindEIPByPublicIP(ctx context.Context, conn *ec2.EC2, ip string) (*ec2.Address, error) {
+	input := &ec2.DescribeAddressesInput{
+		PublicIps: aws.StringSlice([]string{ip}),
+	}
+
+	output, err := FindEIP(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.PublicIp) != ip {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindAddressTransfer(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeAddressTransfersInput) (*ec2.AddressTransfer, error) {
+	output, err := FindAddressTransfers(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindAddressTransfers(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeAddressTransfersInput) ([]*ec2.AddressTransfer, error) {
+	var output []*ec2.AddressTransfer
+
+	err := conn.DescribeAddressTransfersPagesWithContext(ctx, input, func(page *ec2.DescribeAddressTransfersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AddressTransfers {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindAddressTransferByAllocationID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.AddressTransfer, error) {
+	input := &ec2.DescribeAddressTransfersInput{
+		AllocationIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindAddressTransfer(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := aws.StringValue(output.AddressTransferStatus); status == ec2.AddressTransferStatusDisabled {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.AllocationId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindHostByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Host, error) {
 	input := &ec2.DescribeHostsInput{
 		HostIds: aws.StringSlice([]string{id}),
@@ -7319,6 +7417,36 @@ func FindImageBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Client
 	return output.ImageBlockPublicAccessState, nil
 }
 
+func FindInstanceMetadataDefaults(ctx context.Context, conn *ec2_sdkv2.Client) (*awstypes.InstanceMetadataDefaultsResponse, error) {
+	input := &ec2_sdkv2.GetInstanceMetadataDefaultsInput{}
+	output, err := conn.GetInstanceMetadataDefaults(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccountLevel == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AccountLevel, nil
+}
+
+func FindSnapshotBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Client) (awstypes.SnapshotBlockPublicAccessState, error) {
+	input := &ec2_sdkv2.GetSnapshotBlockPublicAccessStateInput{}
+	output, err := conn.GetSnapshotBlockPublicAccessState(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.State == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return output.State, nil
+}
+
 func FindVerifiedAccessEndpoint(ctx context.Context, conn *ec2_sdkv2.Client, input *ec2_sdkv2.DescribeVerifiedAccessEndpointsInput) (*awstypes.VerifiedAccessEndpoint, error) {
 	output, err := FindVerifiedAccessEndpoints(ctx, conn, input)
 
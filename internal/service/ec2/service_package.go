@@ -10,6 +10,7 @@ import (
 	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
 	ec2_sdkv1 "github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 // CustomizeConn customizes a new AWS SDK for Go v1 client for this service package's AWS API.
@@ -51,3 +52,23 @@ func (p *servicePackage) CustomizeConn(ctx context.Context, conn *ec2_sdkv1.EC2)
 
 	return conn, nil
 }
+
+// SDKResourceHints returns migration hints for this service package's SDK resources.
+func (p *servicePackage) SDKResourceHints(ctx context.Context) map[string][]*types.ServicePackageResourceHint {
+	return map[string][]*types.ServicePackageResourceHint{
+		"aws_security_group": {
+			{
+				Attribute: "egress",
+				Summary:   "Consider standalone security group rule resources",
+				Detail: "Inline `egress` blocks cannot be combined with `aws_vpc_security_group_egress_rule` or `aws_security_group_rule` resources for the same security group. " +
+					"Managing each rule with an `aws_vpc_security_group_egress_rule` resource gives every rule its own ID, tags and description, and avoids rule conflicts.",
+			},
+			{
+				Attribute: "ingress",
+				Summary:   "Consider standalone security group rule resources",
+				Detail: "Inline `ingress` blocks cannot be combined with `aws_vpc_security_group_ingress_rule` or `aws_security_group_rule` resources for the same security group. " +
+					"Managing each rule with an `aws_vpc_security_group_ingress_rule` resource gives every rule its own ID, tags and description, and avoids rule conflicts.",
+			},
+		},
+	}
+}
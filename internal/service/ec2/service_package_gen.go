@@ -19,6 +19,10 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceCapacityBlockOffering,
+			Name:    "Capacity Block Offering",
+		},
 		{
 			Factory: newDataSourceSecurityGroupRule,
 		},
@@ -30,6 +34,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceCapacityBlockReservation,
+			Name:    "Capacity Block Reservation",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory: newResourceEBSFastSnapshotRestore,
 			Name:    "EBS Fast Snapshot Restore",
@@ -196,6 +207,18 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSpotPrice,
 			TypeName: "aws_ec2_spot_price",
 		},
+		{
+			Factory:  DataSourceTrafficMirrorFilter,
+			TypeName: "aws_ec2_traffic_mirror_filter",
+		},
+		{
+			Factory:  DataSourceTrafficMirrorSession,
+			TypeName: "aws_ec2_traffic_mirror_session",
+		},
+		{
+			Factory:  DataSourceTrafficMirrorTarget,
+			TypeName: "aws_ec2_traffic_mirror_target",
+		},
 		{
 			Factory:  DataSourceTransitGateway,
 			TypeName: "aws_ec2_transit_gateway",
@@ -495,6 +518,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceEBSSnapshotBlockPublicAccess,
+			TypeName: "aws_ebs_snapshot_block_public_access",
+			Name:     "EBS Snapshot Block Public Access",
+		},
 		{
 			Factory:  ResourceEBSSnapshotCopy,
 			TypeName: "aws_ebs_snapshot_copy",
@@ -583,6 +611,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_ec2_image_block_public_access",
 			Name:     "Image Block Public Access",
 		},
+		{
+			Factory:  ResourceInstanceMetadataDefaults,
+			TypeName: "aws_ec2_instance_metadata_defaults",
+			Name:     "Instance Metadata Defaults",
+		},
 		{
 			Factory:  ResourceInstanceState,
 			TypeName: "aws_ec2_instance_state",
@@ -801,6 +834,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceEIPAssociation,
 			TypeName: "aws_eip_association",
 		},
+		{
+			Factory:  ResourceEIPTransfer,
+			TypeName: "aws_eip_transfer",
+			Name:     "EIP Transfer",
+		},
+		{
+			Factory:  ResourceEIPTransferAccepter,
+			TypeName: "aws_eip_transfer_accepter",
+			Name:     "EIP Transfer Accepter",
+		},
 		{
 			Factory:  ResourceFlowLog,
 			TypeName: "aws_flow_log",
@@ -1500,6 +1500,22 @@ func StatusImageBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Clie
 	}
 }
 
+func StatusSnapshotBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSnapshotBlockPublicAccessState(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output), nil
+	}
+}
+
 func StatusVerifiedAccessEndpoint(ctx context.Context, conn *ec2_sdkv2.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindVerifiedAccessEndpointByID(ctx, conn, id)
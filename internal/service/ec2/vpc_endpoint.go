@@ -494,7 +494,8 @@ func vpcEndpointAccept(ctx context.Context, conn *ec2.EC2, vpceID, serviceName s
 }
 
 func isAmazonS3VPCEndpoint(serviceName string) bool {
-	ok, _ := regexp.MatchString("com\\.amazonaws\\.([a-z]+\\-[a-z]+\\-[0-9])\\.s3", serviceName)
+	// Match only the S3 service itself, e.g. "com.amazonaws.us-gov-west-1.s3", and not S3-prefixed services such as "s3express" or "s3-outposts".
+	ok, _ := regexp.MatchString(`^(?:[a-z]+\.)?com\.amazonaws\.[a-z]{2}(?:-[a-z]+)+-[0-9]\.s3$`, serviceName)
 	return ok
 }
 
@@ -20,6 +20,27 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestIsAmazonS3VPCEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"com.amazonaws.us-west-2.s3":              true,  //lintignore:AWSAT003
+		"com.amazonaws.us-gov-west-1.s3":          true,  //lintignore:AWSAT003
+		"cn.com.amazonaws.cn-north-1.s3":          true,  //lintignore:AWSAT003
+		"com.amazonaws.us-west-2.s3express":       false, //lintignore:AWSAT003
+		"com.amazonaws.us-west-2.s3-outposts":     false, //lintignore:AWSAT003
+		"com.amazonaws.us-west-2.s3.fips":         false, //lintignore:AWSAT003
+		"com.amazonaws.us-west-2.dynamodb":        false, //lintignore:AWSAT003
+		"com.amazonaws.vpce.us-west-2.vpce-svc-1": false, //lintignore:AWSAT003
+	}
+
+	for serviceName, expected := range testCases {
+		if got := tfec2.IsAmazonS3VPCEndpoint(serviceName); got != expected {
+			t.Errorf("IsAmazonS3VPCEndpoint(%q) = %t, want %t", serviceName, got, expected)
+		}
+	}
+}
+
 func TestAccVPCEndpoint_gatewayBasic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var endpoint ec2.VpcEndpoint
@@ -87,7 +87,6 @@ func ResourceNATGateway() *schema.Resource {
 				Type:          schema.TypeInt,
 				Optional:      true,
 				Computed:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"secondary_private_ip_addresses"},
 			},
 			"secondary_private_ip_addresses": {
@@ -221,7 +220,62 @@ func resourceNATGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	switch d.Get("connectivity_type").(string) {
 	case ec2.ConnectivityTypePrivate:
-		if d.HasChanges("secondary_private_ip_addresses") {
+		if v := d.GetRawConfig().GetAttr("secondary_private_ip_address_count"); v.IsKnown() && !v.IsNull() {
+			if d.HasChange("secondary_private_ip_address_count") {
+				oRaw, nRaw := d.GetChange("secondary_private_ip_address_count")
+				o, n := oRaw.(int), nRaw.(int)
+
+				if n > o {
+					input := &ec2.AssignPrivateNatGatewayAddressInput{
+						NatGatewayId:          aws.String(d.Id()),
+						PrivateIpAddressCount: aws.Int64(int64(n - o)),
+					}
+
+					output, err := conn.AssignPrivateNatGatewayAddressWithContext(ctx, input)
+
+					if err != nil {
+						return sdkdiag.AppendErrorf(diags, "assigning EC2 NAT Gateway (%s) private IP addresses: %s", d.Id(), err)
+					}
+
+					for _, natGatewayAddress := range output.NatGatewayAddresses {
+						privateIP := aws.StringValue(natGatewayAddress.PrivateIp)
+						if _, err := WaitNATGatewayAddressAssigned(ctx, conn, d.Id(), privateIP, d.Timeout(schema.TimeoutUpdate)); err != nil {
+							return sdkdiag.AppendErrorf(diags, "waiting for EC2 NAT Gateway (%s) private IP address (%s) assign: %s", d.Id(), privateIP, err)
+						}
+					}
+				} else if n < o {
+					// The API has no count-based unassign, so release the surplus addresses explicitly.
+					ng, err := FindNATGatewayByID(ctx, conn, d.Id())
+
+					if err != nil {
+						return sdkdiag.AppendErrorf(diags, "reading EC2 NAT Gateway (%s): %s", d.Id(), err)
+					}
+
+					del, err := natGatewaySecondaryPrivateIPAddressesToUnassign(ng.NatGatewayAddresses, o-n)
+
+					if err != nil {
+						return sdkdiag.AppendErrorf(diags, "unassigning EC2 NAT Gateway (%s) private IP addresses: %s", d.Id(), err)
+					}
+
+					input := &ec2.UnassignPrivateNatGatewayAddressInput{
+						NatGatewayId:       aws.String(d.Id()),
+						PrivateIpAddresses: aws.StringSlice(del),
+					}
+
+					_, err = conn.UnassignPrivateNatGatewayAddressWithContext(ctx, input)
+
+					if err != nil {
+						return sdkdiag.AppendErrorf(diags, "unassigning EC2 NAT Gateway (%s) private IP addresses: %s", d.Id(), err)
+					}
+
+					for _, privateIP := range del {
+						if _, err := WaitNATGatewayAddressUnassigned(ctx, conn, d.Id(), privateIP, d.Timeout(schema.TimeoutUpdate)); err != nil {
+							return sdkdiag.AppendErrorf(diags, "waiting for EC2 NAT Gateway (%s) private IP address (%s) unassign: %s", d.Id(), privateIP, err)
+						}
+					}
+				}
+			}
+		} else if d.HasChanges("secondary_private_ip_addresses") {
 			oRaw, nRaw := d.GetChange("secondary_private_ip_addresses")
 			o, n := oRaw.(*schema.Set), nRaw.(*schema.Set)
 
@@ -372,6 +426,20 @@ func resourceNATGatewayCustomizeDiff(ctx context.Context, diff *schema.ResourceD
 			return fmt.Errorf(`secondary_allocation_ids is not supported with connectivity_type = "%s"`, connectivityType)
 		}
 
+		if diff.Id() != "" {
+			if v := diff.GetRawConfig().GetAttr("secondary_private_ip_address_count"); v.IsKnown() && !v.IsNull() {
+				if diff.HasChange("secondary_private_ip_address_count") {
+					if err := diff.SetNewComputed("secondary_private_ip_addresses"); err != nil {
+						return fmt.Errorf("setting secondary_private_ip_addresses to computed: %s", err)
+					}
+				}
+			} else if diff.HasChange("secondary_private_ip_addresses") {
+				if err := diff.SetNewComputed("secondary_private_ip_address_count"); err != nil {
+					return fmt.Errorf("setting secondary_private_ip_address_count to computed: %s", err)
+				}
+			}
+		}
+
 	case ec2.ConnectivityTypePublic:
 		if v := diff.GetRawConfig().GetAttr("secondary_private_ip_address_count"); v.IsKnown() && !v.IsNull() {
 			return fmt.Errorf(`secondary_private_ip_address_count is not supported with connectivity_type = "%s"`, connectivityType)
@@ -392,3 +460,42 @@ func resourceNATGatewayCustomizeDiff(ctx context.Context, diff *schema.ResourceD
 
 	return nil
 }
+
+// natGatewaySecondaryPrivateIPAddressesToUnassign chooses count secondary private IP addresses
+// to release from a private NAT gateway's current addresses.
+// Addresses that failed to assign are released first, followed by the most recently assigned.
+// Addresses that are already being released are skipped.
+func natGatewaySecondaryPrivateIPAddressesToUnassign(addresses []*ec2.NatGatewayAddress, count int) ([]string, error) {
+	var failed, succeeded []string
+
+	for _, address := range addresses {
+		if aws.BoolValue(address.IsPrimary) {
+			continue
+		}
+
+		privateIP := aws.StringValue(address.PrivateIp)
+		if privateIP == "" {
+			continue
+		}
+
+		switch aws.StringValue(address.Status) {
+		case ec2.NatGatewayAddressStatusFailed:
+			failed = append(failed, privateIP)
+		case ec2.NatGatewayAddressStatusUnassigning:
+		default:
+			succeeded = append(succeeded, privateIP)
+		}
+	}
+
+	if available := len(failed) + len(succeeded); count > available {
+		return nil, fmt.Errorf("%d secondary private IP addresses to unassign, but only %d are assigned", count, available)
+	}
+
+	del := make([]string, 0, count)
+	del = append(del, failed[:min(count, len(failed))]...)
+	for i := len(succeeded) - 1; len(del) < count; i-- {
+		del = append(del, succeeded[i])
+	}
+
+	return del, nil
+}
@@ -9,7 +9,9 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/google/go-cmp/cmp"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -20,6 +22,66 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestNATGatewaySecondaryPrivateIPAddressesToUnassign(t *testing.T) {
+	t.Parallel()
+
+	addresses := []*ec2.NatGatewayAddress{
+		{IsPrimary: aws.Bool(true), PrivateIp: aws.String("10.0.0.4"), Status: aws.String(ec2.NatGatewayAddressStatusSucceeded)},
+		{IsPrimary: aws.Bool(false), PrivateIp: aws.String("10.0.0.5"), Status: aws.String(ec2.NatGatewayAddressStatusSucceeded)},
+		{IsPrimary: aws.Bool(false), PrivateIp: aws.String("10.0.0.6"), Status: aws.String(ec2.NatGatewayAddressStatusFailed)},
+		{IsPrimary: aws.Bool(false), PrivateIp: aws.String("10.0.0.7"), Status: aws.String(ec2.NatGatewayAddressStatusUnassigning)},
+		{IsPrimary: aws.Bool(false), PrivateIp: aws.String("10.0.0.8"), Status: aws.String(ec2.NatGatewayAddressStatusSucceeded)},
+	}
+
+	testCases := map[string]struct {
+		count         int
+		expected      []string
+		expectedError bool
+	}{
+		"failed first": {
+			count:    1,
+			expected: []string{"10.0.0.6"},
+		},
+		"then most recently assigned": {
+			count:    2,
+			expected: []string{"10.0.0.6", "10.0.0.8"},
+		},
+		"all available": {
+			count:    3,
+			expected: []string{"10.0.0.6", "10.0.0.8", "10.0.0.5"},
+		},
+		"more than available": {
+			count:         4,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfec2.NATGatewaySecondaryPrivateIPAddressesToUnassign(addresses, testCase.count)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestAccVPCNATGateway_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var natGateway ec2.NatGateway
@@ -278,6 +340,22 @@ func TestAccVPCNATGateway_secondaryPrivateIPAddressCount(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccVPCNATGatewayConfig_secondaryPrivateIPAddressCount(rName, secondaryPrivateIpAddressCount+2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_address_count", strconv.Itoa(secondaryPrivateIpAddressCount+2)),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_addresses.#", strconv.Itoa(secondaryPrivateIpAddressCount+2)),
+				),
+			},
+			{
+				Config: testAccVPCNATGatewayConfig_secondaryPrivateIPAddressCount(rName, secondaryPrivateIpAddressCount-1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_address_count", strconv.Itoa(secondaryPrivateIpAddressCount-1)),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_addresses.#", strconv.Itoa(secondaryPrivateIpAddressCount-1)),
+				),
+			},
 		},
 	})
 }
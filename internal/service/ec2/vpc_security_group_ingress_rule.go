@@ -396,17 +396,12 @@ func (r *resourceSecurityGroupRule) expandIPPermission(ctx context.Context, data
 	}
 
 	if !data.ReferencedSecurityGroupID.IsNull() {
+		userID, groupID := r.expandReferencedSecurityGroup(ctx, data.ReferencedSecurityGroupID)
 		apiObject.UserIdGroupPairs = []*ec2.UserIdGroupPair{{
 			Description: flex.StringFromFramework(ctx, data.Description),
+			GroupId:     groupID,
+			UserId:      userID,
 		}}
-
-		// [UserID/]GroupID.
-		if parts := strings.Split(data.ReferencedSecurityGroupID.ValueString(), "/"); len(parts) == 2 {
-			apiObject.UserIdGroupPairs[0].GroupId = aws.String(parts[1])
-			apiObject.UserIdGroupPairs[0].UserId = aws.String(parts[0])
-		} else {
-			apiObject.UserIdGroupPairs[0].GroupId = flex.StringFromFramework(ctx, data.ReferencedSecurityGroupID)
-		}
 	}
 
 	return apiObject
@@ -414,19 +409,32 @@ func (r *resourceSecurityGroupRule) expandIPPermission(ctx context.Context, data
 
 func (r *resourceSecurityGroupRule) expandSecurityGroupRuleRequest(ctx context.Context, data *resourceSecurityGroupRuleData) *ec2.SecurityGroupRuleRequest {
 	apiObject := &ec2.SecurityGroupRuleRequest{
-		CidrIpv4:          flex.StringFromFramework(ctx, data.CIDRIPv4),
-		CidrIpv6:          flex.StringFromFramework(ctx, data.CIDRIPv6),
-		Description:       flex.StringFromFramework(ctx, data.Description),
-		FromPort:          flex.Int64FromFramework(ctx, data.FromPort),
-		IpProtocol:        flex.StringFromFramework(ctx, data.IPProtocol),
-		PrefixListId:      flex.StringFromFramework(ctx, data.PrefixListID),
-		ReferencedGroupId: flex.StringFromFramework(ctx, data.ReferencedSecurityGroupID),
-		ToPort:            flex.Int64FromFramework(ctx, data.ToPort),
+		CidrIpv4:     flex.StringFromFramework(ctx, data.CIDRIPv4),
+		CidrIpv6:     flex.StringFromFramework(ctx, data.CIDRIPv6),
+		Description:  flex.StringFromFramework(ctx, data.Description),
+		FromPort:     flex.Int64FromFramework(ctx, data.FromPort),
+		IpProtocol:   flex.StringFromFramework(ctx, data.IPProtocol),
+		PrefixListId: flex.StringFromFramework(ctx, data.PrefixListID),
+		ToPort:       flex.Int64FromFramework(ctx, data.ToPort),
+	}
+
+	// ModifySecurityGroupRules identifies the referenced group by group ID only.
+	if !data.ReferencedSecurityGroupID.IsNull() {
+		_, apiObject.ReferencedGroupId = r.expandReferencedSecurityGroup(ctx, data.ReferencedSecurityGroupID)
 	}
 
 	return apiObject
 }
 
+func (r *resourceSecurityGroupRule) expandReferencedSecurityGroup(ctx context.Context, v types.String) (*string, *string) {
+	// [UserID/]GroupID.
+	if parts := strings.Split(v.ValueString(), "/"); len(parts) == 2 {
+		return aws.String(parts[0]), aws.String(parts[1])
+	}
+
+	return nil, flex.StringFromFramework(ctx, v)
+}
+
 func (r *resourceSecurityGroupRule) flattenReferencedSecurityGroup(ctx context.Context, apiObject *ec2.ReferencedSecurityGroup) types.String {
 	if apiObject == nil {
 		return types.StringNull()
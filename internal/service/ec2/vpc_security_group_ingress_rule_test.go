@@ -892,7 +892,7 @@ func TestAccVPCSecurityGroupIngressRule_referencedSecurityGroupID(t *testing.T)
 
 func TestAccVPCSecurityGroupIngressRule_ReferencedSecurityGroupID_peerVPC(t *testing.T) {
 	ctx := acctest.Context(t)
-	var v ec2.SecurityGroupRule
+	var v1, v2 ec2.SecurityGroupRule
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_vpc_security_group_ingress_rule.test"
 
@@ -908,7 +908,7 @@ func TestAccVPCSecurityGroupIngressRule_ReferencedSecurityGroupID_peerVPC(t *tes
 			{
 				Config: testAccVPCSecurityGroupIngressRuleConfig_referencedSecurityGroupIDPeerVPC(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSecurityGroupIngressRuleExists(ctx, resourceName, &v),
+					testAccCheckSecurityGroupIngressRuleExists(ctx, resourceName, &v1),
 					resource.TestCheckResourceAttrSet(resourceName, "arn"),
 					resource.TestCheckNoResourceAttr(resourceName, "cidr_ipv4"),
 					resource.TestCheckNoResourceAttr(resourceName, "cidr_ipv6"),
@@ -927,6 +927,15 @@ func TestAccVPCSecurityGroupIngressRule_ReferencedSecurityGroupID_peerVPC(t *tes
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccVPCSecurityGroupIngressRuleConfig_referencedSecurityGroupIDPeerVPCDescription(rName, "description1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupIngressRuleExists(ctx, resourceName, &v2),
+					testAccCheckSecurityGroupRuleNotRecreated(&v2, &v1),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestMatchResourceAttr(resourceName, "referenced_security_group_id", regexache.MustCompile("^[0-9]{12}/sg-[0-9a-z]{17}$")),
+				),
+			},
 		},
 	})
 }
@@ -1360,7 +1369,7 @@ resource "aws_vpc_security_group_ingress_rule" "test" {
 `, rName))
 }
 
-func testAccVPCSecurityGroupIngressRuleConfig_referencedSecurityGroupIDPeerVPC(rName string) string {
+func testAccVPCSecurityGroupIngressRuleConfig_referencedSecurityGroupIDPeerVPCBase(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), testAccVPCSecurityGroupRuleConfig_base(rName), fmt.Sprintf(`
 resource "aws_vpc" "peer" {
   provider = "awsalternate"
@@ -1411,7 +1420,11 @@ resource "aws_vpc_peering_connection_accepter" "peer" {
     Name = %[1]q
   }
 }
+`, rName, acctest.Region()))
+}
 
+func testAccVPCSecurityGroupIngressRuleConfig_referencedSecurityGroupIDPeerVPC(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupIngressRuleConfig_referencedSecurityGroupIDPeerVPCBase(rName), `
 resource "aws_vpc_security_group_ingress_rule" "test" {
   security_group_id = aws_security_group.test.id
 
@@ -1422,5 +1435,21 @@ resource "aws_vpc_security_group_ingress_rule" "test" {
 
   depends_on = [aws_vpc_peering_connection_accepter.peer]
 }
-`, rName, acctest.Region()))
+`)
+}
+
+func testAccVPCSecurityGroupIngressRuleConfig_referencedSecurityGroupIDPeerVPCDescription(rName, description string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupIngressRuleConfig_referencedSecurityGroupIDPeerVPCBase(rName), fmt.Sprintf(`
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  referenced_security_group_id = "${data.aws_caller_identity.peer.account_id}/${aws_security_group.peer.id}"
+  description                  = %[1]q
+  from_port                    = 80
+  ip_protocol                  = "tcp"
+  to_port                      = 8080
+
+  depends_on = [aws_vpc_peering_connection_accepter.peer]
+}
+`, description))
 }
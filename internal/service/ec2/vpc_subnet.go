@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -38,7 +40,10 @@ func ResourceSubnet() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceSubnetCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -157,6 +162,32 @@ func ResourceSubnet() *schema.Resource {
 	}
 }
 
+func resourceSubnetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("ipv6_native").(bool) {
+		return nil
+	}
+
+	// IPv6-only subnets have no IPv4 addressing.
+	if _, ok := diff.GetOk("ipv6_cidr_block"); !ok && diff.NewValueKnown("ipv6_cidr_block") {
+		return errors.New("ipv6_cidr_block is required when ipv6_native is true")
+	}
+	for _, key := range []string{"cidr_block", "customer_owned_ipv4_pool"} {
+		if _, ok := diff.GetOk(key); ok {
+			return fmt.Errorf("%s is not supported when ipv6_native is true", key)
+		}
+	}
+	for _, key := range []string{"enable_resource_name_dns_a_record_on_launch", "map_customer_owned_ip_on_launch", "map_public_ip_on_launch"} {
+		if diff.Get(key).(bool) {
+			return fmt.Errorf("%s must be false when ipv6_native is true", key)
+		}
+	}
+	if v := diff.Get("private_dns_hostname_type_on_launch").(string); v != "" && v != ec2.HostnameTypeResourceName {
+		return fmt.Errorf(`private_dns_hostname_type_on_launch must be "%s" when ipv6_native is true`, ec2.HostnameTypeResourceName)
+	}
+
+	return nil
+}
+
 func resourceSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
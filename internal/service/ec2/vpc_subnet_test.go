@@ -956,6 +956,36 @@ func TestAccVPCSubnet_ipv6Native(t *testing.T) {
 	})
 }
 
+func TestAccVPCSubnet_ipv6NativeIPv4Arguments(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubnetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCSubnetConfig_ipv6NativeIPv4Arguments(rName, `cidr_block = "10.10.1.0/24"`),
+				ExpectError: regexache.MustCompile(`cidr_block is not supported when ipv6_native is true`),
+			},
+			{
+				Config:      testAccVPCSubnetConfig_ipv6NativeIPv4Arguments(rName, `map_public_ip_on_launch = true`),
+				ExpectError: regexache.MustCompile(`map_public_ip_on_launch must be false when ipv6_native is true`),
+			},
+			{
+				Config:      testAccVPCSubnetConfig_ipv6NativeIPv4Arguments(rName, `enable_resource_name_dns_a_record_on_launch = true`),
+				ExpectError: regexache.MustCompile(`enable_resource_name_dns_a_record_on_launch must be false when ipv6_native is true`),
+			},
+			{
+				Config:      testAccVPCSubnetConfig_ipv6NativeIPv4Arguments(rName, `private_dns_hostname_type_on_launch = "ip-name"`),
+				ExpectError: regexache.MustCompile(`private_dns_hostname_type_on_launch must be "resource-name" when ipv6_native is true`),
+			},
+		},
+	})
+}
+
 func testAccCheckSubnetIPv6BeforeUpdate(subnet *ec2.Subnet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if subnet.Ipv6CidrBlockAssociationSet == nil {
@@ -1506,6 +1536,31 @@ resource "aws_subnet" "test" {
 `, rName)
 }
 
+func testAccVPCSubnetConfig_ipv6NativeIPv4Arguments(rName, argument string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.10.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id          = aws_vpc.test.id
+  ipv6_cidr_block = cidrsubnet(aws_vpc.test.ipv6_cidr_block, 8, 1)
+  ipv6_native     = true
+
+  %[2]s
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, argument)
+}
+
 func testAccVPCSubnetConfig_outpost(rName string) string {
 	return fmt.Sprintf(`
 data "aws_outposts_outposts" "test" {}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCTrafficMirrorFilterDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+	dataSourceNameByID := "data.aws_ec2_traffic_mirror_filter.by_id"
+	dataSourceNameByTags := "data.aws_ec2_traffic_mirror_filter.by_tags"
+	dataSourceNameByFilter := "data.aws_ec2_traffic_mirror_filter.by_filter"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTrafficMirrorFilter(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCTrafficMirrorFilterDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "network_services.#", resourceName, "network_services.#"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByTags, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByFilter, "id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCTrafficMirrorFilterDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  description      = %[1]q
+  network_services = ["amazon-dns"]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_traffic_mirror_filter" "by_id" {
+  id = aws_ec2_traffic_mirror_filter.test.id
+}
+
+data "aws_ec2_traffic_mirror_filter" "by_tags" {
+  tags = {
+    Name = aws_ec2_traffic_mirror_filter.test.tags["Name"]
+  }
+}
+
+data "aws_ec2_traffic_mirror_filter" "by_filter" {
+  filter {
+    name   = "description"
+    values = [aws_ec2_traffic_mirror_filter.test.description]
+  }
+}
+`, rName)
+}
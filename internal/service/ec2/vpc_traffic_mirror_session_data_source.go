@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_ec2_traffic_mirror_session")
+func DataSourceTrafficMirrorSession() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTrafficMirrorSessionRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter": CustomFiltersSchema(),
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"packet_length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"session_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"traffic_mirror_filter_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"traffic_mirror_target_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"virtual_network_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTrafficMirrorSessionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeTrafficMirrorSessionsInput{}
+
+	if v, ok := d.GetOk("id"); ok {
+		input.TrafficMirrorSessionIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	input.Filters = append(input.Filters, BuildTagFilterList(
+		Tags(tftags.New(ctx, d.Get("tags").(map[string]interface{}))),
+	)...)
+
+	input.Filters = append(input.Filters, BuildCustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	session, err := FindTrafficMirrorSession(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Traffic Mirror Session", err))
+	}
+
+	d.SetId(aws.StringValue(session.TrafficMirrorSessionId))
+
+	ownerID := aws.StringValue(session.OwnerId)
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: ownerID,
+		Resource:  fmt.Sprintf("traffic-mirror-session/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("description", session.Description)
+	d.Set("network_interface_id", session.NetworkInterfaceId)
+	d.Set("owner_id", ownerID)
+	d.Set("packet_length", session.PacketLength)
+	d.Set("session_number", session.SessionNumber)
+	d.Set("traffic_mirror_filter_id", session.TrafficMirrorFilterId)
+	d.Set("traffic_mirror_target_id", session.TrafficMirrorTargetId)
+	d.Set("virtual_network_id", session.VirtualNetworkId)
+
+	if err := d.Set("tags", KeyValueTags(ctx, session.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCTrafficMirrorSessionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+	session := sdkacctest.RandIntRange(1, 32766)
+	resourceName := "aws_ec2_traffic_mirror_session.test"
+	dataSourceNameByID := "data.aws_ec2_traffic_mirror_session.by_id"
+	dataSourceNameByTags := "data.aws_ec2_traffic_mirror_session.by_tags"
+	dataSourceNameByFilter := "data.aws_ec2_traffic_mirror_session.by_filter"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTrafficMirrorSession(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCTrafficMirrorSessionDataSourceConfig_basic(rName, session),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "network_interface_id", resourceName, "network_interface_id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "owner_id", resourceName, "owner_id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "packet_length", resourceName, "packet_length"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "session_number", resourceName, "session_number"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "traffic_mirror_filter_id", resourceName, "traffic_mirror_filter_id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "traffic_mirror_target_id", resourceName, "traffic_mirror_target_id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "virtual_network_id", resourceName, "virtual_network_id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByTags, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByFilter, "id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCTrafficMirrorSessionDataSourceConfig_basic(rName string, session int) string {
+	return acctest.ConfigCompose(testAccTrafficMirrorSessionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_session" "test" {
+  description              = %[1]q
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  traffic_mirror_target_id = aws_ec2_traffic_mirror_target.test.id
+  network_interface_id     = aws_instance.test.primary_network_interface_id
+  session_number           = %[2]d
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_traffic_mirror_session" "by_id" {
+  id = aws_ec2_traffic_mirror_session.test.id
+}
+
+data "aws_ec2_traffic_mirror_session" "by_tags" {
+  tags = {
+    Name = aws_ec2_traffic_mirror_session.test.tags["Name"]
+  }
+}
+
+data "aws_ec2_traffic_mirror_session" "by_filter" {
+  filter {
+    name   = "network-interface-id"
+    values = [aws_ec2_traffic_mirror_session.test.network_interface_id]
+  }
+}
+`, rName, session))
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCTrafficMirrorTargetDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+	resourceName := "aws_ec2_traffic_mirror_target.test"
+	dataSourceNameByID := "data.aws_ec2_traffic_mirror_target.by_id"
+	dataSourceNameByTags := "data.aws_ec2_traffic_mirror_target.by_tags"
+	dataSourceNameByFilter := "data.aws_ec2_traffic_mirror_target.by_filter"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTrafficMirrorTarget(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCTrafficMirrorTargetDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "gateway_load_balancer_endpoint_id", resourceName, "gateway_load_balancer_endpoint_id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "network_interface_id", resourceName, "network_interface_id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "network_load_balancer_arn", resourceName, "network_load_balancer_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "owner_id", resourceName, "owner_id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByTags, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByFilter, "id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCTrafficMirrorTargetDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  name               = %[1]q
+  internal           = true
+  load_balancer_type = "network"
+  subnets            = aws_subnet.test[*].id
+
+  enable_deletion_protection = false
+}
+
+resource "aws_ec2_traffic_mirror_target" "test" {
+  description               = %[1]q
+  network_load_balancer_arn = aws_lb.test.arn
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_traffic_mirror_target" "by_id" {
+  id = aws_ec2_traffic_mirror_target.test.id
+}
+
+data "aws_ec2_traffic_mirror_target" "by_tags" {
+  tags = {
+    Name = aws_ec2_traffic_mirror_target.test.tags["Name"]
+  }
+}
+
+data "aws_ec2_traffic_mirror_target" "by_filter" {
+  filter {
+    name   = "network-load-balancer-arn"
+    values = [aws_ec2_traffic_mirror_target.test.network_load_balancer_arn]
+  }
+}
+`, rName))
+}
@@ -206,14 +206,12 @@ func ResourceVPNConnection() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: validVPNConnectionTunnelInsideCIDR(),
 			},
 			"tunnel1_inside_ipv6_cidr": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: validVPNConnectionTunnelInsideIPv6CIDR(),
 				RequiredWith: []string{"transit_gateway_id"},
 			},
@@ -423,14 +421,12 @@ func ResourceVPNConnection() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: validVPNConnectionTunnelInsideCIDR(),
 			},
 			"tunnel2_inside_ipv6_cidr": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: validVPNConnectionTunnelInsideIPv6CIDR(),
 				RequiredWith: []string{"transit_gateway_id"},
 			},
@@ -1353,6 +1349,23 @@ func expandModifyVPNTunnelOptionsSpecification(d *schema.ResourceData, prefix st
 		hasChange = true
 	}
 
+	// Inside CIDRs are computed if not configured, so only explicitly configured values are modified.
+	if key := prefix + "inside_cidr"; d.HasChange(key) {
+		if v, ok := d.GetOk(key); ok {
+			apiObject.TunnelInsideCidr = aws.String(v.(string))
+
+			hasChange = true
+		}
+	}
+
+	if key := prefix + "inside_ipv6_cidr"; d.HasChange(key) {
+		if v, ok := d.GetOk(key); ok {
+			apiObject.TunnelInsideIpv6Cidr = aws.String(v.(string))
+
+			hasChange = true
+		}
+	}
+
 	if !hasChange {
 		return nil
 	}
@@ -5,7 +5,9 @@ package ecr
 
 // Exports for use in tests only.
 var (
-	ResourcePullThroughCacheRule = resourcePullThroughCacheRule
+	ResourcePullThroughCacheRule       = resourcePullThroughCacheRule
+	ResourceRepositoryCreationTemplate = resourceRepositoryCreationTemplate
 
-	FindPullThroughCacheRuleByRepositoryPrefix = findPullThroughCacheRuleByRepositoryPrefix
+	FindPullThroughCacheRuleByRepositoryPrefix       = findPullThroughCacheRuleByRepositoryPrefix
+	FindRepositoryCreationTemplateByRepositoryPrefix = findRepositoryCreationTemplateByRepositoryPrefix
 )
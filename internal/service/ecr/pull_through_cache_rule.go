@@ -35,9 +35,14 @@ func resourcePullThroughCacheRule() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"credential_arn": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					verify.ValidARN,
+					validation.StringMatch(
+						regexache.MustCompile(`:secret:ecr-pullthroughcache/.+`),
+						"must be the ARN of a Secrets Manager secret whose name starts with \"ecr-pullthroughcache/\""),
+				),
 			},
 			"ecr_repository_prefix": {
 				Type:     schema.TypeString,
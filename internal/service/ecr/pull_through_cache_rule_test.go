@@ -79,6 +79,24 @@ func TestAccECRPullThroughCacheRule_credentialARN(t *testing.T) {
 	})
 }
 
+func TestAccECRPullThroughCacheRule_credentialARNInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPullThroughCacheRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPullThroughCacheRuleConfig_credentialARNInvalid(repositoryPrefix),
+				ExpectError: regexache.MustCompile(`name starts with "ecr-pullthroughcache/"`),
+			},
+		},
+	})
+}
+
 func TestAccECRPullThroughCacheRule_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
@@ -236,3 +254,13 @@ resource "aws_ecr_pull_through_cache_rule" "duplicate" {
 }
 `, repositoryPrefix)
 }
+
+func testAccPullThroughCacheRuleConfig_credentialARNInvalid(repositoryPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_pull_through_cache_rule" "test" {
+  ecr_repository_prefix = %[1]q
+  upstream_registry_url = "registry-1.docker.io"
+  credential_arn        = "arn:aws:secretsmanager:us-east-1:123456789012:secret:%[1]s-AbCdEf"
+}
+`, repositoryPrefix)
+}
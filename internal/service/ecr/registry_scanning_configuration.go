@@ -8,12 +8,15 @@ import (
 	"log"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	inspector2types "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
@@ -29,6 +32,13 @@ func ResourceRegistryScanningConfiguration() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"pull_date_rescan_duration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[inspector2types.EcrPullDateRescanDuration](),
+				RequiredWith:     []string{"rescan_duration"},
+			},
 			"registry_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -56,7 +66,8 @@ func ResourceRegistryScanningConfiguration() *schema.Resource {
 									},
 									"filter_type": {
 										Type:         schema.TypeString,
-										Required:     true,
+										Optional:     true,
+										Default:      ecr.ScanningRepositoryFilterTypeWildcard,
 										ValidateFunc: validation.StringInSlice(ecr.ScanningRepositoryFilterType_Values(), false),
 									},
 								},
@@ -70,6 +81,12 @@ func ResourceRegistryScanningConfiguration() *schema.Resource {
 					},
 				},
 			},
+			"rescan_duration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[inspector2types.EcrRescanDuration](),
+			},
 			"scan_type": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -94,6 +111,25 @@ func resourceRegistryScanningConfigurationPut(ctx context.Context, d *schema.Res
 		return sdkdiag.AppendErrorf(diags, "creating ECR Registry Scanning Configuration: %s", err)
 	}
 
+	// Continuous scan durations of enhanced scanning are managed by Amazon Inspector.
+	if v, ok := d.GetOk("rescan_duration"); ok && d.Get("scan_type").(string) == ecr.ScanTypeEnhanced && d.HasChanges("pull_date_rescan_duration", "rescan_duration", "scan_type") {
+		input := &inspector2.UpdateConfigurationInput{
+			EcrConfiguration: &inspector2types.EcrConfiguration{
+				RescanDuration: inspector2types.EcrRescanDuration(v.(string)),
+			},
+		}
+
+		if v, ok := d.GetOk("pull_date_rescan_duration"); ok {
+			input.EcrConfiguration.PullDateRescanDuration = inspector2types.EcrPullDateRescanDuration(v.(string))
+		}
+
+		_, err := meta.(*conns.AWSClient).Inspector2Client(ctx).UpdateConfiguration(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Inspector2 ECR rescan duration: %s", err)
+		}
+	}
+
 	d.SetId(meta.(*conns.AWSClient).AccountID)
 
 	return append(diags, resourceRegistryScanningConfigurationRead(ctx, d, meta)...)
@@ -113,6 +149,20 @@ func resourceRegistryScanningConfigurationRead(ctx context.Context, d *schema.Re
 	d.Set("scan_type", out.ScanningConfiguration.ScanType)
 	d.Set("rule", flattenScanningConfigurationRules(out.ScanningConfiguration.Rules))
 
+	// The rescan durations are an account-wide Amazon Inspector setting and are only read when configured.
+	if _, ok := d.GetOk("rescan_duration"); ok && aws.StringValue(out.ScanningConfiguration.ScanType) == ecr.ScanTypeEnhanced {
+		output, err := meta.(*conns.AWSClient).Inspector2Client(ctx).GetConfiguration(ctx, &inspector2.GetConfigurationInput{})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Inspector2 ECR rescan duration: %s", err)
+		}
+
+		if v := output.EcrConfiguration; v != nil && v.RescanDurationState != nil {
+			d.Set("pull_date_rescan_duration", v.RescanDurationState.PullDateRescanDuration)
+			d.Set("rescan_duration", v.RescanDurationState.RescanDuration)
+		}
+	}
+
 	return diags
 }
 
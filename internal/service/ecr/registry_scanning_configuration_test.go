@@ -20,8 +20,9 @@ func TestAccECRScanningConfiguration_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]func(t *testing.T){
-		"basic":  testAccRegistryScanningConfiguration_basic,
-		"update": testAccRegistryScanningConfiguration_update,
+		"basic":      testAccRegistryScanningConfiguration_basic,
+		"update":     testAccRegistryScanningConfiguration_update,
+		"dataSource": testAccRepositoryScanningConfigurationDataSource_basic,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -107,6 +108,8 @@ func testAccRegistryScanningConfiguration_update(t *testing.T) {
 						"filter":      "*",
 						"filter_type": "WILDCARD",
 					}),
+					resource.TestCheckResourceAttr(resourceName, "pull_date_rescan_duration", "DAYS_14"),
+					resource.TestCheckResourceAttr(resourceName, "rescan_duration", "DAYS_30"),
 					resource.TestCheckResourceAttr(resourceName, "scan_type", "ENHANCED"),
 				),
 			},
@@ -204,7 +207,10 @@ resource "aws_ecr_registry_scanning_configuration" "test" {
 func testAccRegistryScanningConfigurationConfig_twoRules() string {
 	return `
 resource "aws_ecr_registry_scanning_configuration" "test" {
-  scan_type = "ENHANCED"
+  scan_type                 = "ENHANCED"
+  rescan_duration           = "DAYS_30"
+  pull_date_rescan_duration = "DAYS_14"
+
   rule {
     scan_frequency = "CONTINUOUS_SCAN"
     repository_filter {
@@ -215,8 +221,7 @@ resource "aws_ecr_registry_scanning_configuration" "test" {
   rule {
     scan_frequency = "SCAN_ON_PUSH"
     repository_filter {
-      filter      = "*"
-      filter_type = "WILDCARD"
+      filter = "*"
     }
   }
 }
@@ -0,0 +1,423 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ecr_repository_creation_template", name="Repository Creation Template")
+func resourceRepositoryCreationTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRepositoryCreationTemplateCreate,
+		ReadWithoutTimeout:   resourceRepositoryCreationTemplateRead,
+		UpdateWithoutTimeout: resourceRepositoryCreationTemplateUpdate,
+		DeleteWithoutTimeout: resourceRepositoryCreationTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"applied_for": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.RCTAppliedFor](),
+				},
+			},
+			"custom_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          awstypes.EncryptionTypeAes256,
+							ValidateDiagFunc: enum.Validate[awstypes.EncryptionType](),
+						},
+						"kms_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+			},
+			"image_tag_mutability": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.ImageTagMutabilityMutable,
+				ValidateDiagFunc: enum.Validate[awstypes.ImageTagMutability](),
+			},
+			"lifecycle_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := equivalentLifecyclePolicyJSON(old, new)
+
+					return equal
+				},
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(
+						regexache.MustCompile(`^((?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*/?|ROOT)$`),
+						"must be ROOT or only include lowercase alphanumeric, underscore, period, hyphen, or slash characters"),
+				),
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository_policy": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func resourceRepositoryCreationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	prefix := d.Get("prefix").(string)
+	input := &ecr.CreateRepositoryCreationTemplateInput{
+		AppliedFor:         flex.ExpandStringyValueSet[awstypes.RCTAppliedFor](d.Get("applied_for").(*schema.Set)),
+		ImageTagMutability: awstypes.ImageTagMutability(d.Get("image_tag_mutability").(string)),
+		Prefix:             aws.String(prefix),
+	}
+
+	if v, ok := d.GetOk("custom_role_arn"); ok {
+		input.CustomRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncryptionConfiguration = expandEncryptionConfigurationForRepositoryCreationTemplate(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("lifecycle_policy"); ok {
+		policy, err := structure.NormalizeJsonString(v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "lifecycle_policy (%s) is invalid JSON: %s", v.(string), err)
+		}
+
+		input.LifecyclePolicy = aws.String(policy)
+	}
+
+	if v, ok := d.GetOk("repository_policy"); ok {
+		policy, err := structure.NormalizeJsonString(v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "repository_policy (%s) is invalid JSON: %s", v.(string), err)
+		}
+
+		input.RepositoryPolicy = aws.String(policy)
+	}
+
+	if v, ok := d.GetOk("resource_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		input.ResourceTags = expandRepositoryCreationTemplateResourceTags(ctx, v.(map[string]interface{}))
+	}
+
+	_, err := conn.CreateRepositoryCreationTemplate(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ECR Repository Creation Template (%s): %s", prefix, err)
+	}
+
+	d.SetId(prefix)
+
+	return append(diags, resourceRepositoryCreationTemplateRead(ctx, d, meta)...)
+}
+
+func resourceRepositoryCreationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	rct, registryID, err := findRepositoryCreationTemplateByRepositoryPrefix(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECR Repository Creation Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Repository Creation Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("applied_for", rct.AppliedFor)
+	d.Set("custom_role_arn", rct.CustomRoleArn)
+	d.Set("description", rct.Description)
+	if err := d.Set("encryption_configuration", flattenEncryptionConfigurationForRepositoryCreationTemplate(rct.EncryptionConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting encryption_configuration: %s", err)
+	}
+	d.Set("image_tag_mutability", rct.ImageTagMutability)
+
+	equivalent, err := equivalentLifecyclePolicyJSON(d.Get("lifecycle_policy").(string), aws.ToString(rct.LifecyclePolicy))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "while comparing lifecycle_policy (state: %s) (from AWS: %s), encountered: %s", d.Get("lifecycle_policy").(string), aws.ToString(rct.LifecyclePolicy), err)
+	}
+
+	if !equivalent {
+		policyToSet, err := structure.NormalizeJsonString(aws.ToString(rct.LifecyclePolicy))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "lifecycle_policy (%s) is invalid JSON: %s", policyToSet, err)
+		}
+
+		d.Set("lifecycle_policy", policyToSet)
+	}
+
+	d.Set("prefix", rct.Prefix)
+	d.Set("registry_id", registryID)
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("repository_policy").(string), aws.ToString(rct.RepositoryPolicy))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "while setting repository_policy (%s), encountered: %s", policyToSet, err)
+	}
+
+	policyToSet, err = structure.NormalizeJsonString(policyToSet)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "repository_policy (%s) is invalid JSON: %s", policyToSet, err)
+	}
+
+	d.Set("repository_policy", policyToSet)
+
+	if err := d.Set("resource_tags", flattenRepositoryCreationTemplateResourceTags(ctx, rct.ResourceTags)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource_tags: %s", err)
+	}
+
+	return diags
+}
+
+func resourceRepositoryCreationTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	input := &ecr.UpdateRepositoryCreationTemplateInput{
+		Prefix: aws.String(d.Id()),
+	}
+
+	if d.HasChange("applied_for") {
+		input.AppliedFor = flex.ExpandStringyValueSet[awstypes.RCTAppliedFor](d.Get("applied_for").(*schema.Set))
+	}
+
+	if d.HasChange("custom_role_arn") {
+		// An empty string removes the role.
+		input.CustomRoleArn = aws.String(d.Get("custom_role_arn").(string))
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("encryption_configuration") {
+		if v, ok := d.GetOk("encryption_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.EncryptionConfiguration = expandEncryptionConfigurationForRepositoryCreationTemplate(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.EncryptionConfiguration = &awstypes.EncryptionConfigurationForRepositoryCreationTemplate{
+				EncryptionType: awstypes.EncryptionTypeAes256,
+			}
+		}
+	}
+
+	if d.HasChange("image_tag_mutability") {
+		input.ImageTagMutability = awstypes.ImageTagMutability(d.Get("image_tag_mutability").(string))
+	}
+
+	if d.HasChange("lifecycle_policy") {
+		policy, err := structure.NormalizeJsonString(d.Get("lifecycle_policy").(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "lifecycle_policy (%s) is invalid JSON: %s", d.Get("lifecycle_policy").(string), err)
+		}
+
+		input.LifecyclePolicy = aws.String(policy)
+	}
+
+	if d.HasChange("repository_policy") {
+		policy, err := structure.NormalizeJsonString(d.Get("repository_policy").(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "repository_policy (%s) is invalid JSON: %s", d.Get("repository_policy").(string), err)
+		}
+
+		input.RepositoryPolicy = aws.String(policy)
+	}
+
+	if d.HasChange("resource_tags") {
+		// An empty list removes all tags.
+		input.ResourceTags = expandRepositoryCreationTemplateResourceTags(ctx, d.Get("resource_tags").(map[string]interface{}))
+	}
+
+	_, err := conn.UpdateRepositoryCreationTemplate(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating ECR Repository Creation Template (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRepositoryCreationTemplateRead(ctx, d, meta)...)
+}
+
+func resourceRepositoryCreationTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	log.Printf("[DEBUG] Deleting ECR Repository Creation Template: %s", d.Id())
+	_, err := conn.DeleteRepositoryCreationTemplate(ctx, &ecr.DeleteRepositoryCreationTemplateInput{
+		Prefix: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.TemplateNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ECR Repository Creation Template (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findRepositoryCreationTemplateByRepositoryPrefix(ctx context.Context, conn *ecr.Client, repositoryPrefix string) (*awstypes.RepositoryCreationTemplate, *string, error) {
+	input := &ecr.DescribeRepositoryCreationTemplatesInput{
+		Prefixes: []string{repositoryPrefix},
+	}
+
+	output, err := conn.DescribeRepositoryCreationTemplates(ctx, input)
+
+	if errs.IsA[*awstypes.TemplateNotFoundException](err) {
+		return nil, nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if output == nil {
+		return nil, nil, tfresource.NewEmptyResultError(input)
+	}
+
+	rct, err := tfresource.AssertSingleValueResult(output.RepositoryCreationTemplates)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rct, output.RegistryId, nil
+}
+
+func expandEncryptionConfigurationForRepositoryCreationTemplate(tfMap map[string]interface{}) *awstypes.EncryptionConfigurationForRepositoryCreationTemplate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.EncryptionConfigurationForRepositoryCreationTemplate{}
+
+	if v, ok := tfMap["encryption_type"].(string); ok && v != "" {
+		apiObject.EncryptionType = awstypes.EncryptionType(v)
+	}
+
+	if v, ok := tfMap["kms_key"].(string); ok && v != "" {
+		apiObject.KmsKey = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEncryptionConfigurationForRepositoryCreationTemplate(apiObject *awstypes.EncryptionConfigurationForRepositoryCreationTemplate) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"encryption_type": apiObject.EncryptionType,
+		"kms_key":         aws.ToString(apiObject.KmsKey),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandRepositoryCreationTemplateResourceTags(ctx context.Context, tfMap map[string]interface{}) []awstypes.Tag {
+	apiObjects := make([]awstypes.Tag, 0, len(tfMap))
+
+	for k, v := range tftags.New(ctx, tfMap).IgnoreAWS().Map() {
+		apiObjects = append(apiObjects, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenRepositoryCreationTemplateResourceTags(ctx context.Context, apiObjects []awstypes.Tag) map[string]string {
+	tags := make(map[string]string, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tags[aws.ToString(apiObject.Key)] = aws.ToString(apiObject.Value)
+	}
+
+	return tftags.New(ctx, tags).IgnoreAWS().Map()
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_ecr_repository_scanning_configuration")
+func dataSourceRepositoryScanningConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRepositoryScanningConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"applied_scan_filter": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"filter_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"repository_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"scan_frequency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scan_on_push": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRepositoryScanningConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRConn(ctx)
+
+	name := d.Get("repository_name").(string)
+	config, err := findRepositoryScanningConfigurationByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Repository (%s) Scanning Configuration: %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(config.RepositoryName))
+	if err := d.Set("applied_scan_filter", flattenScanningConfigurationFilters(config.AppliedScanFilters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting applied_scan_filter: %s", err)
+	}
+	d.Set("repository_arn", config.RepositoryArn)
+	d.Set("repository_name", config.RepositoryName)
+	d.Set("scan_frequency", config.ScanFrequency)
+	d.Set("scan_on_push", config.ScanOnPush)
+
+	return diags
+}
+
+func findRepositoryScanningConfigurationByName(ctx context.Context, conn *ecr.ECR, name string) (*ecr.RepositoryScanningConfiguration, error) {
+	input := &ecr.BatchGetRepositoryScanningConfigurationInput{
+		RepositoryNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.BatchGetRepositoryScanningConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.Failures {
+		if aws.StringValue(v.FailureCode) == ecr.ScanningConfigurationFailureCodeRepositoryNotFound {
+			return nil, &retry.NotFoundError{
+				Message:     aws.StringValue(v.FailureReason),
+				LastRequest: input,
+			}
+		}
+
+		return nil, fmt.Errorf("%s: %s", aws.StringValue(v.FailureCode), aws.StringValue(v.FailureReason))
+	}
+
+	return tfresource.AssertSinglePtrResult(output.ScanningConfigurations)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRepositoryScanningConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ecr_repository_scanning_configuration.test"
+	repositoryResourceName := "aws_ecr_repository.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRegistryScanningConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryScanningConfigurationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "applied_scan_filter.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "applied_scan_filter.0.filter", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "applied_scan_filter.0.filter_type", "WILDCARD"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "repository_arn", repositoryResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "repository_name", repositoryResourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "scan_frequency", "SCAN_ON_PUSH"),
+					resource.TestCheckResourceAttr(dataSourceName, "scan_on_push", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryScanningConfigurationDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "BASIC"
+  rule {
+    scan_frequency = "SCAN_ON_PUSH"
+    repository_filter {
+      filter      = %[1]q
+      filter_type = "WILDCARD"
+    }
+  }
+}
+
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+data "aws_ecr_repository_scanning_configuration" "test" {
+  repository_name = aws_ecr_repository.test.name
+
+  depends_on = [aws_ecr_registry_scanning_configuration.test]
+}
+`, rName)
+}
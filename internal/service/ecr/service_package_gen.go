@@ -49,6 +49,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceRepository,
 			TypeName: "aws_ecr_repository",
 		},
+		{
+			Factory:  dataSourceRepositoryScanningConfiguration,
+			TypeName: "aws_ecr_repository_scanning_configuration",
+		},
 	}
 }
 
@@ -83,6 +87,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  resourceRepositoryCreationTemplate,
+			TypeName: "aws_ecr_repository_creation_template",
+			Name:     "Repository Creation Template",
+		},
 		{
 			Factory:  ResourceRepositoryPolicy,
 			TypeName: "aws_ecr_repository_policy",
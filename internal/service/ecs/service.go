@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"log"
 	"math"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -502,8 +504,14 @@ func ResourceService() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"task_definition": {
-				Type:     schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressTaskDefinitionRevisionTracking,
+			},
+			"track_latest": {
+				Type:     schema.TypeBool,
 				Optional: true,
+				Default:  false,
 			},
 			// modeled after null_resource & aws_api_gateway_deployment
 			// only for _updates in-place_ rather than replacements
@@ -513,6 +521,86 @@ func ResourceService() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"volume_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_ebs_volume": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encrypted": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"file_system_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(ecs.TaskFilesystemType_Values(), false),
+									},
+									"iops": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"size_in_gb": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"snapshot_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"tag_specifications": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"propagate_tags": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(ecs.PropagateTags_Values(), false),
+												},
+												"resource_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(ecs.EBSResourceType_Values(), false),
+												},
+												names.AttrTags: tftags.TagsSchema(),
+											},
+										},
+									},
+									"throughput": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"volume_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"wait_for_steady_state": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -652,6 +740,10 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.TaskDefinition = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("volume_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.VolumeConfigurations = expandServiceVolumeConfigurations(ctx, v.([]interface{}), d.GetRawConfig().GetAttr("volume_configuration"))
+	}
+
 	output, err := serviceCreateWithRetry(ctx, conn, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -964,6 +1056,11 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.TaskDefinition = aws.String(d.Get("task_definition").(string))
 		}
 
+		if d.HasChange("volume_configuration") {
+			// To remove an existing volume configuration, specify an empty list.
+			input.VolumeConfigurations = expandServiceVolumeConfigurations(ctx, d.Get("volume_configuration").([]interface{}), d.GetRawConfig().GetAttr("volume_configuration"))
+		}
+
 		// Retry due to IAM eventual consistency
 		err := retry.RetryContext(ctx, propagationTimeout+serviceUpdateTimeout, func() *retry.RetryError {
 			_, err := conn.UpdateServiceWithContext(ctx, input)
@@ -1156,7 +1253,7 @@ func expandAlarms(tfMap map[string]interface{}) *ecs.DeploymentAlarms {
 		apiObject.Enable = aws.Bool(v)
 	}
 
-	if v, ok := tfMap["enable"].(bool); ok {
+	if v, ok := tfMap["rollback"].(bool); ok {
 		apiObject.Rollback = aws.Bool(v)
 	}
 
@@ -1392,6 +1489,121 @@ func flattenPlacementStrategy(pss []*ecs.PlacementStrategy) []interface{} {
 	return results
 }
 
+// expandServiceVolumeConfigurations expands the volume_configuration blocks.
+// rawConfig is the raw configuration of volume_configuration and is used to tell an unset encrypted from false.
+func expandServiceVolumeConfigurations(ctx context.Context, tfList []interface{}, rawConfig cty.Value) []*ecs.ServiceVolumeConfiguration {
+	apiObjects := make([]*ecs.ServiceVolumeConfiguration, 0, len(tfList))
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ServiceVolumeConfiguration{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["managed_ebs_volume"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ManagedEBSVolume = expandServiceManagedEBSVolumeConfiguration(ctx, v[0].(map[string]interface{}))
+			apiObject.ManagedEBSVolume.Encrypted = expandServiceManagedEBSVolumeEncrypted(rawConfig, i)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandServiceManagedEBSVolumeConfiguration(ctx context.Context, tfMap map[string]interface{}) *ecs.ServiceManagedEBSVolumeConfiguration {
+	apiObject := &ecs.ServiceManagedEBSVolumeConfiguration{}
+
+	if v, ok := tfMap["file_system_type"].(string); ok && v != "" {
+		apiObject.FilesystemType = aws.String(v)
+	}
+
+	if v, ok := tfMap["iops"].(int); ok && v != 0 {
+		apiObject.Iops = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["size_in_gb"].(int); ok && v != 0 {
+		apiObject.SizeInGiB = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["snapshot_id"].(string); ok && v != "" {
+		apiObject.SnapshotId = aws.String(v)
+	}
+
+	if v, ok := tfMap["tag_specifications"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TagSpecifications = expandEBSTagSpecifications(ctx, v)
+	}
+
+	if v, ok := tfMap["throughput"].(int); ok && v != 0 {
+		apiObject.Throughput = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["volume_type"].(string); ok && v != "" {
+		apiObject.VolumeType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+// expandServiceManagedEBSVolumeEncrypted returns the configured encrypted value of the managed_ebs_volume
+// in the volume_configuration block at index i, or nil if it isn't set.
+func expandServiceManagedEBSVolumeEncrypted(rawConfig cty.Value, i int) *bool {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() || rawConfig.LengthInt() <= i {
+		return nil
+	}
+
+	v := rawConfig.Index(cty.NumberIntVal(int64(i))).GetAttr("managed_ebs_volume")
+	if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+		return nil
+	}
+
+	v = v.Index(cty.NumberIntVal(0)).GetAttr("encrypted")
+	if !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+
+	return aws.Bool(v.True())
+}
+
+func expandEBSTagSpecifications(ctx context.Context, tfList []interface{}) []*ecs.EBSTagSpecification {
+	apiObjects := make([]*ecs.EBSTagSpecification, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.EBSTagSpecification{
+			ResourceType: aws.String(tfMap["resource_type"].(string)),
+		}
+
+		if v, ok := tfMap["propagate_tags"].(string); ok && v != "" {
+			apiObject.PropagateTags = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrTags].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Tags = Tags(tftags.New(ctx, v).IgnoreAWS())
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandServiceConnectConfiguration(sc []interface{}) *ecs.ServiceConnectConfiguration {
 	if len(sc) == 0 {
 		return &ecs.ServiceConnectConfiguration{
@@ -1654,6 +1866,42 @@ func serviceCreateWithRetry(ctx context.Context, conn *ecs.ECS, input ecs.Create
 	return output, err
 }
 
+// suppressTaskDefinitionRevisionTracking suppresses task_definition differences when track_latest is set
+// and the deployed task definition is a revision of the configured family that is at least as new as the configured one,
+// e.g. because a newer revision has been deployed outside of Terraform.
+func suppressTaskDefinitionRevisionTracking(k, old, new string, d *schema.ResourceData) bool {
+	if !d.Get("track_latest").(bool) || old == "" || new == "" {
+		return false
+	}
+
+	oldFamily, oldRevision := taskDefinitionFamilyAndRevision(old)
+	newFamily, newRevision := taskDefinitionFamilyAndRevision(new)
+
+	if oldFamily != newFamily {
+		return false
+	}
+
+	// No configured revision means the latest ACTIVE revision.
+	if newRevision == 0 {
+		return true
+	}
+
+	return oldRevision >= newRevision
+}
+
+// taskDefinitionFamilyAndRevision returns the family and revision of a task definition ARN, family:revision or family.
+// The revision is 0 if not specified.
+func taskDefinitionFamilyAndRevision(v string) (string, int) {
+	if i := strings.LastIndex(v, "/"); i >= 0 {
+		v = v[i+1:]
+	}
+
+	family, revision, _ := strings.Cut(v, ":")
+	n, _ := strconv.Atoi(revision)
+
+	return family, n
+}
+
 func buildFamilyAndRevisionFromARN(arn string) string {
 	return strings.Split(arn, "/")[1]
 }
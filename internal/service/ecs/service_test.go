@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -62,6 +63,53 @@ func Test_GetRoleNameFromARN(t *testing.T) {
 	}
 }
 
+func Test_TaskDefinitionFamilyAndRevision(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		input            string
+		expectedFamily   string
+		expectedRevision int
+	}{
+		{
+			name:           "family",
+			input:          "example",
+			expectedFamily: "example",
+		},
+		{
+			name:             "family and revision",
+			input:            "example:3",
+			expectedFamily:   "example",
+			expectedRevision: 3,
+		},
+		{
+			name:             "ARN",
+			input:            "arn:aws:ecs:us-west-2:123456789012:task-definition/example:12", //lintignore:AWSAT003,AWSAT005
+			expectedFamily:   "example",
+			expectedRevision: 12,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			family, revision := tfecs.TaskDefinitionFamilyAndRevision(testCase.input)
+
+			if family != testCase.expectedFamily {
+				t.Errorf("family: got %q, want %q", family, testCase.expectedFamily)
+			}
+
+			if revision != testCase.expectedRevision {
+				t.Errorf("revision: got %d, want %d", revision, testCase.expectedRevision)
+			}
+		})
+	}
+}
+
 func Test_GetClustereNameFromARN(t *testing.T) {
 	t.Parallel()
 
@@ -123,6 +171,46 @@ func TestAccECSService_basic(t *testing.T) {
 	})
 }
 
+func TestAccECSService_trackLatest(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_trackLatest(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "track_latest", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "task_definition", "aws_ecs_task_definition.test", "arn"),
+				),
+			},
+			{
+				// Simulate a deployment outside of Terraform of a newer task definition revision.
+				PreConfig: func() {
+					testAccDeployNewTaskDefinitionRevision(ctx, t, &service)
+				},
+				Config: testAccServiceConfig_trackLatest(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_ecs_task_definition.test", "revision", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "task_definition", "aws_ecs_task_definition.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSService_basicImport(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -535,7 +623,7 @@ func TestAccECSService_alarmsAdd(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccServiceConfig_alarms(rName, true),
+				Config: testAccServiceConfig_alarms(rName, true, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "alarms.#", "1"),
@@ -559,7 +647,7 @@ func TestAccECSService_alarmsUpdate(t *testing.T) {
 		CheckDestroy:             testAccCheckServiceDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccServiceConfig_alarms(rName, true),
+				Config: testAccServiceConfig_alarms(rName, true, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "alarms.#", "1"),
@@ -567,13 +655,22 @@ func TestAccECSService_alarmsUpdate(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccServiceConfig_alarms(rName, false),
+				Config: testAccServiceConfig_alarms(rName, false, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "alarms.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "alarms.0.enable", "false"),
 				),
 			},
+			{
+				Config: testAccServiceConfig_alarms(rName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "alarms.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarms.0.enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alarms.0.rollback", "false"),
+				),
+			},
 		},
 	})
 }
@@ -1576,6 +1673,45 @@ func TestAccECSService_executeCommand(t *testing.T) {
 	})
 }
 
+func TestAccECSService_VolumeConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_volumeConfiguration(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.name", "ebs"),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "volume_configuration.0.managed_ebs_volume.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.0.size_in_gb", "10"),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.0.volume_type", "gp3"),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.0.tag_specifications.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.0.tag_specifications.0.resource_type", "volume"),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.0.tag_specifications.0.tags.Name", rName),
+				),
+			},
+			{
+				Config: testAccServiceConfig_volumeConfiguration(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.0.size_in_gb", "20"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckServiceDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn(ctx)
@@ -1604,6 +1740,40 @@ func testAccCheckServiceDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
+// testAccDeployNewTaskDefinitionRevision registers a new revision of the service's task definition and updates the service to use it.
+func testAccDeployNewTaskDefinitionRevision(ctx context.Context, t *testing.T, service *ecs.Service) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn(ctx)
+
+	output, err := conn.DescribeTaskDefinitionWithContext(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: service.TaskDefinition,
+	})
+
+	if err != nil {
+		t.Fatalf("describing ECS Task Definition (%s): %s", aws.StringValue(service.TaskDefinition), err)
+	}
+
+	registerOutput, err := conn.RegisterTaskDefinitionWithContext(ctx, &ecs.RegisterTaskDefinitionInput{
+		ContainerDefinitions: output.TaskDefinition.ContainerDefinitions,
+		Family:               output.TaskDefinition.Family,
+	})
+
+	if err != nil {
+		t.Fatalf("registering ECS Task Definition (%s): %s", aws.StringValue(output.TaskDefinition.Family), err)
+	}
+
+	_, err = conn.UpdateServiceWithContext(ctx, &ecs.UpdateServiceInput{
+		Cluster:        service.ClusterArn,
+		Service:        service.ServiceName,
+		TaskDefinition: registerOutput.TaskDefinition.TaskDefinitionArn,
+	})
+
+	if err != nil {
+		t.Fatalf("updating ECS Service (%s): %s", aws.StringValue(service.ServiceName), err)
+	}
+}
+
 func testAccCheckServiceExists(ctx context.Context, name string, service *ecs.Service) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -1674,6 +1844,40 @@ resource "aws_ecs_service" "test" {
 `, rName)
 }
 
+func testAccServiceConfig_trackLatest(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+
+  track_latest = true
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 1
+  track_latest    = true
+}
+`, rName)
+}
+
 func testAccServiceConfig_modified(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -2586,7 +2790,7 @@ resource "aws_ecs_service" "test" {
 `, rName))
 }
 
-func testAccServiceConfig_alarms(rName string, enable bool) string {
+func testAccServiceConfig_alarms(rName string, enable, rollback bool) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
   name = %[1]q
@@ -2616,7 +2820,7 @@ resource "aws_ecs_service" "test" {
 
   alarms {
     enable   = %[2]t
-    rollback = %[2]t
+    rollback = %[3]t
     alarm_names = [
       aws_cloudwatch_metric_alarm.test.alarm_name
     ]
@@ -2634,7 +2838,7 @@ resource "aws_cloudwatch_metric_alarm" "test" {
   threshold                 = "80"
   insufficient_data_actions = []
 }
-`, rName, enable)
+`, rName, enable, rollback)
 }
 
 func testAccServiceConfig_noAlarms(rName string) string {
@@ -4545,3 +4749,92 @@ resource "aws_ecs_service" "test" {
 }
 `, rName)
 }
+
+func testAccServiceConfig_volumeConfiguration(rName string, sizeInGiB int) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ecs.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonECSInfrastructureRolePolicyForVolumes"
+}
+
+resource "aws_ecs_task_definition" "ebs" {
+  family                   = "%[1]s-ebs"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "essential": true,
+    "image": "busybox",
+    "name": "sleep",
+    "command": ["sleep", "3600"],
+    "mountPoints": [
+      {
+        "containerPath": "/data",
+        "sourceVolume": "ebs"
+      }
+    ]
+  }
+]
+DEFINITION
+
+  volume {
+    name                = "ebs"
+    configure_at_launch = true
+  }
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.ebs.arn
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    security_groups = aws_security_group.test[*].id
+    subnets         = aws_subnet.test[*].id
+  }
+
+  volume_configuration {
+    name = "ebs"
+
+    managed_ebs_volume {
+      role_arn    = aws_iam_role.test.arn
+      size_in_gb  = %[2]d
+      volume_type = "gp3"
+
+      tag_specifications {
+        resource_type = "volume"
+
+        tags = {
+          Name = %[1]q
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, sizeInGiB))
+}
@@ -288,6 +288,11 @@ func ResourceTaskDefinition() *schema.Resource {
 				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"configure_at_launch": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
 						"docker_volume_configuration": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -749,6 +754,10 @@ func resourceTaskDefinitionVolumeHash(v interface{}) int {
 		}
 	}
 
+	if v, ok := m["configure_at_launch"].(bool); ok && v {
+		buf.WriteString(fmt.Sprintf("%t-", v))
+	}
+
 	return create.StringHashcode(buf.String())
 }
 
@@ -915,6 +924,10 @@ func expandVolumes(configured []interface{}) []*ecs.Volume {
 			Name: aws.String(data["name"].(string)),
 		}
 
+		if v, ok := data["configure_at_launch"].(bool); ok && v {
+			l.ConfiguredAtLaunch = aws.Bool(v)
+		}
+
 		hostPath := data["host_path"].(string)
 		if hostPath != "" {
 			l.Host = &ecs.HostVolumeProperties{
@@ -1047,7 +1060,8 @@ func flattenVolumes(list []*ecs.Volume) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, len(list))
 	for _, volume := range list {
 		l := map[string]interface{}{
-			"name": aws.StringValue(volume.Name),
+			"configure_at_launch": aws.BoolValue(volume.ConfiguredAtLaunch),
+			"name":                aws.StringValue(volume.Name),
 		}
 
 		if volume.Host != nil && volume.Host.SourcePath != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -26,6 +27,7 @@ func ResourceReplicationConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceReplicationConfigurationCreate,
 		ReadWithoutTimeout:   resourceReplicationConfigurationRead,
+		UpdateWithoutTimeout: schema.NoopContext, // Allow replication_overwrite_protection update.
 		DeleteWithoutTimeout: resourceReplicationConfigurationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -85,6 +87,15 @@ func ResourceReplicationConfiguration() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"replication_overwrite_protection": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  efs.ReplicationOverwriteProtectionEnabled,
+				ValidateFunc: validation.StringInSlice([]string{
+					efs.ReplicationOverwriteProtectionEnabled,
+					efs.ReplicationOverwriteProtectionDisabled,
+				}, false),
+			},
 			"source_file_system_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -183,8 +194,10 @@ func resourceReplicationConfigurationDelete(ctx context.Context, d *schema.Resou
 		return sdkdiag.AppendErrorf(diags, "creating AWS session (%s): %s", region, err)
 	}
 
+	destinationConn := efs.New(session)
+
 	log.Printf("[DEBUG] Deleting EFS Replication Configuration: %s", d.Id())
-	if err := deleteReplicationConfiguration(ctx, efs.New(session), d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if err := deleteReplicationConfiguration(ctx, destinationConn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -193,9 +206,38 @@ func resourceReplicationConfigurationDelete(ctx context.Context, d *schema.Resou
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	// The destination file system is retained. Optionally leave it unprotected so that replication can be resumed into it.
+	if fsID, protection := aws.StringValue(destination.FileSystemId), d.Get("replication_overwrite_protection").(string); fsID != "" && protection == efs.ReplicationOverwriteProtectionDisabled {
+		if err := updateFileSystemReplicationOverwriteProtection(ctx, destinationConn, fsID, protection, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return diags
 }
 
+func updateFileSystemReplicationOverwriteProtection(ctx context.Context, conn *efs.EFS, fsID, protection string, timeout time.Duration) error {
+	input := &efs.UpdateFileSystemProtectionInput{
+		FileSystemId:                   aws.String(fsID),
+		ReplicationOverwriteProtection: aws.String(protection),
+	}
+
+	// The destination file system may briefly remain read-only after the replication configuration is deleted.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+		return conn.UpdateFileSystemProtectionWithContext(ctx, input)
+	}, efs.ErrCodeIncorrectFileSystemLifeCycleState, efs.ErrCodeReplicationAlreadyExists)
+
+	if tfawserr.ErrCodeEquals(err, efs.ErrCodeFileSystemNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("updating EFS file system (%s) replication overwrite protection: %w", fsID, err)
+	}
+
+	return nil
+}
+
 func deleteReplicationConfiguration(ctx context.Context, conn *efs.EFS, fsID string, timeout time.Duration) error {
 	_, err := conn.DeleteReplicationConfigurationWithContext(ctx, &efs.DeleteReplicationConfigurationInput{
 		SourceFileSystemId: aws.String(fsID),
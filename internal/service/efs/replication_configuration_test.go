@@ -53,9 +53,10 @@ func TestAccEFSReplicationConfiguration_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replication_overwrite_protection"},
 			},
 		},
 	})
@@ -163,6 +164,65 @@ func TestAccEFSReplicationConfiguration_existingDestination(t *testing.T) {
 	})
 }
 
+func TestAccEFSReplicationConfiguration_replicationOverwriteProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resourceName := "aws_efs_replication_configuration.test"
+	destinationFsResourceName := "aws_efs_file_system.destination"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EFSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		CheckDestroy:             acctest.CheckWithProviders(testAccCheckReplicationConfigurationDestroyWithProvider(ctx), &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationConfig_replicationOverwriteProtection(rName, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "destination.0.file_system_id", destinationFsResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.status", efs.ReplicationStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "replication_overwrite_protection", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replication_overwrite_protection"},
+			},
+			// Pause replication, retaining the destination file system.
+			{
+				Config: testAccReplicationConfigurationConfig_fileSystems(rName),
+			},
+			{
+				Config: testAccReplicationConfigurationConfig_fileSystems(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(destinationFsResourceName, "protection.0.replication_overwrite", "DISABLED"),
+				),
+			},
+			// Resume replication into the retained destination file system.
+			{
+				Config: testAccReplicationConfigurationConfig_replicationOverwriteProtection(rName, "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "destination.0.file_system_id", destinationFsResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.status", efs.ReplicationStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "replication_overwrite_protection", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckReplicationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -263,6 +323,46 @@ resource "aws_efs_replication_configuration" "test" {
 `, rName, acctest.AlternateRegion()))
 }
 
+func testAccReplicationConfigurationConfig_fileSystems(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+resource "aws_efs_file_system" "source" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_efs_file_system" "destination" {
+  provider = "awsalternate"
+
+  protection {
+    replication_overwrite = "DISABLED"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [protection]
+  }
+}
+`, rName))
+}
+
+func testAccReplicationConfigurationConfig_replicationOverwriteProtection(rName, protection string) string {
+	return acctest.ConfigCompose(testAccReplicationConfigurationConfig_fileSystems(rName), fmt.Sprintf(`
+resource "aws_efs_replication_configuration" "test" {
+  source_file_system_id            = aws_efs_file_system.source.id
+  replication_overwrite_protection = %[2]q
+
+  destination {
+    file_system_id = aws_efs_file_system.destination.id
+    region         = %[1]q
+  }
+}
+`, acctest.AlternateRegion(), protection))
+}
+
 func testAccReplicationConfigurationConfig_full(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
 resource "aws_kms_key" "test" {
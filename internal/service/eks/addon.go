@@ -13,6 +13,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -78,9 +79,10 @@ func resourceAddon() *schema.Resource {
 				ValidateFunc: validClusterName,
 			},
 			"configuration_values": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
 			},
 			"created_at": {
 				Type:     schema.TypeString,
@@ -90,6 +92,23 @@ func resourceAddon() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"pod_identity_association": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"service_account": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"preserve": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -148,6 +167,10 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.ConfigurationValues = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("pod_identity_association"); ok && v.(*schema.Set).Len() > 0 {
+		input.PodIdentityAssociations = expandAddonPodIdentityAssociations(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("resolve_conflicts"); ok {
 		input.ResolveConflicts = types.ResolveConflicts(v.(string))
 	} else if v, ok := d.GetOk("resolve_conflicts_on_create"); ok {
@@ -226,6 +249,13 @@ func resourceAddonRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("configuration_values", addon.ConfigurationValues)
 	d.Set("created_at", aws.ToTime(addon.CreatedAt).Format(time.RFC3339))
 	d.Set("modified_at", aws.ToTime(addon.ModifiedAt).Format(time.RFC3339))
+	podIdentityAssociations, err := flattenAddonPodIdentityAssociations(ctx, conn, clusterName, addon.PodIdentityAssociations)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Add-On (%s) Pod Identity Associations: %s", d.Id(), err)
+	}
+	if err := d.Set("pod_identity_association", podIdentityAssociations); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting pod_identity_association: %s", err)
+	}
 	d.Set("service_account_role_arn", addon.ServiceAccountRoleArn)
 
 	setTagsOut(ctx, addon.Tags)
@@ -242,7 +272,7 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChanges("addon_version", "service_account_role_arn", "configuration_values") {
+	if d.HasChanges("addon_version", "configuration_values", "pod_identity_association", "service_account_role_arn") {
 		input := &eks.UpdateAddonInput{
 			AddonName:          aws.String(addonName),
 			ClientRequestToken: aws.String(sdkid.UniqueId()),
@@ -257,6 +287,11 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.ConfigurationValues = aws.String(d.Get("configuration_values").(string))
 		}
 
+		// An empty list removes all Pod Identity Associations owned by the add-on.
+		if d.HasChange("pod_identity_association") {
+			input.PodIdentityAssociations = expandAddonPodIdentityAssociations(d.Get("pod_identity_association").(*schema.Set).List())
+		}
+
 		var conflictResolutionAttr string
 		var conflictResolution types.ResolveConflicts
 
@@ -478,6 +513,59 @@ func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterNam
 	return nil, err
 }
 
+func expandAddonPodIdentityAssociations(tfList []interface{}) []types.AddonPodIdentityAssociations {
+	apiObjects := make([]types.AddonPodIdentityAssociations, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.AddonPodIdentityAssociations{}
+
+		if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+			apiObject.RoleArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["service_account"].(string); ok && v != "" {
+			apiObject.ServiceAccount = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// flattenAddonPodIdentityAssociations looks up each of the add-on's Pod Identity Association ARNs,
+// as DescribeAddon returns only the ARNs and not the associated IAM role and service account.
+func flattenAddonPodIdentityAssociations(ctx context.Context, conn *eks.Client, clusterName string, associationARNs []string) ([]interface{}, error) {
+	tfList := make([]interface{}, 0, len(associationARNs))
+
+	for _, associationARN := range associationARNs {
+		// arn:${Partition}:eks:${Region}:${Account}:podidentityassociation/${ClusterName}/${AssociationID}
+		parsedARN, err := arn.Parse(associationARN)
+		if err != nil {
+			return nil, err
+		}
+
+		associationID := parsedARN.Resource[strings.LastIndex(parsedARN.Resource, "/")+1:]
+		association, err := findPodIdentityAssociationByTwoPartKey(ctx, conn, associationID, clusterName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"role_arn":        aws.ToString(association.RoleArn),
+			"service_account": aws.ToString(association.ServiceAccount),
+		})
+	}
+
+	return tfList, nil
+}
+
 func addonIssueError(apiObject types.AddonIssue) error {
 	return fmt.Errorf("%s: %s", apiObject.Code, aws.ToString(apiObject.Message))
 }
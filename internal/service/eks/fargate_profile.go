@@ -76,19 +76,21 @@ func resourceFargateProfile() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				MinItems: 1,
+				MaxItems: 5,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"labels": {
-							Type:     schema.TypeMap,
-							Optional: true,
-							ForceNew: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:             schema.TypeMap,
+							Optional:         true,
+							ForceNew:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							ValidateDiagFunc: verify.MapLenBetween(0, 5),
 						},
 						"namespace": {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: validation.NoZeroValues,
+							ValidateFunc: validFargateProfileSelectorNamespace,
 						},
 					},
 				},
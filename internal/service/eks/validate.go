@@ -26,3 +26,22 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+func validFargateProfileSelectorNamespace(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 63 {
+		errors = append(errors, fmt.Errorf(
+			"%q length must be between 1-63 characters: %q", k, value))
+	}
+
+	// Kubernetes namespace names (RFC 1123 labels) in which the * and ? wildcard characters may be used.
+	// https://docs.aws.amazon.com/eks/latest/userguide/fargate-profile.html#fargate-profile-wildcards
+	pattern := `^[0-9a-z*?]([0-9a-z*?-]*[0-9a-z*?])?$`
+	if !regexache.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't comply with restrictions (%q): %q",
+			k, pattern, value))
+	}
+
+	return
+}
@@ -66,3 +66,61 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidFargateProfileSelectorNamespace(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "kube-system",
+			ErrCount: 0,
+		},
+		{
+			Value:    "prod-*",
+			ErrCount: 0,
+		},
+		{
+			Value:    "*",
+			ErrCount: 0,
+		},
+		{
+			Value:    "team-?-dev",
+			ErrCount: 0,
+		},
+		{
+			Value:    `Invalid`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `-invalid`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `invalid-`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `invalid_namespace`,
+			ErrCount: 1,
+		},
+		{
+			Value:    ``,
+			ErrCount: 2,
+		},
+		{
+			Value:    sdkacctest.RandStringFromCharSet(64, sdkacctest.CharSetAlpha),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validFargateProfileSelectorNamespace(tc.Value, "namespace")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the EKS Fargate Profile selector namespace to trigger a validation error: %s, expected %d, got %d errors", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
@@ -472,6 +472,10 @@ func resourceGlobalReplicationGroupUpdate(ctx context.Context, d *schema.Resourc
 				return sdkdiag.AppendErrorf(diags, "updating ElastiCache Global Replication Group (%s): %s", d.Id(), err)
 			}
 		}
+
+		if err := waitGlobalReplicationGroupMembersAvailable(ctx, conn, d.Id(), meta.(*conns.AWSClient).Region, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ElastiCache Global Replication Group (%s) engine version: waiting for members: %s", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("global_replication_group_description") {
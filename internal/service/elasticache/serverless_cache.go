@@ -215,6 +215,9 @@ func (r *resourceServerlessCache) Schema(ctx context.Context, request resource.S
 									},
 								},
 							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
 						},
 					},
 				},
@@ -350,6 +353,11 @@ func (r *resourceServerlessCache) Update(ctx context.Context, request resource.U
 
 		input.ServerlessCacheName = flex.StringFromFramework(ctx, state.Name)
 
+		// An omitted user group leaves the existing one associated.
+		if !state.UserGroupID.IsNull() && plan.UserGroupID.IsNull() {
+			input.RemoveUserGroup = aws.Bool(true)
+		}
+
 		_, err := conn.ModifyServerlessCache(ctx, input)
 
 		if err != nil {
@@ -255,6 +255,45 @@ func TestAccElastiCacheServerlessCache_update(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheServerlessCache_userGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_serverless_cache.test"
+	var serverlessElasticCache awstypes.ServerlessCache
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerlessCacheDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessCacheConfig_userGroup(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &serverlessElasticCache),
+					resource.TestCheckResourceAttrPair(resourceName, "user_group_id", "aws_elasticache_user_group.test", "user_group_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServerlessCacheConfig_userGroup(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &serverlessElasticCache),
+					resource.TestCheckNoResourceAttr(resourceName, "user_group_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElastiCacheServerlessCache_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -514,6 +553,35 @@ resource "aws_elasticache_serverless_cache" "test" {
 `, rName, desc)
 }
 
+func testAccServerlessCacheConfig_userGroup(rName string, associate bool) string {
+	userGroupID := "null"
+	if associate {
+		userGroupID = "aws_elasticache_user_group.test.user_group_id"
+	}
+
+	return fmt.Sprintf(`
+resource "aws_elasticache_user" "test" {
+  user_id       = %[1]q
+  user_name     = "default"
+  access_string = "on ~* +@all"
+  engine        = "REDIS"
+  passwords     = ["password123456789"]
+}
+
+resource "aws_elasticache_user_group" "test" {
+  user_group_id = %[1]q
+  engine        = "REDIS"
+  user_ids      = [aws_elasticache_user.test.user_id]
+}
+
+resource "aws_elasticache_serverless_cache" "test" {
+  engine        = "redis"
+  name          = %[1]q
+  user_group_id = %[2]s
+}
+`, rName, userGroupID)
+}
+
 func testAccServerlessCacheConfig_tags(rName, tags string) string {
 	return fmt.Sprintf(`
 resource "aws_elasticache_serverless_cache" "test" {
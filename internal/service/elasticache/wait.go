@@ -5,10 +5,12 @@ package elasticache
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	elasticache_v2 "github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
@@ -235,6 +237,29 @@ func waitGlobalReplicationGroupAvailable(ctx context.Context, conn *elasticache.
 	return nil, err
 }
 
+// waitGlobalReplicationGroupMembersAvailable waits for each member Replication Group in the given region
+// to be available. Changes such as engine version upgrades are rolled out to members one at a time,
+// and the Global Replication Group can report available before every member has finished modifying.
+func waitGlobalReplicationGroupMembersAvailable(ctx context.Context, conn *elasticache.ElastiCache, globalReplicationGroupID, region string, timeout time.Duration) error {
+	globalReplicationGroup, err := FindGlobalReplicationGroupByID(ctx, conn, globalReplicationGroupID)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range globalReplicationGroup.Members {
+		if aws.StringValue(member.ReplicationGroupRegion) != region {
+			continue
+		}
+
+		id := aws.StringValue(member.ReplicationGroupId)
+		if _, err := WaitReplicationGroupAvailable(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("member Replication Group (%s): %w", id, err)
+		}
+	}
+
+	return nil
+}
+
 // waitGlobalReplicationGroupDeleted waits for a Global Replication Group to be deleted
 func waitGlobalReplicationGroupDeleted(ctx context.Context, conn *elasticache.ElastiCache, globalReplicationGroupID string, timeout time.Duration) (*elasticache.GlobalReplicationGroup, error) {
 	stateConf := &retry.StateChangeConf{
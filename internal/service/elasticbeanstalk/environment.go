@@ -5,10 +5,12 @@ package elasticbeanstalk
 
 import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +21,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -26,6 +29,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	sdktypes "github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -92,7 +96,10 @@ func ResourceEnvironment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffSharedLoadBalancer,
+			verify.SetTagsDiff,
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  EnvironmentMigrateState,
@@ -379,6 +386,15 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 	d.Set("version_label", env.VersionLabel)
 
+	settings := d.Get("setting").(*schema.Set)
+
+	// Values that are equivalent to the configured ones (e.g. "True" vs. "true",
+	// or comma-separated lists in a different order) keep their configured form.
+	configuredValues := make(map[int]string)
+	for _, v := range settings.List() {
+		configuredValues[optionSettingKeyHash(v)], _ = v.(map[string]interface{})["value"].(string)
+	}
+
 	allSettings := &schema.Set{F: optionSettingValueHash}
 	for _, optionSetting := range configurationSettings.OptionSettings {
 		m := map[string]interface{}{}
@@ -404,11 +420,14 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 			default:
 				m["value"] = value
 			}
+
+			if v, ok := configuredValues[optionSettingKeyHash(m)]; ok && optionSettingValuesEquivalent(m["namespace"].(string), m["name"].(string), v, m["value"].(string)) {
+				m["value"] = v
+			}
 		}
 
 		allSettings.Add(m)
 	}
-	settings := d.Get("setting").(*schema.Set)
 
 	// perform the set operation with only name/namespace as keys, excluding value
 	// this is so we override things in the settings resource data key with updated values
@@ -813,6 +832,134 @@ func sortValues(v string) string {
 	return strings.Join(values, ",")
 }
 
+// optionSettingValuesEquivalent returns whether two option setting values only differ
+// in the case of a boolean or, for the listener rule options that the API returns reordered,
+// in the order of a comma-separated list.
+func optionSettingValuesEquivalent(namespace, name, v1, v2 string) bool {
+	if v1 == v2 {
+		return true
+	}
+
+	if strings.EqualFold(v1, v2) && (strings.EqualFold(v1, "true") || strings.EqualFold(v1, "false")) {
+		return true
+	}
+
+	switch {
+	case strings.HasPrefix(namespace, "aws:elbv2:listenerrule:") && (name == "PathPatterns" || name == "HostHeaders"),
+		strings.HasPrefix(namespace, "aws:elbv2:listener:") && name == "Rules":
+		return sortValues(v1) == sortValues(v2)
+	}
+
+	return false
+}
+
+// customizeDiffSharedLoadBalancer validates the option settings of environments that use a shared Application Load Balancer.
+// Settings that come from a configuration template are not known at plan time, so validation is skipped for those environments.
+func customizeDiffSharedLoadBalancer(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("setting") || diff.Get("template_name").(string) != "" {
+		return nil
+	}
+
+	return validateSharedLoadBalancerSettings(diff.Get("tier").(string), diff.Get("setting").(*schema.Set).List())
+}
+
+func validateSharedLoadBalancerSettings(tier string, settings []interface{}) error {
+	const (
+		namespaceEnvironment         = "aws:elasticbeanstalk:environment"
+		namespaceListenerPrefix      = "aws:elbv2:listener:"
+		namespaceListenerRulePrefix  = "aws:elbv2:listenerrule:"
+		namespaceLoadBalancer        = "aws:elbv2:loadbalancer"
+		optionLoadBalancerIsShared   = "LoadBalancerIsShared"
+		optionSharedLoadBalancer     = "SharedLoadBalancer"
+		listenerRulePriorityMaxValue = 1000
+	)
+
+	values := make(map[string]string)
+	for _, v := range settings {
+		m := v.(map[string]interface{})
+		value, _ := m["value"].(string)
+		values[m["namespace"].(string)+":"+m["name"].(string)] = value
+	}
+	keys := tfmaps.Keys(values)
+	slices.Sort(keys)
+
+	var errs []error
+
+	for _, k := range keys {
+		namespace, name, ok := cutOptionKey(k)
+		if !ok || !strings.HasPrefix(namespace, namespaceListenerRulePrefix) || name != "Priority" || values[k] == "" {
+			continue
+		}
+
+		if v, err := strconv.Atoi(values[k]); err != nil || v < 1 || v > listenerRulePriorityMaxValue {
+			errs = append(errs, fmt.Errorf("setting %s %s: value (%s) must be an integer between 1 and %d", namespace, name, values[k], listenerRulePriorityMaxValue))
+		}
+	}
+
+	isShared := strings.EqualFold(values[namespaceEnvironment+":"+optionLoadBalancerIsShared], "true")
+	_, hasSharedLoadBalancer := values[namespaceLoadBalancer+":"+optionSharedLoadBalancer]
+
+	if !isShared {
+		if hasSharedLoadBalancer {
+			errs = append(errs, fmt.Errorf("setting %s %s can only be specified when %s %s is true", namespaceLoadBalancer, optionSharedLoadBalancer, namespaceEnvironment, optionLoadBalancerIsShared))
+		}
+
+		return errors.Join(errs...)
+	}
+
+	if tier == environmentTierWorker {
+		errs = append(errs, fmt.Errorf("%s %s is not supported for %s tier environments", namespaceEnvironment, optionLoadBalancerIsShared, tier))
+	}
+
+	if v, ok := values[namespaceEnvironment+":EnvironmentType"]; ok && v != "LoadBalanced" {
+		errs = append(errs, fmt.Errorf("%s %s requires %s EnvironmentType to be LoadBalanced, got %s", namespaceEnvironment, optionLoadBalancerIsShared, namespaceEnvironment, v))
+	}
+
+	if v, ok := values[namespaceEnvironment+":LoadBalancerType"]; ok && v != "application" {
+		errs = append(errs, fmt.Errorf("%s %s requires %s LoadBalancerType to be application, got %s", namespaceEnvironment, optionLoadBalancerIsShared, namespaceEnvironment, v))
+	}
+
+	if !hasSharedLoadBalancer {
+		errs = append(errs, fmt.Errorf("%s %s requires setting %s %s", namespaceEnvironment, optionLoadBalancerIsShared, namespaceLoadBalancer, optionSharedLoadBalancer))
+	}
+
+	for _, k := range keys {
+		namespace, name, ok := cutOptionKey(k)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case namespace == namespaceLoadBalancer:
+			switch name {
+			case "AccessLogsS3Bucket", "AccessLogsS3Enabled", "AccessLogsS3Prefix", "IdleTimeout", "ManagedSecurityGroup":
+			default:
+				continue
+			}
+		case strings.HasPrefix(namespace, namespaceListenerPrefix):
+			if name == "Rules" {
+				continue
+			}
+		default:
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("setting %s %s is not supported with a shared load balancer", namespace, name))
+	}
+
+	return errors.Join(errs...)
+}
+
+// cutOptionKey splits a "namespace:name" key on its last colon, as namespaces themselves contain colons.
+func cutOptionKey(k string) (string, string, bool) {
+	i := strings.LastIndex(k, ":")
+	if i < 0 {
+		return "", "", false
+	}
+
+	return k[:i], k[i+1:], true
+}
+
 func extractOptionSettings(s *schema.Set) []*elasticbeanstalk.ConfigurationOptionSetting {
 	settings := []*elasticbeanstalk.ConfigurationOptionSetting{}
 
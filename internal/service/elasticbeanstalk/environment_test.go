@@ -470,6 +470,64 @@ func TestAccElasticBeanstalkEnvironment_platformARN(t *testing.T) {
 	})
 }
 
+func TestAccElasticBeanstalkEnvironment_sharedLoadBalancer(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app elasticbeanstalk.EnvironmentDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticBeanstalkServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_sharedLoadBalancer(rName, "/api/*,/v1/*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"namespace": "aws:elasticbeanstalk:environment",
+						"name":      "LoadBalancerIsShared",
+						"value":     "True",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"namespace": "aws:elbv2:listenerrule:api",
+						"name":      "PathPatterns",
+						"value":     "/api/*,/v1/*",
+					}),
+				),
+			},
+			{
+				Config:   testAccEnvironmentConfig_sharedLoadBalancer(rName, "/v1/*,/api/*"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkEnvironment_sharedLoadBalancerValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticBeanstalkServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEnvironmentConfig_sharedLoadBalancerInvalid(rName, "LoadBalancerType", "classic"),
+				ExpectError: regexache.MustCompile(`requires aws:elasticbeanstalk:environment LoadBalancerType to be application`),
+			},
+			{
+				Config:      testAccEnvironmentConfig_sharedLoadBalancerInvalid(rName, "EnvironmentType", "SingleInstance"),
+				ExpectError: regexache.MustCompile(`requires aws:elasticbeanstalk:environment EnvironmentType to be LoadBalanced`),
+			},
+		},
+	})
+}
+
 func testAccCheckEnvironmentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticBeanstalkConn(ctx)
@@ -1640,3 +1698,158 @@ EOF
 }
 `, rName, publicKey, email))
 }
+
+func testAccEnvironmentConfig_sharedLoadBalancerBase(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_subnet" "test2" {
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[1]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, 1)
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_lb" "test" {
+  name            = %[1]q
+  security_groups = [aws_security_group.test.id]
+  subnets         = [aws_subnet.test[0].id, aws_subnet.test2.id]
+
+  depends_on = [aws_internet_gateway.test]
+}
+
+resource "aws_lb_listener" "test" {
+  load_balancer_arn = aws_lb.test.arn
+  port              = 80
+  protocol          = "HTTP"
+
+  default_action {
+    type = "fixed-response"
+
+    fixed_response {
+      content_type = "text/plain"
+      status_code  = "404"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccEnvironmentConfig_sharedLoadBalancer(rName, pathPatterns string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_sharedLoadBalancerBase(rName), fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = %[1]q
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test[0].id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "ELBSubnets"
+    value     = "${aws_subnet.test[0].id},${aws_subnet.test2.id}"
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "LoadBalancerType"
+    value     = "application"
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "LoadBalancerIsShared"
+    value     = "True"
+  }
+
+  setting {
+    namespace = "aws:elbv2:loadbalancer"
+    name      = "SharedLoadBalancer"
+    value     = aws_lb.test.arn
+  }
+
+  setting {
+    namespace = "aws:elbv2:listener:80"
+    name      = "Rules"
+    value     = "default,api"
+  }
+
+  setting {
+    namespace = "aws:elbv2:listenerrule:api"
+    name      = "PathPatterns"
+    value     = %[2]q
+  }
+
+  setting {
+    namespace = "aws:elbv2:listenerrule:api"
+    name      = "Priority"
+    value     = "10"
+  }
+
+  depends_on = [aws_lb_listener.test]
+}
+`, rName, pathPatterns))
+}
+
+func testAccEnvironmentConfig_sharedLoadBalancerInvalid(rName, optionName, value string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = %[1]q
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "LoadBalancerIsShared"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:elbv2:loadbalancer"
+    name      = "SharedLoadBalancer"
+    value     = "arn:${data.aws_partition.current.partition}:elasticloadbalancing:${data.aws_region.current.name}:123456789012:loadbalancer/app/%[1]s/0123456789abcdef"
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = %[2]q
+    value     = %[3]q
+  }
+}
+`, rName, optionName, value))
+}
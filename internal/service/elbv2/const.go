@@ -14,6 +14,7 @@ const (
 )
 
 const (
+	errCodeAccessDenied    = "AccessDenied"
 	errCodeValidationError = "ValidationError"
 
 	tagsOnCreationErrMessage = "cannot specify tags on creation"
@@ -127,6 +128,32 @@ const (
 	targetGroupAttributeTargetFailoverOnUnhealthy      = "target_failover.on_unhealthy"
 )
 
+// See https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_ListenerAttribute.html#API_ListenerAttribute_Contents.
+const (
+	// The following attributes are supported only by Application Load Balancer HTTPS listeners:
+	listenerAttributeRoutingHTTPRequestXAmznMTLSClientCertSerialNumberHeaderName = "routing.http.request.x_amzn_mtls_clientcert_serial_number.header_name"
+	listenerAttributeRoutingHTTPRequestXAmznMTLSClientCertIssuerHeaderName       = "routing.http.request.x_amzn_mtls_clientcert_issuer.header_name"
+	listenerAttributeRoutingHTTPRequestXAmznMTLSClientCertSubjectHeaderName      = "routing.http.request.x_amzn_mtls_clientcert_subject.header_name"
+	listenerAttributeRoutingHTTPRequestXAmznMTLSClientCertValidityHeaderName     = "routing.http.request.x_amzn_mtls_clientcert_validity.header_name"
+	listenerAttributeRoutingHTTPRequestXAmznMTLSClientCertLeafHeaderName         = "routing.http.request.x_amzn_mtls_clientcert_leaf.header_name"
+	listenerAttributeRoutingHTTPRequestXAmznMTLSClientCertHeaderName             = "routing.http.request.x_amzn_mtls_clientcert.header_name"
+	listenerAttributeRoutingHTTPRequestXAmznTLSVersionHeaderName                 = "routing.http.request.x_amzn_tls_version.header_name"
+	listenerAttributeRoutingHTTPRequestXAmznTLSCipherSuiteHeaderName             = "routing.http.request.x_amzn_tls_cipher_suite.header_name"
+
+	// The following attributes are supported only by Application Load Balancer HTTP and HTTPS listeners:
+	listenerAttributeRoutingHTTPResponseServerEnabled                            = "routing.http.response.server.enabled"
+	listenerAttributeRoutingHTTPResponseStrictTransportSecurityHeaderValue       = "routing.http.response.strict_transport_security.header_value"
+	listenerAttributeRoutingHTTPResponseAccessControlAllowOriginHeaderValue      = "routing.http.response.access_control_allow_origin.header_value"
+	listenerAttributeRoutingHTTPResponseAccessControlAllowMethodsHeaderValue     = "routing.http.response.access_control_allow_methods.header_value"
+	listenerAttributeRoutingHTTPResponseAccessControlAllowHeadersHeaderValue     = "routing.http.response.access_control_allow_headers.header_value"
+	listenerAttributeRoutingHTTPResponseAccessControlAllowCredentialsHeaderValue = "routing.http.response.access_control_allow_credentials.header_value"
+	listenerAttributeRoutingHTTPResponseAccessControlExposeHeadersHeaderValue    = "routing.http.response.access_control_expose_headers.header_value"
+	listenerAttributeRoutingHTTPResponseAccessControlMaxAgeHeaderValue           = "routing.http.response.access_control_max_age.header_value"
+	listenerAttributeRoutingHTTPResponseContentSecurityPolicyHeaderValue         = "routing.http.response.content_security_policy.header_value"
+	listenerAttributeRoutingHTTPResponseXContentTypeOptionsHeaderValue           = "routing.http.response.x_content_type_options.header_value"
+	listenerAttributeRoutingHTTPResponseXFrameOptionsHeaderValue                 = "routing.http.response.x_frame_options.header_value"
+)
+
 const (
 	loadBalancingAlgorithmTypeRoundRobin               = "round_robin"
 	loadBalancingAlgorithmTypeLeastOutstandingRequests = "least_outstanding_requests"
@@ -201,6 +228,10 @@ const (
 	healthCheckPortTrafficPort = "traffic-port"
 )
 
+const (
+	targetGroupHealthOff = "off"
+)
+
 func healthCheckProtocolEnumValues() []string {
 	return []string{
 		elbv2.ProtocolEnumHttp,
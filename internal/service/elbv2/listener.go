@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -28,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -381,6 +384,103 @@ func ResourceListener() *schema.Resource {
 				},
 				ValidateDiagFunc: enum.ValidateIgnoreCase[awstypes.ProtocolEnum](),
 			},
+			"routing_http_request_x_amzn_mtls_clientcert_header_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_request_x_amzn_mtls_clientcert_issuer_header_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_request_x_amzn_mtls_clientcert_leaf_header_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_request_x_amzn_mtls_clientcert_serial_number_header_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_request_x_amzn_mtls_clientcert_subject_header_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_request_x_amzn_mtls_clientcert_validity_header_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_request_x_amzn_tls_cipher_suite_header_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_request_x_amzn_tls_version_header_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_response_access_control_allow_credentials_header_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"true"}, false),
+			},
+			"routing_http_response_access_control_allow_headers_header_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_response_access_control_allow_methods_header_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_response_access_control_allow_origin_header_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_response_access_control_expose_headers_header_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_response_access_control_max_age_header_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_response_content_security_policy_header_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_response_server_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_response_strict_transport_security_header_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"routing_http_response_x_content_type_options_header_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"nosniff"}, false),
+			},
+			"routing_http_response_x_frame_options_header_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"ssl_policy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -393,6 +493,7 @@ func ResourceListener() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
 			validateListenerActionsCustomDiff("default_action"),
+			validateListenerMutualAuthenticationCustomDiff,
 		),
 	}
 }
@@ -489,6 +590,12 @@ func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "waiting for ELBv2 Listener (%s) create: %s", d.Id(), err)
 	}
 
+	if attributes := listenerAttributes.expand(d, awstypes.ProtocolEnum(d.Get("protocol").(string)), false); len(attributes) > 0 {
+		if err := modifyListenerAttributes(ctx, conn, d.Id(), attributes); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	// For partitions not supporting tag-on-create, attempt tag after create.
 	if tags := getTagsInV2(ctx); input.Tags == nil && len(tags) > 0 {
 		err := createTagsV2(ctx, conn, d.Id(), tags)
@@ -543,6 +650,21 @@ func resourceListenerRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("protocol", listener.Protocol)
 	d.Set("ssl_policy", listener.SslPolicy)
 
+	if listenerAttributes.supported(listener.Protocol) {
+		attributes, err := findListenerAttributesByARN(ctx, conn, d.Id())
+
+		// Listener attributes may not be available in all partitions or to all principals.
+		// If none are configured, continue. Otherwise, error.
+		switch {
+		case err == nil:
+			listenerAttributes.flatten(d, attributes)
+		case !listenerAttributes.configured(d) && (tfawserr_sdkv2.ErrCodeEquals(err, errCodeAccessDenied) || errs.IsUnsupportedOperationInPartitionError(meta.(*conns.AWSClient).Partition, err)):
+			log.Printf("[WARN] reading ELBv2 Listener (%s) attributes: %s", d.Id(), err)
+		default:
+			return sdkdiag.AppendErrorf(diags, "reading ELBv2 Listener (%s) attributes: %s", d.Id(), err)
+		}
+	}
+
 	return diags
 }
 
@@ -550,7 +672,7 @@ func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ELBV2Client(ctx)
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept(append(listenerAttributes.keys(), "tags", "tags_all")...) {
 		input := &elasticloadbalancingv2.ModifyListenerInput{
 			ListenerArn: aws.String(d.Id()),
 		}
@@ -597,6 +719,12 @@ func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if attributes := listenerAttributes.expand(d, awstypes.ProtocolEnum(d.Get("protocol").(string)), true); len(attributes) > 0 {
+		if err := modifyListenerAttributes(ctx, conn, d.Id(), attributes); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceListenerRead(ctx, d, meta)...)
 }
 
@@ -628,6 +756,238 @@ func retryListenerCreate(ctx context.Context, conn *elasticloadbalancingv2.Clien
 	return outputRaw.(*elasticloadbalancingv2.CreateListenerOutput), nil
 }
 
+func modifyListenerAttributes(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string, attributes []awstypes.ListenerAttribute) error {
+	input := &elasticloadbalancingv2.ModifyListenerAttributesInput{
+		Attributes:  attributes,
+		ListenerArn: aws.String(arn),
+	}
+
+	_, err := conn.ModifyListenerAttributes(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("modifying ELBv2 Listener (%s) attributes: %w", arn, err)
+	}
+
+	return nil
+}
+
+type listenerAttributeInfo struct {
+	apiAttributeKey    string
+	tfType             schema.ValueType
+	protocolsSupported []awstypes.ProtocolEnum
+}
+
+type listenerAttributeMap map[string]listenerAttributeInfo
+
+var listenerAttributes = listenerAttributeMap(map[string]listenerAttributeInfo{
+	"routing_http_request_x_amzn_mtls_clientcert_header_name": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPRequestXAmznMTLSClientCertHeaderName,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_request_x_amzn_mtls_clientcert_issuer_header_name": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPRequestXAmznMTLSClientCertIssuerHeaderName,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_request_x_amzn_mtls_clientcert_leaf_header_name": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPRequestXAmznMTLSClientCertLeafHeaderName,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_request_x_amzn_mtls_clientcert_serial_number_header_name": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPRequestXAmznMTLSClientCertSerialNumberHeaderName,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_request_x_amzn_mtls_clientcert_subject_header_name": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPRequestXAmznMTLSClientCertSubjectHeaderName,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_request_x_amzn_mtls_clientcert_validity_header_name": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPRequestXAmznMTLSClientCertValidityHeaderName,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_request_x_amzn_tls_cipher_suite_header_name": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPRequestXAmznTLSCipherSuiteHeaderName,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_request_x_amzn_tls_version_header_name": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPRequestXAmznTLSVersionHeaderName,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_response_access_control_allow_credentials_header_value": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPResponseAccessControlAllowCredentialsHeaderValue,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttp, awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_response_access_control_allow_headers_header_value": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPResponseAccessControlAllowHeadersHeaderValue,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttp, awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_response_access_control_allow_methods_header_value": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPResponseAccessControlAllowMethodsHeaderValue,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttp, awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_response_access_control_allow_origin_header_value": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPResponseAccessControlAllowOriginHeaderValue,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttp, awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_response_access_control_expose_headers_header_value": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPResponseAccessControlExposeHeadersHeaderValue,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttp, awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_response_access_control_max_age_header_value": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPResponseAccessControlMaxAgeHeaderValue,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttp, awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_response_content_security_policy_header_value": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPResponseContentSecurityPolicyHeaderValue,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttp, awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_response_server_enabled": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPResponseServerEnabled,
+		tfType:             schema.TypeBool,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttp, awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_response_strict_transport_security_header_value": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPResponseStrictTransportSecurityHeaderValue,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttp, awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_response_x_content_type_options_header_value": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPResponseXContentTypeOptionsHeaderValue,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttp, awstypes.ProtocolEnumHttps},
+	},
+	"routing_http_response_x_frame_options_header_value": {
+		apiAttributeKey:    listenerAttributeRoutingHTTPResponseXFrameOptionsHeaderValue,
+		tfType:             schema.TypeString,
+		protocolsSupported: []awstypes.ProtocolEnum{awstypes.ProtocolEnumHttp, awstypes.ProtocolEnumHttps},
+	},
+})
+
+func (m listenerAttributeMap) keys() []string {
+	return tfmaps.Keys(m)
+}
+
+// supported returns whether any listener attribute applies to the specified protocol.
+func (m listenerAttributeMap) supported(protocol awstypes.ProtocolEnum) bool {
+	for _, attributeInfo := range m {
+		if slices.Contains(attributeInfo.protocolsSupported, protocol) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// configured returns whether any listener attribute is set in configuration.
+// Configuration isn't available during refresh, in which case it returns false.
+func (m listenerAttributeMap) configured(d *schema.ResourceData) bool {
+	configRaw := d.GetRawConfig()
+	if !configRaw.IsKnown() || configRaw.IsNull() {
+		return false
+	}
+
+	for tfAttributeName := range m {
+		if v := configRaw.GetAttr(tfAttributeName); v.IsKnown() && !v.IsNull() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m listenerAttributeMap) expand(d *schema.ResourceData, protocol awstypes.ProtocolEnum, update bool) []awstypes.ListenerAttribute {
+	var apiObjects []awstypes.ListenerAttribute
+
+	for tfAttributeName, attributeInfo := range m {
+		if update && !d.HasChange(tfAttributeName) {
+			continue
+		}
+
+		if !slices.Contains(attributeInfo.protocolsSupported, protocol) {
+			continue
+		}
+
+		// Only send values that are set in configuration.
+		if v := d.GetRawConfig().GetAttr(tfAttributeName); !v.IsKnown() || v.IsNull() {
+			continue
+		}
+
+		switch v, t, k := d.Get(tfAttributeName), attributeInfo.tfType, aws.String(attributeInfo.apiAttributeKey); t {
+		case schema.TypeBool:
+			apiObjects = append(apiObjects, awstypes.ListenerAttribute{
+				Key:   k,
+				Value: flex.BoolValueToString(v.(bool)),
+			})
+		case schema.TypeString:
+			apiObjects = append(apiObjects, awstypes.ListenerAttribute{
+				Key:   k,
+				Value: aws.String(v.(string)),
+			})
+		}
+	}
+
+	return apiObjects
+}
+
+func (m listenerAttributeMap) flatten(d *schema.ResourceData, apiObjects []awstypes.ListenerAttribute) {
+	for tfAttributeName, attributeInfo := range m {
+		k := attributeInfo.apiAttributeKey
+		i := slices.IndexFunc(apiObjects, func(v awstypes.ListenerAttribute) bool {
+			return aws.ToString(v.Key) == k
+		})
+
+		if i == -1 {
+			continue
+		}
+
+		switch v, t := apiObjects[i].Value, attributeInfo.tfType; t {
+		case schema.TypeBool:
+			d.Set(tfAttributeName, flex.StringToBoolValue(v))
+		case schema.TypeString:
+			d.Set(tfAttributeName, v)
+		}
+	}
+}
+
+func findListenerAttributesByARN(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string) ([]awstypes.ListenerAttribute, error) {
+	input := &elasticloadbalancingv2.DescribeListenerAttributesInput{
+		ListenerArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeListenerAttributes(ctx, input)
+
+	if errs.IsA[*awstypes.ListenerNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Attributes, nil
+}
+
 func findListenerByARN(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string) (*awstypes.Listener, error) {
 	input := &elasticloadbalancingv2.DescribeListenersInput{
 		ListenerArns: []string{arn},
@@ -1239,6 +1599,51 @@ func validateListenerActionsCustomDiff(attrName string) schema.CustomizeDiffFunc
 	}
 }
 
+func validateListenerMutualAuthenticationCustomDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	var diags diag.Diagnostics
+
+	configRaw := d.GetRawConfig()
+	if !configRaw.IsKnown() || configRaw.IsNull() {
+		return nil
+	}
+
+	mutualAuthentication := configRaw.GetAttr("mutual_authentication")
+	if !mutualAuthentication.IsKnown() || mutualAuthentication.IsNull() || mutualAuthentication.LengthInt() == 0 {
+		return nil
+	}
+
+	path := cty.GetAttrPath("mutual_authentication").IndexInt(0)
+	tfMap := mutualAuthentication.Index(cty.NumberIntVal(0))
+	if !tfMap.IsKnown() || tfMap.IsNull() {
+		return nil
+	}
+
+	mode := tfMap.GetAttr("mode")
+	if !mode.IsKnown() || mode.IsNull() {
+		return nil
+	}
+
+	if strings.EqualFold(mode.AsString(), mutualAuthenticationVerify) {
+		if v := tfMap.GetAttr("trust_store_arn"); v.IsKnown() && (v.IsNull() || v.AsString() == "") {
+			diags = append(diags, errs.NewAttributeRequiredWhenError(
+				path.GetAttr("trust_store_arn"),
+				path.GetAttr("mode"),
+				mutualAuthenticationVerify,
+			))
+		}
+	} else {
+		if v := tfMap.GetAttr("ignore_client_certificate_expiry"); v.IsKnown() && !v.IsNull() && v.True() {
+			diags = append(diags, errs.NewAttributeConflictsWhenError(
+				path.GetAttr("ignore_client_certificate_expiry"),
+				path.GetAttr("mode"),
+				mode.AsString(),
+			))
+		}
+	}
+
+	return sdkdiag.DiagnosticsError(diags)
+}
+
 func listenerActionsPlantimeValidate(actionsPath cty.Path, actions cty.Value, diags *diag.Diagnostics) {
 	it := actions.ElementIterator()
 	for it.Next() {
@@ -313,6 +313,60 @@ func ResourceTargetGroup() *schema.Resource {
 					},
 				},
 			},
+			"target_group_health": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_failover": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_healthy_targets_count": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "1",
+										ValidateFunc: validTargetGroupHealthInput,
+									},
+									"minimum_healthy_targets_percentage": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      targetGroupHealthOff,
+										ValidateFunc: validTargetGroupHealthPercentageInput,
+									},
+								},
+							},
+						},
+						"unhealthy_state_routing": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_healthy_targets_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"minimum_healthy_targets_percentage": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      targetGroupHealthOff,
+										ValidateFunc: validTargetGroupHealthPercentageInput,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"target_health_state": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -478,6 +532,10 @@ func resourceTargetGroupCreate(ctx context.Context, d *schema.ResourceData, meta
 			attributes = append(attributes, expandTargetGroupTargetFailoverAttributes(v.([]interface{})[0].(map[string]interface{}), protocol)...)
 		}
 
+		if v, ok := d.GetOk("target_group_health"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			attributes = append(attributes, expandTargetGroupHealthAttributes(v.([]interface{})[0].(map[string]interface{}), protocol)...)
+		}
+
 		if v, ok := d.GetOk("target_health_state"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			attributes = append(attributes, expandTargetGroupTargetHealthStateAttributes(v.([]interface{})[0].(map[string]interface{}), protocol)...)
 		}
@@ -576,6 +634,13 @@ func resourceTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "setting target_failover: %s", err)
 	}
 
+	// Target group health is only applied to instance and ip target groups.
+	if targetType == elbv2.TargetTypeEnumInstance || targetType == elbv2.TargetTypeEnumIp {
+		if err := d.Set("target_group_health", []interface{}{flattenTargetGroupHealthAttributes(attributes, protocol)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting target_group_health: %s", err)
+		}
+	}
+
 	if err := d.Set("target_health_state", []interface{}{flattenTargetGroupTargetHealthStateAttributes(attributes, protocol)}); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting target_health_state: %s", err)
 	}
@@ -658,6 +723,12 @@ func resourceTargetGroupUpdate(ctx context.Context, d *schema.ResourceData, meta
 			}
 		}
 
+		if d.HasChange("target_group_health") {
+			if v, ok := d.GetOk("target_group_health"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				attributes = append(attributes, expandTargetGroupHealthAttributes(v.([]interface{})[0].(map[string]interface{}), protocol)...)
+			}
+		}
+
 		if d.HasChange("target_health_state") {
 			if v, ok := d.GetOk("target_health_state"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				attributes = append(attributes, expandTargetGroupTargetHealthStateAttributes(v.([]interface{})[0].(map[string]interface{}), protocol)...)
@@ -972,6 +1043,34 @@ func validTargetGroupHealthCheckPort(v interface{}, k string) (ws []string, erro
 	return
 }
 
+func validTargetGroupHealthInput(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == targetGroupHealthOff {
+		return
+	}
+
+	if count, err := strconv.Atoi(value); err != nil || count < 1 {
+		errors = append(errors, fmt.Errorf("%q must be an integer greater than 0 or %q", k, targetGroupHealthOff))
+	}
+
+	return
+}
+
+func validTargetGroupHealthPercentageInput(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == targetGroupHealthOff {
+		return
+	}
+
+	if percentage, err := strconv.Atoi(value); err != nil || percentage < 1 || percentage > 100 {
+		errors = append(errors, fmt.Errorf("%q must be an integer between 1 and 100 or %q", k, targetGroupHealthOff))
+	}
+
+	return
+}
+
 func TargetGroupSuffixFromARN(arn *string) string {
 	if arn == nil {
 		return ""
@@ -1244,6 +1343,75 @@ func flattenTargetGroupTargetFailoverAttributes(apiObjects []*elbv2.TargetGroupA
 	return tfMap
 }
 
+func expandTargetGroupHealthAttributes(tfMap map[string]interface{}, protocol string) []*elbv2.TargetGroupAttribute {
+	// Target group health is not supported by Gateway Load Balancers.
+	if tfMap == nil || protocol == elbv2.ProtocolEnumGeneve {
+		return nil
+	}
+
+	var apiObjects []*elbv2.TargetGroupAttribute
+
+	if v, ok := tfMap["dns_failover"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObjects = append(apiObjects,
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String(targetGroupAttributeTargetGroupHealthDNSFailoverMinimumHealthyTargetsCount),
+				Value: aws.String(tfMap["minimum_healthy_targets_count"].(string)),
+			},
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String(targetGroupAttributeTargetGroupHealthDNSFailoverMinimumHealthyTargetsPercentage),
+				Value: aws.String(tfMap["minimum_healthy_targets_percentage"].(string)),
+			})
+	}
+
+	if v, ok := tfMap["unhealthy_state_routing"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObjects = append(apiObjects,
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String(targetGroupAttributeTargetGroupHealthUnhealthyStateRoutingMinimumHealthyTargetsCount),
+				Value: flex.IntValueToString(tfMap["minimum_healthy_targets_count"].(int)),
+			},
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String(targetGroupAttributeTargetGroupHealthUnhealthyStateRoutingMinimumHealthyTargetsPercentage),
+				Value: aws.String(tfMap["minimum_healthy_targets_percentage"].(string)),
+			})
+	}
+
+	return apiObjects
+}
+
+func flattenTargetGroupHealthAttributes(apiObjects []*elbv2.TargetGroupAttribute, protocol string) map[string]interface{} {
+	if len(apiObjects) == 0 || protocol == elbv2.ProtocolEnumGeneve {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+	dnsFailover := map[string]interface{}{}
+	unhealthyStateRouting := map[string]interface{}{}
+
+	for _, apiObject := range apiObjects {
+		switch k, v := aws.StringValue(apiObject.Key), apiObject.Value; k {
+		case targetGroupAttributeTargetGroupHealthDNSFailoverMinimumHealthyTargetsCount:
+			dnsFailover["minimum_healthy_targets_count"] = aws.StringValue(v)
+		case targetGroupAttributeTargetGroupHealthDNSFailoverMinimumHealthyTargetsPercentage:
+			dnsFailover["minimum_healthy_targets_percentage"] = aws.StringValue(v)
+		case targetGroupAttributeTargetGroupHealthUnhealthyStateRoutingMinimumHealthyTargetsCount:
+			unhealthyStateRouting["minimum_healthy_targets_count"] = flex.StringToIntValue(v)
+		case targetGroupAttributeTargetGroupHealthUnhealthyStateRoutingMinimumHealthyTargetsPercentage:
+			unhealthyStateRouting["minimum_healthy_targets_percentage"] = aws.StringValue(v)
+		}
+	}
+
+	if len(dnsFailover) > 0 {
+		tfMap["dns_failover"] = []interface{}{dnsFailover}
+	}
+	if len(unhealthyStateRouting) > 0 {
+		tfMap["unhealthy_state_routing"] = []interface{}{unhealthyStateRouting}
+	}
+
+	return tfMap
+}
+
 func expandTargetGroupTargetHealthStateAttributes(tfMap map[string]interface{}, protocol string) []*elbv2.TargetGroupAttribute {
 	if tfMap == nil {
 		return nil
@@ -2595,6 +2595,53 @@ func TestAccELBV2TargetGroup_targetHealthStateUnhealthyConnectionTermination(t *
 	})
 }
 
+func TestAccELBV2TargetGroup_targetGroupHealth(t *testing.T) {
+	ctx := acctest.Context(t)
+	var targetGroup elbv2.TargetGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetGroupConfig_targetGroupHealth(rName, "off", "off", 1, "off"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(ctx, resourceName, &targetGroup),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.0.minimum_healthy_targets_count", "off"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.0.minimum_healthy_targets_percentage", "off"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.0.minimum_healthy_targets_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.0.minimum_healthy_targets_percentage", "off"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTargetGroupConfig_targetGroupHealth(rName, "2", "50", 2, "30"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(ctx, resourceName, &targetGroup),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.0.minimum_healthy_targets_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.0.minimum_healthy_targets_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.0.minimum_healthy_targets_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.0.minimum_healthy_targets_percentage", "30"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBV2TargetGroup_Instance_HealthCheck_defaults(t *testing.T) {
 	t.Parallel()
 
@@ -3661,6 +3708,7 @@ func TestAccELBV2TargetGroup_Lambda_defaults(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "vpc_id"),
 					resource.TestCheckResourceAttr(resourceName, "health_check.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "health_check.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.#", "0"),
 				),
 			},
 		},
@@ -5017,6 +5065,37 @@ resource "aws_vpc" "test" {
 `, rName, protocol, enabled)
 }
 
+func testAccTargetGroupConfig_targetGroupHealth(rName, dnsFailoverCount, dnsFailoverPercentage string, unhealthyStateRoutingCount int, unhealthyStateRoutingPercentage string) string {
+	return fmt.Sprintf(`
+resource "aws_lb_target_group" "test" {
+  name     = %[1]q
+  port     = 443
+  protocol = "HTTPS"
+  vpc_id   = aws_vpc.test.id
+
+  target_group_health {
+    dns_failover {
+      minimum_healthy_targets_count      = %[2]q
+      minimum_healthy_targets_percentage = %[3]q
+    }
+
+    unhealthy_state_routing {
+      minimum_healthy_targets_count      = %[4]d
+      minimum_healthy_targets_percentage = %[5]q
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, dnsFailoverCount, dnsFailoverPercentage, unhealthyStateRoutingCount, unhealthyStateRoutingPercentage)
+}
+
 func testAccTargetGroupConfig_typeTCP(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_lb_target_group" "test" {
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -287,10 +288,43 @@ func ResourceONTAPVolume() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceONTAPVolumeSnaplockCustomizeDiff,
+		),
 	}
 }
 
+// resourceONTAPVolumeSnaplockCustomizeDiff rejects SnapLock settings that the FSx API would only reject on apply, or on delete.
+// See https://docs.aws.amazon.com/fsx/latest/ONTAPGuide/snaplock-modes.html.
+func resourceONTAPVolumeSnaplockCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.Get("snaplock_configuration").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		snaplockType := tfMap["snaplock_type"].(string)
+
+		// Compliance volumes can't be deleted while they contain WORM files with unexpired retention periods.
+		// Only Enterprise volumes support privileged delete or bypassing retention on delete.
+		if snaplockType == fsx.SnaplockTypeCompliance {
+			if v := tfMap["privileged_delete"].(string); v != fsx.PrivilegedDeleteDisabled {
+				return fmt.Errorf("snaplock_configuration.0.privileged_delete must be %s for %s SnapLock volumes, got: %s", fsx.PrivilegedDeleteDisabled, snaplockType, v)
+			}
+
+			if d.Get("bypass_snaplock_enterprise_retention").(bool) {
+				return fmt.Errorf("bypass_snaplock_enterprise_retention cannot be enabled for %s SnapLock volumes", snaplockType)
+			}
+		}
+	}
+
+	// Privileged delete can't be re-enabled once it has been permanently disabled.
+	if d.Id() != "" && d.HasChange("snaplock_configuration.0.privileged_delete") {
+		if o, n := d.GetChange("snaplock_configuration.0.privileged_delete"); o.(string) == fsx.PrivilegedDeletePermanentlyDisabled {
+			return fmt.Errorf("snaplock_configuration.0.privileged_delete cannot be changed from %s to %s", o, n)
+		}
+	}
+
+	return nil
+}
+
 func resourceONTAPVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).FSxConn(ctx)
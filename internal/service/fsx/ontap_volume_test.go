@@ -402,6 +402,30 @@ func TestAccFSxONTAPVolume_snaplock(t *testing.T) {
 	})
 }
 
+func TestAccFSxONTAPVolume_snaplockCompliance(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("tf_acc_test_%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, fsx.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckONTAPVolumeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccONTAPVolumeConfig_snaplockCompliance(rName, "ENABLED", false),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`privileged_delete must be DISABLED for COMPLIANCE SnapLock volumes`),
+			},
+			{
+				Config:      testAccONTAPVolumeConfig_snaplockCompliance(rName, "DISABLED", true),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`bypass_snaplock_enterprise_retention cannot be enabled for COMPLIANCE SnapLock volumes`),
+			},
+		},
+	})
+}
+
 func TestAccFSxONTAPVolume_snapshotPolicy(t *testing.T) {
 	ctx := acctest.Context(t)
 	var volume1, volume2 fsx.Volume
@@ -769,6 +793,25 @@ resource "aws_fsx_ontap_volume" "test" {
 `, rName))
 }
 
+func testAccONTAPVolumeConfig_snaplockCompliance(rName, privilegedDelete string, bypassRetention bool) string {
+	return acctest.ConfigCompose(testAccONTAPVolumeConfig_base(rName), fmt.Sprintf(`
+resource "aws_fsx_ontap_volume" "test" {
+  name                       = %[1]q
+  junction_path              = "/%[1]s"
+  size_in_megabytes          = 1024
+  storage_efficiency_enabled = true
+  storage_virtual_machine_id = aws_fsx_ontap_storage_virtual_machine.test.id
+
+  snaplock_configuration {
+    privileged_delete = %[2]q
+    snaplock_type     = "COMPLIANCE"
+  }
+
+  bypass_snaplock_enterprise_retention = %[3]t
+}
+`, rName, privilegedDelete, bypassRetention))
+}
+
 /*
 func testAccONTAPVolumeConfig_snaplockUpdate(rName string) string {
 	return acctest.ConfigCompose(testAccONTAPVolumeConfig_base(rName), fmt.Sprintf(`
@@ -5,6 +5,7 @@ package fsx
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -36,6 +37,7 @@ func ResourceOpenZFSVolume() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("copy_snapshot_and_update", nil)
 				d.Set("delete_volume_options", nil)
 
 				return []*schema.ResourceData{d}, nil
@@ -53,6 +55,36 @@ func ResourceOpenZFSVolume() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"copy_snapshot_and_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"copy_strategy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{fsx.OpenZFSCopyStrategyFullCopy, fsx.OpenZFSCopyStrategyIncrementalCopy}, false),
+						},
+						"options": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(fsx.UpdateOpenZFSVolumeOption_Values(), false),
+							},
+						},
+						"snapshot_arn": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(8, 512),
+								validation.StringMatch(regexache.MustCompile(`^arn:.*`), "must specify the full ARN of the snapshot"),
+							),
+						},
+					},
+				},
+			},
 			"copy_tags_to_snapshots": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -273,6 +305,12 @@ func resourceOpenZFSVolumeCreate(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "waiting for FSx for OpenZFS Volume (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("copy_snapshot_and_update"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := copySnapshotAndUpdateOpenZFSVolume(ctx, conn, d.Id(), v.([]interface{})[0].(map[string]interface{}), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceOpenZFSVolumeRead(ctx, d, meta)...)
 }
 
@@ -321,7 +359,7 @@ func resourceOpenZFSVolumeUpdate(ctx context.Context, d *schema.ResourceData, me
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).FSxConn(ctx)
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("tags", "tags_all", "copy_snapshot_and_update", "delete_volume_options") {
 		openzfsConfig := &fsx.UpdateOpenZFSVolumeConfiguration{}
 
 		if d.HasChange("data_compression_type") {
@@ -378,6 +416,15 @@ func resourceOpenZFSVolumeUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	// Only a new source snapshot (or copy settings) triggers a copy; removing the block is a no-op.
+	if d.HasChange("copy_snapshot_and_update") {
+		if v, ok := d.GetOk("copy_snapshot_and_update"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := copySnapshotAndUpdateOpenZFSVolume(ctx, conn, d.Id(), v.([]interface{})[0].(map[string]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	return append(diags, resourceOpenZFSVolumeRead(ctx, d, meta)...)
 }
 
@@ -413,6 +460,36 @@ func resourceOpenZFSVolumeDelete(ctx context.Context, d *schema.ResourceData, me
 	return diags
 }
 
+func copySnapshotAndUpdateOpenZFSVolume(ctx context.Context, conn *fsx.FSx, volumeID string, tfMap map[string]interface{}, timeout time.Duration) error {
+	input := &fsx.CopySnapshotAndUpdateVolumeInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		CopyStrategy:       aws.String(tfMap["copy_strategy"].(string)),
+		SourceSnapshotARN:  aws.String(tfMap["snapshot_arn"].(string)),
+		VolumeId:           aws.String(volumeID),
+	}
+
+	if v, ok := tfMap["options"].(*schema.Set); ok && v.Len() > 0 {
+		input.Options = flex.ExpandStringSet(v)
+	}
+
+	startTime := time.Now()
+	_, err := conn.CopySnapshotAndUpdateVolumeWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("copying snapshot (%s) to FSx for OpenZFS Volume (%s): %w", aws.StringValue(input.SourceSnapshotARN), volumeID, err)
+	}
+
+	if _, err := waitVolumeUpdated(ctx, conn, volumeID, startTime, timeout); err != nil {
+		return fmt.Errorf("waiting for FSx for OpenZFS Volume (%s) update: %w", volumeID, err)
+	}
+
+	if _, err := waitVolumeAdministrativeActionCompleted(ctx, conn, volumeID, fsx.AdministrativeActionTypeVolumeUpdateWithSnapshot, timeout); err != nil {
+		return fmt.Errorf("waiting for FSx for OpenZFS Volume (%s) administrative action (%s) complete: %w", volumeID, fsx.AdministrativeActionTypeVolumeUpdateWithSnapshot, err)
+	}
+
+	return nil
+}
+
 func expandOpenZFSUserOrGroupQuotas(cfg []interface{}) []*fsx.OpenZFSUserOrGroupQuota {
 	quotas := []*fsx.OpenZFSUserOrGroupQuota{}
 
@@ -510,6 +510,48 @@ func TestAccFSxOpenZFSVolume_userAndGroupQuotas(t *testing.T) {
 	})
 }
 
+func TestAccFSxOpenZFSVolume_copySnapshotAndUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var volume1, volume2 fsx.Volume
+	resourceName := "aws_fsx_openzfs_volume.test"
+	snapshotResourceName := "aws_fsx_openzfs_snapshot.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, fsx.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpenZFSVolumeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenZFSVolumeConfig_copySnapshotAndUpdateNone(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenZFSVolumeExists(ctx, resourceName, &volume1),
+					resource.TestCheckResourceAttr(resourceName, "copy_snapshot_and_update.#", "0"),
+				),
+			},
+			{
+				Config: testAccOpenZFSVolumeConfig_copySnapshotAndUpdate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenZFSVolumeExists(ctx, resourceName, &volume2),
+					testAccCheckOpenZFSVolumeNotRecreated(&volume1, &volume2),
+					resource.TestCheckResourceAttr(resourceName, "copy_snapshot_and_update.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "copy_snapshot_and_update.0.copy_strategy", "FULL_COPY"),
+					resource.TestCheckResourceAttr(resourceName, "copy_snapshot_and_update.0.options.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "copy_snapshot_and_update.0.options.*", "DELETE_INTERMEDIATE_SNAPSHOTS"),
+					resource.TestCheckResourceAttrPair(resourceName, "copy_snapshot_and_update.0.snapshot_arn", snapshotResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"copy_snapshot_and_update"},
+			},
+		},
+	})
+}
+
 func testAccCheckOpenZFSVolumeExists(ctx context.Context, n string, v *fsx.Volume) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -777,3 +819,53 @@ resource "aws_fsx_openzfs_volume" "test" {
 }
 `, rName, userQuota, groupQuota))
 }
+
+func testAccOpenZFSVolumeConfig_copySnapshotAndUpdateBase(rName string) string {
+	return acctest.ConfigCompose(testAccOpenZFSVolumeConfig_base(rName), fmt.Sprintf(`
+resource "aws_fsx_openzfs_file_system" "source" {
+  storage_capacity    = 64
+  subnet_ids          = aws_subnet.test[*].id
+  deployment_type     = "SINGLE_AZ_1"
+  throughput_capacity = 64
+  skip_final_backup   = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_fsx_openzfs_volume" "source" {
+  name             = "%[1]s-source"
+  parent_volume_id = aws_fsx_openzfs_file_system.source.root_volume_id
+}
+
+resource "aws_fsx_openzfs_snapshot" "test" {
+  name      = %[1]q
+  volume_id = aws_fsx_openzfs_volume.source.id
+}
+`, rName))
+}
+
+func testAccOpenZFSVolumeConfig_copySnapshotAndUpdateNone(rName string) string {
+	return acctest.ConfigCompose(testAccOpenZFSVolumeConfig_copySnapshotAndUpdateBase(rName), fmt.Sprintf(`
+resource "aws_fsx_openzfs_volume" "test" {
+  name             = %[1]q
+  parent_volume_id = aws_fsx_openzfs_file_system.test.root_volume_id
+}
+`, rName))
+}
+
+func testAccOpenZFSVolumeConfig_copySnapshotAndUpdate(rName string) string {
+	return acctest.ConfigCompose(testAccOpenZFSVolumeConfig_copySnapshotAndUpdateBase(rName), fmt.Sprintf(`
+resource "aws_fsx_openzfs_volume" "test" {
+  name             = %[1]q
+  parent_volume_id = aws_fsx_openzfs_file_system.test.root_volume_id
+
+  copy_snapshot_and_update {
+    copy_strategy = "FULL_COPY"
+    options       = ["DELETE_INTERMEDIATE_SNAPSHOTS"]
+    snapshot_arn  = aws_fsx_openzfs_snapshot.test.arn
+  }
+}
+`, rName))
+}
@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package globalaccelerator
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_globalaccelerator_cross_account_attachment", name="Cross-account Attachment")
+// @Tags(identifierAttribute="id")
+func ResourceCrossAccountAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCrossAccountAttachmentCreate,
+		ReadWithoutTimeout:   resourceCrossAccountAttachmentRead,
+		UpdateWithoutTimeout: resourceCrossAccountAttachmentUpdate,
+		DeleteWithoutTimeout: resourceCrossAccountAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"principals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
+			"resource": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr_block": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+						"endpoint_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCrossAccountAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &globalaccelerator.CreateCrossAccountAttachmentInput{
+		IdempotencyToken: aws.String(id.UniqueId()),
+		Name:             aws.String(name),
+		Tags:             getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("principals"); ok && v.(*schema.Set).Len() > 0 {
+		input.Principals = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource"); ok && v.(*schema.Set).Len() > 0 {
+		input.Resources = expandResources(v.(*schema.Set).List())
+	}
+
+	output, err := conn.CreateCrossAccountAttachmentWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Global Accelerator Cross-account Attachment (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CrossAccountAttachment.AttachmentArn))
+
+	return append(diags, resourceCrossAccountAttachmentRead(ctx, d, meta)...)
+}
+
+func resourceCrossAccountAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn(ctx)
+
+	attachment, err := FindCrossAccountAttachmentByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Global Accelerator Cross-account Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Global Accelerator Cross-account Attachment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", attachment.AttachmentArn)
+	if v := attachment.CreatedTime; v != nil {
+		d.Set("created_time", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	if v := attachment.LastModifiedTime; v != nil {
+		d.Set("last_modified_time", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_time", nil)
+	}
+	d.Set("name", attachment.Name)
+	d.Set("principals", aws.StringValueSlice(attachment.Principals))
+	if err := d.Set("resource", flattenResources(attachment.Resources)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource: %s", err)
+	}
+
+	return diags
+}
+
+func resourceCrossAccountAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn(ctx)
+
+	if d.HasChanges("name", "principals", "resource") {
+		input := &globalaccelerator.UpdateCrossAccountAttachmentInput{
+			AttachmentArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("principals") {
+			o, n := d.GetChange("principals")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				input.AddPrincipals = flex.ExpandStringSet(add)
+			}
+
+			if del := os.Difference(ns); del.Len() > 0 {
+				input.RemovePrincipals = flex.ExpandStringSet(del)
+			}
+		}
+
+		if d.HasChange("resource") {
+			o, n := d.GetChange("resource")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				input.AddResources = expandResources(add.List())
+			}
+
+			if del := os.Difference(ns); del.Len() > 0 {
+				input.RemoveResources = expandResources(del.List())
+			}
+		}
+
+		_, err := conn.UpdateCrossAccountAttachmentWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Global Accelerator Cross-account Attachment (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCrossAccountAttachmentRead(ctx, d, meta)...)
+}
+
+func resourceCrossAccountAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Global Accelerator Cross-account Attachment: %s", d.Id())
+	_, err := conn.DeleteCrossAccountAttachmentWithContext(ctx, &globalaccelerator.DeleteCrossAccountAttachmentInput{
+		AttachmentArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, globalaccelerator.ErrCodeAttachmentNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Global Accelerator Cross-account Attachment (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindCrossAccountAttachmentByARN(ctx context.Context, conn *globalaccelerator.GlobalAccelerator, arn string) (*globalaccelerator.Attachment, error) {
+	input := &globalaccelerator.DescribeCrossAccountAttachmentInput{
+		AttachmentArn: aws.String(arn),
+	}
+
+	return findCrossAccountAttachment(ctx, conn, input)
+}
+
+func findCrossAccountAttachment(ctx context.Context, conn *globalaccelerator.GlobalAccelerator, input *globalaccelerator.DescribeCrossAccountAttachmentInput) (*globalaccelerator.Attachment, error) {
+	output, err := conn.DescribeCrossAccountAttachmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, globalaccelerator.ErrCodeAttachmentNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CrossAccountAttachment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CrossAccountAttachment, nil
+}
+
+func expandResource(tfMap map[string]interface{}) *globalaccelerator.Resource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &globalaccelerator.Resource{}
+
+	if v, ok := tfMap["cidr_block"].(string); ok && v != "" {
+		apiObject.Cidr = aws.String(v)
+	}
+
+	if v, ok := tfMap["endpoint_id"].(string); ok && v != "" {
+		apiObject.EndpointId = aws.String(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandResources(tfList []interface{}) []*globalaccelerator.Resource {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*globalaccelerator.Resource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandResource(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenResource(apiObject *globalaccelerator.Resource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Cidr; v != nil {
+		tfMap["cidr_block"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EndpointId; v != nil {
+		tfMap["endpoint_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["region"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenResources(apiObjects []*globalaccelerator.Resource) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenResource(apiObject))
+	}
+
+	return tfList
+}
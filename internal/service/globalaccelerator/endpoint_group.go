@@ -50,6 +50,11 @@ func ResourceEndpointGroup() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"attachment_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
 						"client_ip_preservation_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -232,7 +237,16 @@ func resourceEndpointGroupRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.Set("arn", endpointGroup.EndpointGroupArn)
-	if err := d.Set("endpoint_configuration", flattenEndpointDescriptions(endpointGroup.EndpointDescriptions)); err != nil {
+	// Cross-account attachment ARNs are not returned by the API.
+	attachmentARNs := make(map[string]string)
+	for _, tfMapRaw := range d.Get("endpoint_configuration").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			if v, ok := tfMap["attachment_arn"].(string); ok && v != "" {
+				attachmentARNs[tfMap["endpoint_id"].(string)] = v
+			}
+		}
+	}
+	if err := d.Set("endpoint_configuration", flattenEndpointDescriptions(endpointGroup.EndpointDescriptions, attachmentARNs)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting endpoint_configuration: %s", err)
 	}
 	d.Set("endpoint_group_region", endpointGroup.EndpointGroupRegion)
@@ -381,6 +395,10 @@ func expandEndpointConfiguration(tfMap map[string]interface{}) *globalaccelerato
 
 	apiObject := &globalaccelerator.EndpointConfiguration{}
 
+	if v, ok := tfMap["attachment_arn"].(string); ok && v != "" {
+		apiObject.AttachmentArn = aws.String(v)
+	}
+
 	if v, ok := tfMap["client_ip_preservation_enabled"].(bool); ok {
 		apiObject.ClientIPPreservationEnabled = aws.Bool(v)
 	}
@@ -466,13 +484,17 @@ func expandPortOverrides(tfList []interface{}) []*globalaccelerator.PortOverride
 	return apiObjects
 }
 
-func flattenEndpointDescription(apiObject *globalaccelerator.EndpointDescription) map[string]interface{} {
+func flattenEndpointDescription(apiObject *globalaccelerator.EndpointDescription, attachmentARNs map[string]string) map[string]interface{} {
 	if apiObject == nil {
 		return nil
 	}
 
 	tfMap := map[string]interface{}{}
 
+	if v, ok := attachmentARNs[aws.StringValue(apiObject.EndpointId)]; ok {
+		tfMap["attachment_arn"] = v
+	}
+
 	if v := apiObject.ClientIPPreservationEnabled; v != nil {
 		tfMap["client_ip_preservation_enabled"] = aws.BoolValue(v)
 	}
@@ -488,7 +510,7 @@ func flattenEndpointDescription(apiObject *globalaccelerator.EndpointDescription
 	return tfMap
 }
 
-func flattenEndpointDescriptions(apiObjects []*globalaccelerator.EndpointDescription) []interface{} {
+func flattenEndpointDescriptions(apiObjects []*globalaccelerator.EndpointDescription, attachmentARNs map[string]string) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
 	}
@@ -500,7 +522,7 @@ func flattenEndpointDescriptions(apiObjects []*globalaccelerator.EndpointDescrip
 			continue
 		}
 
-		tfList = append(tfList, flattenEndpointDescription(apiObject))
+		tfList = append(tfList, flattenEndpointDescription(apiObject, attachmentARNs))
 	}
 
 	return tfList
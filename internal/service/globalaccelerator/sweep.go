@@ -37,6 +37,14 @@ func RegisterSweepers() {
 		F:    sweepEndpointGroups,
 	})
 
+	resource.AddTestSweepers("aws_globalaccelerator_cross_account_attachment", &resource.Sweeper{
+		Name: "aws_globalaccelerator_cross_account_attachment",
+		F:    sweepCrossAccountAttachments,
+		Dependencies: []string{
+			"aws_globalaccelerator_endpoint_group",
+		},
+	})
+
 	resource.AddTestSweepers("aws_globalaccelerator_custom_routing_accelerator", &resource.Sweeper{
 		Name: "aws_globalaccelerator_custom_routing_accelerator",
 		F:    sweepCustomRoutingAccelerators,
@@ -103,6 +111,50 @@ func sweepAccelerators(region string) error {
 	return nil
 }
 
+func sweepCrossAccountAttachments(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.GlobalAcceleratorConn(ctx)
+	input := &globalaccelerator.ListCrossAccountAttachmentsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListCrossAccountAttachmentsPagesWithContext(ctx, input, func(page *globalaccelerator.ListCrossAccountAttachmentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CrossAccountAttachments {
+			r := ResourceCrossAccountAttachment()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.AttachmentArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Global Accelerator Cross-account Attachment sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Global Accelerator Cross-account Attachments (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Global Accelerator Cross-account Attachments (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepEndpointGroups(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
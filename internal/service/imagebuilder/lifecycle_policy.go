@@ -0,0 +1,831 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package imagebuilder
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_imagebuilder_lifecycle_policy", name="Lifecycle Policy")
+// @Tags(identifierAttribute="id")
+func ResourceLifecyclePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLifecyclePolicyCreate,
+		ReadWithoutTimeout:   resourceLifecyclePolicyRead,
+		UpdateWithoutTimeout: resourceLifecyclePolicyUpdate,
+		DeleteWithoutTimeout: resourceLifecyclePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"execution_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"policy_detail": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"include_resources": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"amis": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"containers": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"snapshots": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyDetailActionType_Values(), false),
+									},
+								},
+							},
+						},
+						"exclusion_rules": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"amis": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"is_public": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"last_launched": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"unit": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyTimeUnit_Values(), false),
+															},
+															"value": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntBetween(1, 365),
+															},
+														},
+													},
+												},
+												"regions": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: verify.ValidRegionName,
+													},
+												},
+												"shared_accounts": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: verify.ValidAccountID,
+													},
+												},
+												"tag_map": tftags.TagsSchema(),
+											},
+										},
+									},
+									"tag_map": tftags.TagsSchema(),
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"retain_at_least": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyDetailFilterType_Values(), false),
+									},
+									"unit": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyTimeUnit_Values(), false),
+									},
+									"value": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"resource_selection": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recipe": {
+							Type:         schema.TypeSet,
+							Optional:     true,
+							MaxItems:     50,
+							AtLeastOneOf: []string{"resource_selection.0.recipe", "resource_selection.0.tag_map"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"semantic_version": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"tag_map": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							AtLeastOneOf: []string{"resource_selection.0.recipe", "resource_selection.0.tag_map"},
+							Elem:         &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyResourceType_Values(), false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyStatus_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	input := &imagebuilder.CreateLifecyclePolicyInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		ExecutionRole: aws.String(d.Get("execution_role").(string)),
+		Name:          aws.String(d.Get("name").(string)),
+		ResourceType:  aws.String(d.Get("resource_type").(string)),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("policy_detail"); ok && len(v.([]interface{})) > 0 {
+		input.PolicyDetails = expandLifecyclePolicyDetails(ctx, v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("resource_selection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ResourceSelection = expandLifecyclePolicyResourceSelection(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Status = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateLifecyclePolicyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Image Builder Lifecycle Policy: %s", err)
+	}
+
+	if output == nil {
+		return sdkdiag.AppendErrorf(diags, "creating Image Builder Lifecycle Policy: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.LifecyclePolicyArn))
+
+	return append(diags, resourceLifecyclePolicyRead(ctx, d, meta)...)
+}
+
+func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	input := &imagebuilder.GetLifecyclePolicyInput{
+		LifecyclePolicyArn: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetLifecyclePolicyWithContext(ctx, input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Image Builder Lifecycle Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Image Builder Lifecycle Policy (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.LifecyclePolicy == nil {
+		return sdkdiag.AppendErrorf(diags, "getting Image Builder Lifecycle Policy (%s): empty response", d.Id())
+	}
+
+	lifecyclePolicy := output.LifecyclePolicy
+
+	d.Set("arn", lifecyclePolicy.Arn)
+	d.Set("description", lifecyclePolicy.Description)
+	d.Set("execution_role", lifecyclePolicy.ExecutionRole)
+	d.Set("name", lifecyclePolicy.Name)
+	if err := d.Set("policy_detail", flattenLifecyclePolicyDetails(ctx, lifecyclePolicy.PolicyDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting policy_detail: %s", err)
+	}
+	if lifecyclePolicy.ResourceSelection != nil {
+		if err := d.Set("resource_selection", []interface{}{flattenLifecyclePolicyResourceSelection(ctx, lifecyclePolicy.ResourceSelection)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting resource_selection: %s", err)
+		}
+	} else {
+		d.Set("resource_selection", nil)
+	}
+	d.Set("resource_type", lifecyclePolicy.ResourceType)
+	d.Set("status", lifecyclePolicy.Status)
+
+	setTagsOut(ctx, lifecyclePolicy.Tags)
+
+	return diags
+}
+
+func resourceLifecyclePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &imagebuilder.UpdateLifecyclePolicyInput{
+			ClientToken:        aws.String(id.UniqueId()),
+			ExecutionRole:      aws.String(d.Get("execution_role").(string)),
+			LifecyclePolicyArn: aws.String(d.Id()),
+			ResourceType:       aws.String(d.Get("resource_type").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("policy_detail"); ok && len(v.([]interface{})) > 0 {
+			input.PolicyDetails = expandLifecyclePolicyDetails(ctx, v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("resource_selection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ResourceSelection = expandLifecyclePolicyResourceSelection(ctx, v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("status"); ok {
+			input.Status = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateLifecyclePolicyWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Image Builder Lifecycle Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceLifecyclePolicyRead(ctx, d, meta)...)
+}
+
+func resourceLifecyclePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	input := &imagebuilder.DeleteLifecyclePolicyInput{
+		LifecyclePolicyArn: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteLifecyclePolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Image Builder Lifecycle Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandLifecyclePolicyDetails(ctx context.Context, tfList []interface{}) []*imagebuilder.LifecyclePolicyDetail {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*imagebuilder.LifecyclePolicyDetail
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandLifecyclePolicyDetail(ctx, tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLifecyclePolicyDetail(ctx context.Context, tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetail {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyDetail{}
+
+	if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Action = expandLifecyclePolicyDetailAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["exclusion_rules"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ExclusionRules = expandLifecyclePolicyDetailExclusionRules(ctx, v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Filter = expandLifecyclePolicyDetailFilter(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyDetailAction(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyDetailAction{}
+
+	if v, ok := tfMap["include_resources"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.IncludeResources = expandLifecyclePolicyDetailActionIncludeResources(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyDetailActionIncludeResources(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailActionIncludeResources {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyDetailActionIncludeResources{}
+
+	if v, ok := tfMap["amis"].(bool); ok {
+		apiObject.Amis = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["containers"].(bool); ok {
+		apiObject.Containers = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["snapshots"].(bool); ok {
+		apiObject.Snapshots = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyDetailExclusionRules(ctx context.Context, tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailExclusionRules {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyDetailExclusionRules{}
+
+	if v, ok := tfMap["amis"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Amis = expandLifecyclePolicyDetailExclusionRulesAmis(ctx, v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["tag_map"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.TagMap = Tags(tftags.New(ctx, v))
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyDetailExclusionRulesAmis(ctx context.Context, tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailExclusionRulesAmis {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyDetailExclusionRulesAmis{}
+
+	if v, ok := tfMap["is_public"].(bool); ok {
+		apiObject.IsPublic = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["last_launched"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LastLaunched = expandLifecyclePolicyDetailExclusionRulesAmisLastLaunched(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Regions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["shared_accounts"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SharedAccounts = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["tag_map"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.TagMap = Tags(tftags.New(ctx, v))
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyDetailExclusionRulesAmisLastLaunched(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailExclusionRulesAmisLastLaunched {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyDetailExclusionRulesAmisLastLaunched{}
+
+	if v, ok := tfMap["unit"].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(int); ok && v != 0 {
+		apiObject.Value = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyDetailFilter(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyDetailFilter{}
+
+	if v, ok := tfMap["retain_at_least"].(int); ok && v != 0 {
+		apiObject.RetainAtLeast = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["unit"].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(int); ok && v != 0 {
+		apiObject.Value = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyResourceSelection(ctx context.Context, tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyResourceSelection {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyResourceSelection{}
+
+	if v, ok := tfMap["recipe"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Recipes = expandLifecyclePolicyResourceSelectionRecipes(v.List())
+	}
+
+	if v, ok := tfMap["tag_map"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.TagMap = Tags(tftags.New(ctx, v))
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyResourceSelectionRecipes(tfList []interface{}) []*imagebuilder.LifecyclePolicyResourceSelectionRecipe {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*imagebuilder.LifecyclePolicyResourceSelectionRecipe
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &imagebuilder.LifecyclePolicyResourceSelectionRecipe{}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["semantic_version"].(string); ok && v != "" {
+			apiObject.SemanticVersion = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLifecyclePolicyDetails(ctx context.Context, apiObjects []*imagebuilder.LifecyclePolicyDetail) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenLifecyclePolicyDetail(ctx, apiObject))
+	}
+
+	return tfList
+}
+
+func flattenLifecyclePolicyDetail(ctx context.Context, apiObject *imagebuilder.LifecyclePolicyDetail) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Action; v != nil {
+		tfMap["action"] = []interface{}{flattenLifecyclePolicyDetailAction(v)}
+	}
+
+	if v := apiObject.ExclusionRules; v != nil {
+		tfMap["exclusion_rules"] = []interface{}{flattenLifecyclePolicyDetailExclusionRules(ctx, v)}
+	}
+
+	if v := apiObject.Filter; v != nil {
+		tfMap["filter"] = []interface{}{flattenLifecyclePolicyDetailFilter(v)}
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyDetailAction(apiObject *imagebuilder.LifecyclePolicyDetailAction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IncludeResources; v != nil {
+		tfMap["include_resources"] = []interface{}{flattenLifecyclePolicyDetailActionIncludeResources(v)}
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyDetailActionIncludeResources(apiObject *imagebuilder.LifecyclePolicyDetailActionIncludeResources) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Amis; v != nil {
+		tfMap["amis"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Containers; v != nil {
+		tfMap["containers"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Snapshots; v != nil {
+		tfMap["snapshots"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyDetailExclusionRules(ctx context.Context, apiObject *imagebuilder.LifecyclePolicyDetailExclusionRules) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Amis; v != nil {
+		tfMap["amis"] = []interface{}{flattenLifecyclePolicyDetailExclusionRulesAmis(ctx, v)}
+	}
+
+	if v := apiObject.TagMap; v != nil {
+		tfMap["tag_map"] = KeyValueTags(ctx, v).Map()
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyDetailExclusionRulesAmis(ctx context.Context, apiObject *imagebuilder.LifecyclePolicyDetailExclusionRulesAmis) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IsPublic; v != nil {
+		tfMap["is_public"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.LastLaunched; v != nil {
+		tfMap["last_launched"] = []interface{}{flattenLifecyclePolicyDetailExclusionRulesAmisLastLaunched(v)}
+	}
+
+	if v := apiObject.Regions; v != nil {
+		tfMap["regions"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.SharedAccounts; v != nil {
+		tfMap["shared_accounts"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.TagMap; v != nil {
+		tfMap["tag_map"] = KeyValueTags(ctx, v).Map()
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyDetailExclusionRulesAmisLastLaunched(apiObject *imagebuilder.LifecyclePolicyDetailExclusionRulesAmisLastLaunched) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Unit; v != nil {
+		tfMap["unit"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Value; v != nil {
+		tfMap["value"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyDetailFilter(apiObject *imagebuilder.LifecyclePolicyDetailFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.RetainAtLeast; v != nil {
+		tfMap["retain_at_least"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Unit; v != nil {
+		tfMap["unit"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Value; v != nil {
+		tfMap["value"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyResourceSelection(ctx context.Context, apiObject *imagebuilder.LifecyclePolicyResourceSelection) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Recipes; v != nil {
+		tfMap["recipe"] = flattenLifecyclePolicyResourceSelectionRecipes(v)
+	}
+
+	if v := apiObject.TagMap; v != nil {
+		tfMap["tag_map"] = KeyValueTags(ctx, v).Map()
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyResourceSelectionRecipes(apiObjects []*imagebuilder.LifecyclePolicyResourceSelectionRecipe) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":             aws.StringValue(apiObject.Name),
+			"semantic_version": aws.StringValue(apiObject.SemanticVersion),
+		})
+	}
+
+	return tfList
+}
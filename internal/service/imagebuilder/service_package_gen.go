@@ -141,6 +141,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceLifecyclePolicy,
+			TypeName: "aws_imagebuilder_lifecycle_policy",
+			Name:     "Lifecycle Policy",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceWorkflow,
 			TypeName: "aws_imagebuilder_workflow",
@@ -50,6 +50,11 @@ func RegisterSweepers() {
 		Name: "aws_imagebuilder_infrastructure_configuration",
 		F:    sweepInfrastructureConfigurations,
 	})
+
+	resource.AddTestSweepers("aws_imagebuilder_lifecycle_policy", &resource.Sweeper{
+		Name: "aws_imagebuilder_lifecycle_policy",
+		F:    sweepLifecyclePolicies,
+	})
 }
 
 func sweepComponents(region string) error {
@@ -459,3 +464,53 @@ func sweepInfrastructureConfigurations(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepLifecyclePolicies(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.ImageBuilderConn(ctx)
+
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
+	input := &imagebuilder.ListLifecyclePoliciesInput{}
+
+	err = conn.ListLifecyclePoliciesPagesWithContext(ctx, input, func(page *imagebuilder.ListLifecyclePoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, lifecyclePolicySummary := range page.LifecyclePolicySummaryList {
+			if lifecyclePolicySummary == nil {
+				continue
+			}
+
+			arn := aws.StringValue(lifecyclePolicySummary.Arn)
+
+			r := ResourceLifecyclePolicy()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Image Builder Lifecycle Policy sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Image Builder Lifecycle Policies: %w", err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Image Builder Lifecycle Policies: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
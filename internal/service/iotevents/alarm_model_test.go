@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTEventsAlarmModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DescribeAlarmModelOutput
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_event_actions.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "alarm_model_version"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.comparison_operator", "GREATER"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.threshold", "80"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotevents", fmt.Sprintf("alarmModel/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsAlarmModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DescribeAlarmModelOutput
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotevents.ResourceAlarmModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsAlarmModel_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DescribeAlarmModelOutput
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alarm_event_actions.#", "0"),
+				),
+			},
+			{
+				Config: testAccAlarmModelConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.0.acknowledge_flow.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_event_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_event_actions.0.alarm_action.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "alarm_event_actions.0.alarm_action.0.sns.0.target_arn", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.threshold", "90"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlarmModelExists(ctx context.Context, n string, v *iotevents.DescribeAlarmModelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		output, err := tfiotevents.FindAlarmModelByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAlarmModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotevents_alarm_model" {
+				continue
+			}
+
+			_, err := tfiotevents.FindAlarmModelByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Events Alarm Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAlarmModelConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "iotevents.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "sns:Publish"
+      Effect   = "Allow"
+      Resource = "arn:${data.aws_partition.current.partition}:sns:*:*:*"
+    }]
+  })
+}
+
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAlarmModelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAlarmModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_alarm_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  alarm_rule {
+    simple_rule {
+      comparison_operator = "GREATER"
+      input_property      = "$input.${aws_iotevents_input.test.name}.temperature"
+      threshold           = "80"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAlarmModelConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccAlarmModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotevents_alarm_model" "test" {
+  name        = %[1]q
+  description = "updated"
+  role_arn    = aws_iam_role.test.arn
+  severity    = 2
+
+  alarm_capabilities {
+    acknowledge_flow {
+      enabled = false
+    }
+  }
+
+  alarm_event_actions {
+    alarm_action {
+      sns {
+        target_arn = aws_sns_topic.test.arn
+      }
+    }
+  }
+
+  alarm_rule {
+    simple_rule {
+      comparison_operator = "GREATER"
+      input_property      = "$input.${aws_iotevents_input.test.name}.temperature"
+      threshold           = "90"
+    }
+  }
+}
+`, rName))
+}
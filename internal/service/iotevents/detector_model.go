@@ -0,0 +1,736 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotevents_detector_model", name="Detector Model")
+// @Tags(identifierAttribute="arn")
+func resourceDetectorModel() *schema.Resource {
+	eventSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"action": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: detectorActionSchema(),
+						},
+					},
+					"condition": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(0, 512),
+					},
+					"event_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(0, 128),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorModelCreate,
+		ReadWithoutTimeout:   resourceDetectorModelRead,
+		UpdateWithoutTimeout: resourceDetectorModelUpdate,
+		DeleteWithoutTimeout: resourceDetectorModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"detector_model_definition": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"detector_model_definition", "detector_model_definition_json"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"initial_state_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"state": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"on_enter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": eventSchema(),
+											},
+										},
+									},
+									"on_exit": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": eventSchema(),
+											},
+										},
+									},
+									"on_input": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": eventSchema(),
+												"transition_event": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"action": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Elem: &schema.Resource{
+																	Schema: detectorActionSchema(),
+																},
+															},
+															"condition": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(0, 512),
+															},
+															"event_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(0, 128),
+															},
+															"next_state": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"state_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"detector_model_definition_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"detector_model_definition", "detector_model_definition_json"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentDetectorModelDefinitionJSONDiffs,
+			},
+			"detector_model_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"evaluation_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(iotevents.EvaluationMethod_Values(), false),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validRoleARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDetectorModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	definition, err := expandDetectorModelDefinitionFromResourceData(d)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateDetectorModelInput{
+		DetectorModelDefinition: definition,
+		DetectorModelName:       aws.String(name),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.DetectorModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_method"); ok {
+		input.EvaluationMethod = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	_, err = conn.CreateDetectorModelWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Events Detector Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitDetectorModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDetectorModelRead(ctx, d, meta)...)
+}
+
+func resourceDetectorModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	output, err := findDetectorModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	configuration := output.DetectorModelConfiguration
+	d.Set("arn", configuration.DetectorModelArn)
+	d.Set("description", configuration.DetectorModelDescription)
+	if _, ok := d.GetOk("detector_model_definition_json"); ok {
+		v, err := detectorModelDefinitionJSON(output.DetectorModelDefinition)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("detector_model_definition", nil)
+		d.Set("detector_model_definition_json", v)
+	} else {
+		if err := d.Set("detector_model_definition", flattenDetectorModelDefinition(output.DetectorModelDefinition)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting detector_model_definition: %s", err)
+		}
+		d.Set("detector_model_definition_json", nil)
+	}
+	d.Set("detector_model_version", configuration.DetectorModelVersion)
+	d.Set("evaluation_method", configuration.EvaluationMethod)
+	d.Set("key", configuration.Key)
+	d.Set("name", configuration.DetectorModelName)
+	d.Set("role_arn", configuration.RoleArn)
+	d.Set("status", configuration.Status)
+
+	return diags
+}
+
+func resourceDetectorModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		definition, err := expandDetectorModelDefinitionFromResourceData(d)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		// Each update creates a new version of the detector model.
+		input := &iotevents.UpdateDetectorModelInput{
+			DetectorModelDefinition:  definition,
+			DetectorModelDescription: aws.String(d.Get("description").(string)),
+			DetectorModelName:        aws.String(d.Id()),
+			RoleArn:                  aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("evaluation_method"); ok {
+			input.EvaluationMethod = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdateDetectorModelWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Events Detector Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDetectorModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDetectorModelRead(ctx, d, meta)...)
+}
+
+func resourceDetectorModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Events Detector Model: %s", d.Id())
+	_, err := conn.DeleteDetectorModelWithContext(ctx, &iotevents.DeleteDetectorModelInput{
+		DetectorModelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDetectorModelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDetectorModelByName(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.DetectorModel, error) {
+	input := &iotevents.DescribeDetectorModelInput{
+		DetectorModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDetectorModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DetectorModel == nil || output.DetectorModel.DetectorModelConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DetectorModel, nil
+}
+
+func statusDetectorModel(ctx context.Context, conn *iotevents.IoTEvents, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDetectorModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DetectorModelConfiguration.Status), nil
+	}
+}
+
+func waitDetectorModelActive(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotevents.DetectorModelVersionStatusActivating},
+		Target:  []string{iotevents.DetectorModelVersionStatusActive},
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDetectorModelDeleted(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: iotevents.DetectorModelVersionStatus_Values(),
+		Target:  []string{},
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// expandDetectorModelDefinitionFromResourceData returns the detector model definition from either
+// the typed detector_model_definition block or the detector_model_definition_json argument.
+func expandDetectorModelDefinitionFromResourceData(d *schema.ResourceData) (*iotevents.DetectorModelDefinition, error) {
+	if v, ok := d.GetOk("detector_model_definition_json"); ok {
+		apiObject := &iotevents.DetectorModelDefinition{}
+
+		if err := json.Unmarshal([]byte(v.(string)), apiObject); err != nil {
+			return nil, fmt.Errorf("decoding detector_model_definition_json: %w", err)
+		}
+
+		return apiObject, nil
+	}
+
+	return expandDetectorModelDefinition(d.Get("detector_model_definition").([]interface{})), nil
+}
+
+// detectorModelDefinitionJSON returns a normalized JSON representation of the specified detector model definition.
+// Keys of the decoded JSON document are matched case-insensitively, so the API's camelCase JSON documents are accepted.
+func detectorModelDefinitionJSON(apiObject *iotevents.DetectorModelDefinition) (string, error) {
+	b, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	var v interface{}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return "", err
+	}
+
+	b, err = json.Marshal(removeEmptyJSONValues(v))
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// removeEmptyJSONValues removes null values and empty arrays and objects from the specified decoded JSON value.
+func removeEmptyJSONValues(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			e = removeEmptyJSONValues(e)
+
+			if isEmptyJSONValue(e) {
+				delete(v, k)
+			} else {
+				v[k] = e
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = removeEmptyJSONValues(e)
+		}
+	}
+
+	return v
+}
+
+func isEmptyJSONValue(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+
+	return false
+}
+
+func suppressEquivalentDetectorModelDefinitionJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
+	normalize := func(s string) (string, error) {
+		apiObject := &iotevents.DetectorModelDefinition{}
+
+		if err := json.Unmarshal([]byte(s), apiObject); err != nil {
+			return "", err
+		}
+
+		return detectorModelDefinitionJSON(apiObject)
+	}
+
+	oldNormalized, err := normalize(old)
+
+	if err != nil {
+		return false
+	}
+
+	newNormalized, err := normalize(new)
+
+	if err != nil {
+		return false
+	}
+
+	return oldNormalized == newNormalized
+}
+
+func expandDetectorModelDefinition(tfList []interface{}) *iotevents.DetectorModelDefinition {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.DetectorModelDefinition{
+		InitialStateName: aws.String(tfMap["initial_state_name"].(string)),
+	}
+
+	if v, ok := tfMap["state"].([]interface{}); ok && len(v) > 0 {
+		apiObject.States = expandStates(v)
+	}
+
+	return apiObject
+}
+
+func expandStates(tfList []interface{}) []*iotevents.State {
+	var apiObjects []*iotevents.State
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.State{
+			StateName: aws.String(tfMap["state_name"].(string)),
+		}
+
+		if v, ok := tfMap["on_enter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.OnEnter = &iotevents.OnEnterLifecycle{
+				Events: expandEvents(v[0].(map[string]interface{})["event"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["on_exit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.OnExit = &iotevents.OnExitLifecycle{
+				Events: expandEvents(v[0].(map[string]interface{})["event"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["on_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.OnInput = &iotevents.OnInputLifecycle{
+				Events:           expandEvents(tfMap["event"].([]interface{})),
+				TransitionEvents: expandTransitionEvents(tfMap["transition_event"].([]interface{})),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEvents(tfList []interface{}) []*iotevents.Event {
+	var apiObjects []*iotevents.Event
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.Event{
+			Actions:   expandActionDatas(tfMap["action"].([]interface{})),
+			EventName: aws.String(tfMap["event_name"].(string)),
+		}
+
+		if v, ok := tfMap["condition"].(string); ok && v != "" {
+			apiObject.Condition = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTransitionEvents(tfList []interface{}) []*iotevents.TransitionEvent {
+	var apiObjects []*iotevents.TransitionEvent
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &iotevents.TransitionEvent{
+			Actions:   expandActionDatas(tfMap["action"].([]interface{})),
+			Condition: aws.String(tfMap["condition"].(string)),
+			EventName: aws.String(tfMap["event_name"].(string)),
+			NextState: aws.String(tfMap["next_state"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenDetectorModelDefinition(apiObject *iotevents.DetectorModelDefinition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"initial_state_name": aws.StringValue(apiObject.InitialStateName),
+		"state":              flattenStates(apiObject.States),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenStates(apiObjects []*iotevents.State) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"state_name": aws.StringValue(apiObject.StateName),
+		}
+
+		if v := apiObject.OnEnter; v != nil && len(v.Events) > 0 {
+			tfMap["on_enter"] = []interface{}{map[string]interface{}{
+				"event": flattenEvents(v.Events),
+			}}
+		}
+
+		if v := apiObject.OnExit; v != nil && len(v.Events) > 0 {
+			tfMap["on_exit"] = []interface{}{map[string]interface{}{
+				"event": flattenEvents(v.Events),
+			}}
+		}
+
+		if v := apiObject.OnInput; v != nil && (len(v.Events) > 0 || len(v.TransitionEvents) > 0) {
+			tfMap["on_input"] = []interface{}{map[string]interface{}{
+				"event":            flattenEvents(v.Events),
+				"transition_event": flattenTransitionEvents(v.TransitionEvents),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEvents(apiObjects []*iotevents.Event) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":     flattenActionDatas(apiObject.Actions),
+			"condition":  aws.StringValue(apiObject.Condition),
+			"event_name": aws.StringValue(apiObject.EventName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTransitionEvents(apiObjects []*iotevents.TransitionEvent) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":     flattenActionDatas(apiObject.Actions),
+			"condition":  aws.StringValue(apiObject.Condition),
+			"event_name": aws.StringValue(apiObject.EventName),
+			"next_state": aws.StringValue(apiObject.NextState),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,453 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTEventsDetectorModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotevents", fmt.Sprintf("detectorModel/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.initial_state_name", "Normal"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.0.state_name", "Normal"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.0.on_enter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.0.on_enter.0.event_name", "init"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.0.on_enter.0.action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.0.on_enter.0.action.0.set_variable.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.0.on_enter.0.action.0.set_variable.0.variable_name", "count"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.0.on_enter.0.action.0.set_variable.0.value", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "detector_model_version"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", "BATCH"),
+					resource.TestCheckResourceAttr(resourceName, "key", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotevents.ResourceDetectorModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 iotevents.DetectorModel
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.#", "1"),
+				),
+			},
+			{
+				Config: testAccDetectorModelConfig_twoStates(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v2),
+					testAccCheckDetectorModelVersionChanged(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "description", "two states"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.0.on_input.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.0.on_input.0.transition_event.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.0.on_input.0.transition_event.0.next_state", "Alarm"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.1.state_name", "Alarm"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.1.on_enter.0.action.0.set_timer.0.timer_name", "cooldown"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_definitionJSON(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_definitionJSON(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "detector_model_definition_json"),
+					resource.TestCheckResourceAttr(resourceName, "key", "deviceId"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detector_model_definition", "detector_model_definition_json"},
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDetectorModelConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDetectorModelConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorModelExists(ctx context.Context, n string, v *iotevents.DetectorModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		output, err := tfiotevents.FindDetectorModelByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDetectorModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotevents_detector_model" {
+				continue
+			}
+
+			_, err := tfiotevents.FindDetectorModelByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Events Detector Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDetectorModelVersionChanged(before, after *iotevents.DetectorModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := before.DetectorModelConfiguration.DetectorModelVersion, after.DetectorModelConfiguration.DetectorModelVersion; *before == *after {
+			return fmt.Errorf("IoT Events Detector Model version not changed (%s)", *before)
+		}
+
+		return nil
+	}
+}
+
+func testAccDetectorModelConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "iotevents.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "deviceId"
+    }
+
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDetectorModelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDetectorModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  detector_model_definition {
+    initial_state_name = "Normal"
+
+    state {
+      state_name = "Normal"
+
+      on_enter {
+        event_name = "init"
+
+        action {
+          set_variable {
+            variable_name = "count"
+            value         = "0"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccDetectorModelConfig_twoStates(rName string) string {
+	return acctest.ConfigCompose(testAccDetectorModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name        = %[1]q
+  description = "two states"
+  role_arn    = aws_iam_role.test.arn
+
+  detector_model_definition {
+    initial_state_name = "Normal"
+
+    state {
+      state_name = "Normal"
+
+      on_enter {
+        event_name = "init"
+
+        action {
+          set_variable {
+            variable_name = "count"
+            value         = "0"
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "overheated"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature > 80"
+          next_state = "Alarm"
+        }
+      }
+    }
+
+    state {
+      state_name = "Alarm"
+
+      on_enter {
+        event_name = "startCooldown"
+
+        action {
+          set_timer {
+            timer_name          = "cooldown"
+            duration_expression = "300"
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "cooledDown"
+          condition  = "timeout(\"cooldown\")"
+          next_state = "Normal"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccDetectorModelConfig_definitionJSON(rName string) string {
+	return acctest.ConfigCompose(testAccDetectorModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  key      = "deviceId"
+  role_arn = aws_iam_role.test.arn
+
+  detector_model_definition_json = jsonencode({
+    initialStateName = "Normal"
+    states = [{
+      stateName = "Normal"
+      onEnter = {
+        events = [{
+          eventName = "init"
+          actions = [{
+            setVariable = {
+              variableName = "count"
+              value        = "0"
+            }
+          }]
+        }]
+      }
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccDetectorModelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDetectorModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  detector_model_definition {
+    initial_state_name = "Normal"
+
+    state {
+      state_name = "Normal"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDetectorModelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDetectorModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  detector_model_definition {
+    initial_state_name = "Normal"
+
+    state {
+      state_name = "Normal"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,542 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// alarmActionSchema returns the schema for the actions supported by both alarm models and detector models.
+func alarmActionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"firehose": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"delivery_stream_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"payload": payloadSchema(),
+					"separator": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"\n", "\t", "\r\n", ","}, false),
+					},
+				},
+			},
+		},
+		"iot_events": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"input_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 128),
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"iot_topic_publish": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mqtt_topic": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 128),
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"lambda": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"function_arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"sns": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					"target_arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+			},
+		},
+		"sqs": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					"queue_url": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"use_base64": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// detectorActionSchema returns the schema for the actions supported by detector models.
+func detectorActionSchema() map[string]*schema.Schema {
+	s := alarmActionSchema()
+
+	s["clear_timer"] = timerNameSchema()
+	s["reset_timer"] = timerNameSchema()
+	s["set_timer"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_expression": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"timer_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+	s["set_variable"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"value": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"variable_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+
+	return s
+}
+
+func payloadSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_expression": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(iotevents.PayloadType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func timerNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timer_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+}
+
+func expandActionDatas(tfList []interface{}) []*iotevents.ActionData {
+	var apiObjects []*iotevents.ActionData
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.ActionData{}
+
+		if v, ok := tfMap["clear_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ClearTimer = &iotevents.ClearTimerAction{
+				TimerName: aws.String(v[0].(map[string]interface{})["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Firehose = expandFirehoseAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["iot_events"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IotEvents = expandIotEventsAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["iot_topic_publish"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IotTopicPublish = expandIotTopicPublishAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["lambda"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Lambda = expandLambdaAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["reset_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ResetTimer = &iotevents.ResetTimerAction{
+				TimerName: aws.String(v[0].(map[string]interface{})["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["set_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.SetTimer = &iotevents.SetTimerAction{
+				DurationExpression: aws.String(tfMap["duration_expression"].(string)),
+				TimerName:          aws.String(tfMap["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["set_variable"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.SetVariable = &iotevents.SetVariableAction{
+				Value:        aws.String(tfMap["value"].(string)),
+				VariableName: aws.String(tfMap["variable_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["sns"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Sns = expandSNSTopicPublishAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["sqs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Sqs = expandSqsAction(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAlarmActions(tfList []interface{}) []*iotevents.AlarmAction {
+	var apiObjects []*iotevents.AlarmAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.AlarmAction{}
+
+		if v, ok := tfMap["firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Firehose = expandFirehoseAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["iot_events"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IotEvents = expandIotEventsAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["iot_topic_publish"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IotTopicPublish = expandIotTopicPublishAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["lambda"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Lambda = expandLambdaAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["sns"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Sns = expandSNSTopicPublishAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["sqs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Sqs = expandSqsAction(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandFirehoseAction(tfMap map[string]interface{}) *iotevents.FirehoseAction {
+	apiObject := &iotevents.FirehoseAction{
+		DeliveryStreamName: aws.String(tfMap["delivery_stream_name"].(string)),
+		Payload:            expandPayload(tfMap["payload"].([]interface{})),
+	}
+
+	if v, ok := tfMap["separator"].(string); ok && v != "" {
+		apiObject.Separator = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsAction(tfMap map[string]interface{}) *iotevents.Action {
+	return &iotevents.Action{
+		InputName: aws.String(tfMap["input_name"].(string)),
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+	}
+}
+
+func expandIotTopicPublishAction(tfMap map[string]interface{}) *iotevents.IotTopicPublishAction {
+	return &iotevents.IotTopicPublishAction{
+		MqttTopic: aws.String(tfMap["mqtt_topic"].(string)),
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+	}
+}
+
+func expandLambdaAction(tfMap map[string]interface{}) *iotevents.LambdaAction {
+	return &iotevents.LambdaAction{
+		FunctionArn: aws.String(tfMap["function_arn"].(string)),
+		Payload:     expandPayload(tfMap["payload"].([]interface{})),
+	}
+}
+
+func expandSNSTopicPublishAction(tfMap map[string]interface{}) *iotevents.SNSTopicPublishAction {
+	return &iotevents.SNSTopicPublishAction{
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+		TargetArn: aws.String(tfMap["target_arn"].(string)),
+	}
+}
+
+func expandSqsAction(tfMap map[string]interface{}) *iotevents.SqsAction {
+	apiObject := &iotevents.SqsAction{
+		Payload:  expandPayload(tfMap["payload"].([]interface{})),
+		QueueUrl: aws.String(tfMap["queue_url"].(string)),
+	}
+
+	if v, ok := tfMap["use_base64"].(bool); ok && v {
+		apiObject.UseBase64 = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandPayload(tfList []interface{}) *iotevents.Payload {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotevents.Payload{
+		ContentExpression: aws.String(tfMap["content_expression"].(string)),
+		Type:              aws.String(tfMap["type"].(string)),
+	}
+}
+
+func flattenActionDatas(apiObjects []*iotevents.ActionData) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.ClearTimer; v != nil {
+			tfMap["clear_timer"] = []interface{}{map[string]interface{}{
+				"timer_name": aws.StringValue(v.TimerName),
+			}}
+		}
+
+		if v := apiObject.Firehose; v != nil {
+			tfMap["firehose"] = flattenFirehoseAction(v)
+		}
+
+		if v := apiObject.IotEvents; v != nil {
+			tfMap["iot_events"] = flattenIotEventsAction(v)
+		}
+
+		if v := apiObject.IotTopicPublish; v != nil {
+			tfMap["iot_topic_publish"] = flattenIotTopicPublishAction(v)
+		}
+
+		if v := apiObject.Lambda; v != nil {
+			tfMap["lambda"] = flattenLambdaAction(v)
+		}
+
+		if v := apiObject.ResetTimer; v != nil {
+			tfMap["reset_timer"] = []interface{}{map[string]interface{}{
+				"timer_name": aws.StringValue(v.TimerName),
+			}}
+		}
+
+		if v := apiObject.SetTimer; v != nil {
+			tfMap["set_timer"] = []interface{}{map[string]interface{}{
+				"duration_expression": aws.StringValue(v.DurationExpression),
+				"timer_name":          aws.StringValue(v.TimerName),
+			}}
+		}
+
+		if v := apiObject.SetVariable; v != nil {
+			tfMap["set_variable"] = []interface{}{map[string]interface{}{
+				"value":         aws.StringValue(v.Value),
+				"variable_name": aws.StringValue(v.VariableName),
+			}}
+		}
+
+		if v := apiObject.Sns; v != nil {
+			tfMap["sns"] = flattenSNSTopicPublishAction(v)
+		}
+
+		if v := apiObject.Sqs; v != nil {
+			tfMap["sqs"] = flattenSqsAction(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAlarmActions(apiObjects []*iotevents.AlarmAction) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Firehose; v != nil {
+			tfMap["firehose"] = flattenFirehoseAction(v)
+		}
+
+		if v := apiObject.IotEvents; v != nil {
+			tfMap["iot_events"] = flattenIotEventsAction(v)
+		}
+
+		if v := apiObject.IotTopicPublish; v != nil {
+			tfMap["iot_topic_publish"] = flattenIotTopicPublishAction(v)
+		}
+
+		if v := apiObject.Lambda; v != nil {
+			tfMap["lambda"] = flattenLambdaAction(v)
+		}
+
+		if v := apiObject.Sns; v != nil {
+			tfMap["sns"] = flattenSNSTopicPublishAction(v)
+		}
+
+		if v := apiObject.Sqs; v != nil {
+			tfMap["sqs"] = flattenSqsAction(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenFirehoseAction(apiObject *iotevents.FirehoseAction) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"delivery_stream_name": aws.StringValue(apiObject.DeliveryStreamName),
+		"payload":              flattenPayload(apiObject.Payload),
+		"separator":            aws.StringValue(apiObject.Separator),
+	}}
+}
+
+func flattenIotEventsAction(apiObject *iotevents.Action) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"input_name": aws.StringValue(apiObject.InputName),
+		"payload":    flattenPayload(apiObject.Payload),
+	}}
+}
+
+func flattenIotTopicPublishAction(apiObject *iotevents.IotTopicPublishAction) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"mqtt_topic": aws.StringValue(apiObject.MqttTopic),
+		"payload":    flattenPayload(apiObject.Payload),
+	}}
+}
+
+func flattenLambdaAction(apiObject *iotevents.LambdaAction) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"function_arn": aws.StringValue(apiObject.FunctionArn),
+		"payload":      flattenPayload(apiObject.Payload),
+	}}
+}
+
+func flattenSNSTopicPublishAction(apiObject *iotevents.SNSTopicPublishAction) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"payload":    flattenPayload(apiObject.Payload),
+		"target_arn": aws.StringValue(apiObject.TargetArn),
+	}}
+}
+
+func flattenSqsAction(apiObject *iotevents.SqsAction) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"payload":    flattenPayload(apiObject.Payload),
+		"queue_url":  aws.StringValue(apiObject.QueueUrl),
+		"use_base64": aws.BoolValue(apiObject.UseBase64),
+	}}
+}
+
+func flattenPayload(apiObject *iotevents.Payload) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"content_expression": aws.StringValue(apiObject.ContentExpression),
+		"type":               aws.StringValue(apiObject.Type),
+	}}
+}
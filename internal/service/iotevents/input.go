@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotevents_input", name="Input")
+// @Tags(identifierAttribute="arn")
+func resourceInput() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInputCreate,
+		ReadWithoutTimeout:   resourceInputRead,
+		UpdateWithoutTimeout: resourceInputUpdate,
+		DeleteWithoutTimeout: resourceInputDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"input_definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 200,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"json_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z_]*$`), "must begin with a letter and contain only alphanumeric characters and underscores"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceInputCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateInputInput{
+		InputDefinition: expandInputDefinition(d.Get("input_definition").([]interface{})),
+		InputName:       aws.String(name),
+		Tags:            getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.InputDescription = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateInputWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Events Input (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitInputActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Input (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceInputRead(ctx, d, meta)...)
+}
+
+func resourceInputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	output, err := findInputByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Input (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Events Input (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.InputConfiguration.InputArn)
+	d.Set("description", output.InputConfiguration.InputDescription)
+	if err := d.Set("input_definition", flattenInputDefinition(output.InputDefinition)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input_definition: %s", err)
+	}
+	d.Set("name", output.InputConfiguration.InputName)
+
+	return diags
+}
+
+func resourceInputUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotevents.UpdateInputInput{
+			InputDefinition:  expandInputDefinition(d.Get("input_definition").([]interface{})),
+			InputDescription: aws.String(d.Get("description").(string)),
+			InputName:        aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateInputWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Events Input (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitInputActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Input (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceInputRead(ctx, d, meta)...)
+}
+
+func resourceInputDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Events Input: %s", d.Id())
+	_, err := conn.DeleteInputWithContext(ctx, &iotevents.DeleteInputInput{
+		InputName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Events Input (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitInputDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Input (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findInputByName(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.Input, error) {
+	input := &iotevents.DescribeInputInput{
+		InputName: aws.String(name),
+	}
+
+	output, err := conn.DescribeInputWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Input == nil || output.Input.InputConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Input, nil
+}
+
+func statusInput(ctx context.Context, conn *iotevents.IoTEvents, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findInputByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.InputConfiguration.Status), nil
+	}
+}
+
+func waitInputActive(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.Input, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotevents.InputStatusCreating, iotevents.InputStatusUpdating},
+		Target:  []string{iotevents.InputStatusActive},
+		Refresh: statusInput(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.Input); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInputDeleted(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.Input, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotevents.InputStatusDeleting},
+		Target:  []string{},
+		Refresh: statusInput(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.Input); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandInputDefinition(tfList []interface{}) *iotevents.InputDefinition {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.InputDefinition{}
+
+	if v, ok := tfMap["attribute"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.Attributes = append(apiObject.Attributes, &iotevents.Attribute{
+				JsonPath: aws.String(tfMap["json_path"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenInputDefinition(apiObject *iotevents.InputDefinition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.Attributes {
+		tfList = append(tfList, map[string]interface{}{
+			"json_path": aws.StringValue(v.JsonPath),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"attribute": tfList,
+	}}
+}
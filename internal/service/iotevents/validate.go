@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// validRoleARN validates that a string value is an IAM role ARN.
+var validRoleARN = verify.ValidARNCheck(roleARNCheck)
+
+func roleARNCheck(v any, k string, arn arn.ARN) (ws []string, errors []error) {
+	if arn.Service != "iam" || !strings.HasPrefix(arn.Resource, "role/") {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid IAM role ARN", k, v))
+	}
+	return
+}
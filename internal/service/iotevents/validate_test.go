@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"testing"
+)
+
+func TestValidRoleARN(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value   string
+		IsValid bool
+	}{
+		{
+			Value:   "arn:aws:iam::123456789012:role/example",
+			IsValid: true,
+		},
+		{
+			Value:   "arn:aws:iam::123456789012:role/service-role/example",
+			IsValid: true,
+		},
+		{
+			Value:   "arn:aws-us-gov:iam::123456789012:role/example",
+			IsValid: true,
+		},
+		{
+			Value:   "arn:aws:iam::123456789012:user/example",
+			IsValid: false,
+		},
+		{
+			Value:   "arn:aws:sns:us-west-2:123456789012:example",
+			IsValid: false,
+		},
+		{
+			Value:   "example",
+			IsValid: false,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := validRoleARN(tc.Value, "role_arn")
+		isValid := len(errors) == 0
+		if tc.IsValid && !isValid {
+			t.Errorf("expected %q to return valid, but did not", tc.Value)
+		} else if !tc.IsValid && isValid {
+			t.Errorf("expected %q to not return valid, but did", tc.Value)
+		}
+	}
+}
@@ -40,6 +40,8 @@ func TestAccKeyspacesKeyspace_basic(t *testing.T) {
 					testAccCheckKeyspaceExists(ctx, resourceName),
 					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "cassandra", "/keyspace/"+rName+"/"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.replication_strategy", "SINGLE_REGION"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
@@ -52,6 +54,41 @@ func TestAccKeyspacesKeyspace_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeyspacesKeyspace_replicationSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_keyspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KeyspacesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyspaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyspaceConfig_replicationSpecification(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyspaceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.region_list.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_specification.0.region_list.*", acctest.Region()),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_specification.0.region_list.*", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.replication_strategy", "MULTI_REGION"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccKeyspacesKeyspace_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := "tf_acc_test_" + sdkacctest.RandString(20)
@@ -173,6 +210,19 @@ resource "aws_keyspaces_keyspace" "test" {
 `, rName)
 }
 
+func testAccKeyspaceConfig_replicationSpecification(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+
+  replication_specification {
+    region_list          = [%[2]q, %[3]q]
+    replication_strategy = "MULTI_REGION"
+  }
+}
+`, rName, acctest.Region(), acctest.AlternateRegion())
+}
+
 func testAccKeyspaceConfig_tags1(rName, tag1Key, tag1Value string) string {
 	return fmt.Sprintf(`
 resource "aws_keyspaces_keyspace" "test" {
@@ -102,6 +102,7 @@ func resourceTable() *schema.Resource {
 			"client_side_timestamps": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -181,6 +182,35 @@ func resourceTable() *schema.Resource {
 					},
 				},
 			},
+			"replication_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"replica": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MaxItems: 6,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"read_capacity_units": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"region": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"schema_definition": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -341,6 +371,10 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.PointInTimeRecovery = expandPointInTimeRecovery(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("replication_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ReplicaSpecifications = expandReplicaSpecifications(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("schema_definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.SchemaDefinition = expandSchemaDefinition(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -425,6 +459,13 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	} else {
 		d.Set("point_in_time_recovery", nil)
 	}
+	if len(table.ReplicaSpecifications) > 0 {
+		if err := d.Set("replication_specification", []interface{}{flattenReplicaSpecificationSummaries(table.ReplicaSpecifications)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting replication_specification: %s", err)
+		}
+	} else {
+		d.Set("replication_specification", nil)
+	}
 	if table.SchemaDefinition != nil {
 		if err := d.Set("schema_definition", []interface{}{flattenSchemaDefinition(table.SchemaDefinition)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting schema_definition: %s", err)
@@ -556,6 +597,26 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 		}
 
+		if d.HasChange("replication_specification") {
+			if v, ok := d.GetOk("replication_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input := &keyspaces.UpdateTableInput{
+					KeyspaceName:          aws.String(keyspaceName),
+					ReplicaSpecifications: expandReplicaSpecifications(v.([]interface{})[0].(map[string]interface{})),
+					TableName:             aws.String(tableName),
+				}
+
+				_, err := conn.UpdateTable(ctx, input)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating Keyspaces Table (%s) ReplicaSpecifications: %s", d.Id(), err)
+				}
+
+				if _, err := waitTableUpdated(ctx, conn, keyspaceName, tableName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for Keyspaces Table (%s) ReplicaSpecifications update: %s", d.Id(), err)
+				}
+			}
+		}
+
 		if d.HasChange("ttl") {
 			if v, ok := d.GetOk("ttl"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input := &keyspaces.UpdateTableInput{
@@ -850,6 +911,40 @@ func expandPointInTimeRecovery(tfMap map[string]interface{}) *types.PointInTimeR
 	return apiObject
 }
 
+func expandReplicaSpecifications(tfMap map[string]interface{}) []types.ReplicaSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	v, ok := tfMap["replica"].(*schema.Set)
+	if !ok || v.Len() == 0 {
+		return nil
+	}
+
+	var apiObjects []types.ReplicaSpecification
+
+	for _, tfMapRaw := range v.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.ReplicaSpecification{}
+
+		if v, ok := tfMap["read_capacity_units"].(int); ok && v != 0 {
+			apiObject.ReadCapacityUnits = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["region"].(string); ok && v != "" {
+			apiObject.Region = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandSchemaDefinition(tfMap map[string]interface{}) *types.SchemaDefinition {
 	if tfMap == nil {
 		return nil
@@ -1132,6 +1227,31 @@ func flattenPointInTimeRecoverySummary(apiObject *types.PointInTimeRecoverySumma
 	return tfMap
 }
 
+func flattenReplicaSpecificationSummaries(apiObjects []types.ReplicaSpecificationSummary) map[string]interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"region": aws.ToString(apiObject.Region),
+		}
+
+		// Per-Region read capacity only applies to provisioned tables.
+		if v := apiObject.CapacitySpecification; v != nil && v.ThroughputMode == types.ThroughputModeProvisioned {
+			tfMap["read_capacity_units"] = aws.ToInt64(v.ReadCapacityUnits)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return map[string]interface{}{
+		"replica": tfList,
+	}
+}
+
 func flattenSchemaDefinition(apiObject *types.SchemaDefinition) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -178,6 +178,55 @@ func TestAccKeyspacesTable_clientSideTimestamps(t *testing.T) {
 	})
 }
 
+func TestAccKeyspacesTable_multiRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v keyspaces.GetTableOutput
+	rName1 := "tf_acc_test_" + sdkacctest.RandString(20)
+	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KeyspacesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_multiRegion(rName1, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.0.throughput_mode", "PAY_PER_REQUEST"),
+					resource.TestCheckResourceAttr(resourceName, "client_side_timestamps.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.replica.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableConfig_multiRegionProvisioned(rName1, rName2, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.0.throughput_mode", "PROVISIONED"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.replica.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replication_specification.0.replica.*", map[string]string{
+						"read_capacity_units": "2",
+						"region":              acctest.AlternateRegion(),
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeyspacesTable_multipleColumns(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v keyspaces.GetTableOutput
@@ -672,6 +721,77 @@ resource "aws_keyspaces_table" "test" {
 `, rName1, rName2)
 }
 
+func testAccTableConfig_multiRegionBase(rName1 string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+
+  replication_specification {
+    region_list          = [%[2]q, %[3]q]
+    replication_strategy = "MULTI_REGION"
+  }
+}
+`, rName1, acctest.Region(), acctest.AlternateRegion())
+}
+
+func testAccTableConfig_multiRegion(rName1, rName2 string) string {
+	return acctest.ConfigCompose(testAccTableConfig_multiRegionBase(rName1), fmt.Sprintf(`
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = aws_keyspaces_keyspace.test.name
+  table_name    = %[1]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+}
+`, rName2))
+}
+
+func testAccTableConfig_multiRegionProvisioned(rName1, rName2 string, alternateReadCapacityUnits int) string {
+	return acctest.ConfigCompose(testAccTableConfig_multiRegionBase(rName1), fmt.Sprintf(`
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = aws_keyspaces_keyspace.test.name
+  table_name    = %[1]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+
+  capacity_specification {
+    throughput_mode      = "PROVISIONED"
+    read_capacity_units  = 1
+    write_capacity_units = 1
+  }
+
+  replication_specification {
+    replica {
+      read_capacity_units = 1
+      region              = %[2]q
+    }
+
+    replica {
+      read_capacity_units = %[4]d
+      region              = %[3]q
+    }
+  }
+}
+`, rName2, acctest.Region(), acctest.AlternateRegion(), alternateReadCapacityUnits))
+}
+
 func testAccTableConfig_multipleColumns(rName1, rName2 string) string {
 	return fmt.Sprintf(`
 resource "aws_keyspaces_keyspace" "test" {
@@ -21,6 +21,11 @@ const (
 	propagationTimeout = 5 * time.Minute
 )
 
+const (
+	errCodeAccessDeniedException         = "AccessDeniedException"
+	errCodeUnsupportedOperationException = "UnsupportedOperationException"
+)
+
 const (
 	invocationActionCreate = "create"
 	invocationActionDelete = "delete"
@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -299,6 +300,12 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"recursive_loop": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.RecursiveLoopTerminate,
+				ValidateDiagFunc: enum.Validate[types.RecursiveLoop](),
+			},
 			"replacement_security_group_ids": {
 				Deprecated: "AWS no longer supports this operation. This attribute now has " +
 					"no effect and will be removed in a future major version.",
@@ -458,6 +465,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			checkLoggingConfigLogLevels,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -585,7 +593,7 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	_, err := retryFunctionOp(ctx, func() (interface{}, error) {
+	outputRaw, err := retryFunctionOp(ctx, func() (interface{}, error) {
 		return conn.CreateFunction(ctx, input)
 	})
 
@@ -607,6 +615,16 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): waiting for completion: %s", d.Id(), err)
 	}
 
+	// A published version isn't invokable (e.g. via an alias) until it's Active, which with SnapStart enabled
+	// is only after the version's snapshot has been created.
+	if d.Get("publish").(bool) {
+		version := aws.ToString(outputRaw.(*lambda.CreateFunctionOutput).Version)
+
+		if _, err := waitFunctionVersionActive(ctx, conn, d.Id(), version, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): waiting for version (%s) activation: %s", d.Id(), version, err)
+		}
+	}
+
 	if v, ok := d.Get("reserved_concurrent_executions").(int); ok && v >= 0 {
 		_, err := conn.PutFunctionConcurrency(ctx, &lambda.PutFunctionConcurrencyInput{
 			FunctionName:                 aws.String(d.Id()),
@@ -618,6 +636,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v := types.RecursiveLoop(d.Get("recursive_loop").(string)); v != types.RecursiveLoopTerminate {
+		_, err := conn.PutFunctionRecursionConfig(ctx, &lambda.PutFunctionRecursionConfigInput{
+			FunctionName:  aws.String(d.Id()),
+			RecursiveLoop: v,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) recursion config: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
 }
 
@@ -701,9 +730,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("signing_profile_version_arn", function.SigningProfileVersionArn)
 	// Support in-place update of non-refreshable attribute.
 	d.Set("skip_destroy", d.Get("skip_destroy"))
-	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting snap_start: %s", err)
-	}
+	snapStart := flattenSnapStart(function.SnapStart)
 	d.Set("source_code_hash", function.CodeSha256)
 	d.Set("source_code_size", function.CodeSize)
 	d.Set("timeout", function.Timeout)
@@ -738,9 +765,18 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("qualified_invoke_arn", functionInvokeARN(qualifiedARN, meta))
 		d.Set("version", latest.Version)
 
+		// SnapStart only optimizes published versions, so report the latest published version's status.
+		if len(snapStart) > 0 && aws.ToString(latest.Version) != FunctionVersionLatest && latest.SnapStart != nil {
+			snapStart[0].(map[string]interface{})["optimization_status"] = string(latest.SnapStart.OptimizationStatus)
+		}
+
 		setTagsOut(ctx, output.Tags)
 	}
 
+	if err := d.Set("snap_start", snapStart); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting snap_start: %s", err)
+	}
+
 	// Currently, this functionality is only enabled in AWS Commercial partition
 	// and other partitions return ambiguous error codes (e.g. AccessDeniedException
 	// in AWS GovCloud (US)) so we cannot just ignore the error as would typically.
@@ -767,6 +803,21 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("code_signing_config_arn", codeSigningConfigArn)
 	}
 
+	// Recursive loop detection is only available in the AWS Commercial partition
+	// and not yet in every Region there.
+	if partition := meta.(*conns.AWSClient).Partition; partition == endpoints.AwsPartitionID {
+		output, err := findFunctionRecursionConfigByName(ctx, conn, d.Id())
+
+		switch {
+		case err == nil:
+			d.Set("recursive_loop", output.RecursiveLoop)
+		case tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException, errCodeUnsupportedOperationException):
+			log.Printf("[WARN] Unable to read Lambda Function (%s) recursion config: %s", d.Id(), err)
+		default:
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) recursion config: %s", d.Id(), err)
+		}
+	}
+
 	return diags
 }
 
@@ -1013,6 +1064,17 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("recursive_loop") {
+		_, err := conn.PutFunctionRecursionConfig(ctx, &lambda.PutFunctionRecursionConfigInput{
+			FunctionName:  aws.String(d.Id()),
+			RecursiveLoop: types.RecursiveLoop(d.Get("recursive_loop").(string)),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) recursion config: %s", d.Id(), err)
+		}
+	}
+
 	if d.Get("publish").(bool) && (codeUpdate || configUpdate || d.HasChange("publish")) {
 		input := &lambda.PublishVersionInput{
 			FunctionName: aws.String(d.Id()),
@@ -1045,6 +1107,10 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "publishing Lambda Function (%s) version: waiting for completion: %s", d.Id(), err)
 		}
+
+		if _, err := waitFunctionVersionActive(ctx, conn, d.Id(), aws.ToString(output.Version), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "publishing Lambda Function (%s) version: waiting for activation: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
@@ -1085,6 +1151,15 @@ func FindFunctionByName(ctx context.Context, conn *lambda.Client, name string) (
 	return findFunction(ctx, conn, input)
 }
 
+func findFunctionByTwoPartKey(ctx context.Context, conn *lambda.Client, name, qualifier string) (*lambda.GetFunctionOutput, error) {
+	input := &lambda.GetFunctionInput{
+		FunctionName: aws.String(name),
+		Qualifier:    aws.String(qualifier),
+	}
+
+	return findFunction(ctx, conn, input)
+}
+
 func findFunction(ctx context.Context, conn *lambda.Client, input *lambda.GetFunctionInput) (*lambda.GetFunctionOutput, error) {
 	output, err := conn.GetFunction(ctx, input)
 
@@ -1106,6 +1181,31 @@ func findFunction(ctx context.Context, conn *lambda.Client, input *lambda.GetFun
 	return output, nil
 }
 
+func findFunctionRecursionConfigByName(ctx context.Context, conn *lambda.Client, name string) (*lambda.GetFunctionRecursionConfigOutput, error) {
+	input := &lambda.GetFunctionRecursionConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	output, err := conn.GetFunctionRecursionConfig(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func findLatestFunctionVersionByName(ctx context.Context, conn *lambda.Client, name string) (*types.FunctionConfiguration, error) {
 	input := &lambda.ListVersionsByFunctionInput{
 		FunctionName: aws.String(name),
@@ -1164,6 +1264,22 @@ func statusFunctionState(ctx context.Context, conn *lambda.Client, name string)
 	}
 }
 
+func statusFunctionVersionState(ctx context.Context, conn *lambda.Client, name, version string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFunctionByTwoPartKey(ctx, conn, name, version)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output.Configuration, string(output.Configuration.State), nil
+	}
+}
+
 func waitFunctionCreated(ctx context.Context, conn *lambda.Client, name string, timeout time.Duration) (*types.FunctionConfiguration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.StatePending),
@@ -1204,6 +1320,26 @@ func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName
 	return nil, err
 }
 
+func waitFunctionVersionActive(ctx context.Context, conn *lambda.Client, name, version string, timeout time.Duration) (*types.FunctionConfiguration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.StatePending),
+		Target:  enum.Slice(types.StateActive),
+		Refresh: statusFunctionVersionState(ctx, conn, name, version),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.FunctionConfiguration); ok {
+		tfresource.SetLastError(err, fmt.Errorf("%s: %s", string(output.StateReasonCode), aws.ToString(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 // retryFunctionOp retries a Lambda Function Create or Update operation.
 // It handles IAM eventual consistency and EC2 throttling.
 func retryFunctionOp(ctx context.Context, f func() (interface{}, error)) (interface{}, error) { //nolint:unparam
@@ -1266,6 +1402,17 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+func checkLoggingConfigLogLevels(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if types.LogFormat(d.Get("logging_config.0.log_format").(string)) != types.LogFormatText {
+		return nil
+	}
+
+	if d.Get("logging_config.0.application_log_level").(string) != "" || d.Get("logging_config.0.system_log_level").(string) != "" {
+		return fmt.Errorf("logging_config application_log_level and system_log_level can only be set when log_format is %s", types.LogFormatJson)
+	}
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	DSNameLayerVersions = "Layer Versions Data Source"
+)
+
+// @SDKDataSource("aws_lambda_layer_versions")
+func DataSourceLayerVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLayerVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"compatible_architecture": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(lambda.Architecture_Values(), false),
+			},
+			"compatible_runtime": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(lambda.Runtime_Values(), false),
+			},
+			"latest_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latest_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"layer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"layer_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compatible_architectures": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"compatible_runtimes": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"license_info": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLayerVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LambdaConn(ctx)
+
+	layerName := d.Get("layer_name").(string)
+	input := &lambda.ListLayerVersionsInput{
+		LayerName: aws.String(layerName),
+	}
+
+	if v, ok := d.GetOk("compatible_architecture"); ok {
+		input.CompatibleArchitecture = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("compatible_runtime"); ok {
+		input.CompatibleRuntime = aws.String(v.(string))
+	}
+
+	var layerVersions []*lambda.LayerVersionsListItem
+
+	err := conn.ListLayerVersionsPagesWithContext(ctx, input, func(page *lambda.ListLayerVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LayerVersions {
+			if v != nil {
+				layerVersions = append(layerVersions, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lambda, create.ErrActionReading, DSNameLayerVersions, layerName, err)
+	}
+
+	d.SetId(layerName)
+	// Layer versions are returned newest first.
+	if len(layerVersions) > 0 {
+		d.Set("latest_arn", layerVersions[0].LayerVersionArn)
+		d.Set("latest_version", layerVersions[0].Version)
+	} else {
+		d.Set("latest_arn", nil)
+		d.Set("latest_version", nil)
+	}
+	if err := d.Set("layer_versions", flattenLayerVersionsListItems(layerVersions)); err != nil {
+		return create.AppendDiagSettingError(diags, names.Lambda, DSNameLayerVersions, layerName, "layer_versions", err)
+	}
+
+	return diags
+}
+
+func flattenLayerVersionsListItems(apiObjects []*lambda.LayerVersionsListItem) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"arn":                      aws.StringValue(apiObject.LayerVersionArn),
+			"compatible_architectures": flex.FlattenStringSet(apiObject.CompatibleArchitectures),
+			"compatible_runtimes":      flex.FlattenStringSet(apiObject.CompatibleRuntimes),
+			"created_date":             aws.StringValue(apiObject.CreatedDate),
+			"description":              aws.StringValue(apiObject.Description),
+			"license_info":             aws.StringValue(apiObject.LicenseInfo),
+			"version":                  aws.Int64Value(apiObject.Version),
+		})
+	}
+
+	return tfList
+}
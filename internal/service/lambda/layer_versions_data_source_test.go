@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaLayerVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+	resourceName := "aws_lambda_layer_version.test"
+	resourceName2 := "aws_lambda_layer_version.test_two"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "latest_arn", resourceName2, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "latest_version", resourceName2, "version"),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.arn", resourceName2, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.version", resourceName2, "version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.1.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.1.created_date", resourceName, "created_date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.1.description", resourceName, "description"),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.1.compatible_runtimes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "layer_versions.1.compatible_runtimes.*", "nodejs16.x"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaLayerVersionsDataSource_compatibleRuntime(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+	resourceName := "aws_lambda_layer_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_compatibleRuntime(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "latest_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "latest_version", resourceName, "version"),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLayerVersionsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test" {
+  filename            = "test-fixtures/lambdatest.zip"
+  layer_name          = %[1]q
+  compatible_runtimes = ["nodejs16.x"]
+  description         = "first"
+}
+
+resource "aws_lambda_layer_version" "test_two" {
+  filename            = "test-fixtures/lambdatest_modified.zip"
+  layer_name          = aws_lambda_layer_version.test.layer_name
+  compatible_runtimes = ["nodejs16.x"]
+  description         = "second"
+}
+
+data "aws_lambda_layer_versions" "test" {
+  layer_name = aws_lambda_layer_version.test_two.layer_name
+}
+`, rName)
+}
+
+func testAccLayerVersionsDataSourceConfig_compatibleRuntime(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test" {
+  filename            = "test-fixtures/lambdatest.zip"
+  layer_name          = %[1]q
+  compatible_runtimes = ["python3.12"]
+}
+
+resource "aws_lambda_layer_version" "test_two" {
+  filename            = "test-fixtures/lambdatest_modified.zip"
+  layer_name          = aws_lambda_layer_version.test.layer_name
+  compatible_runtimes = ["nodejs16.x"]
+}
+
+data "aws_lambda_layer_versions" "test" {
+  layer_name         = aws_lambda_layer_version.test_two.layer_name
+  compatible_runtime = "python3.12"
+}
+`, rName)
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lambda_runtime_management_config", name="Runtime Management Config")
+func resourceRuntimeManagementConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRuntimeManagementConfigPut,
+		ReadWithoutTimeout:   resourceRuntimeManagementConfigRead,
+		UpdateWithoutTimeout: resourceRuntimeManagementConfigPut,
+		DeleteWithoutTimeout: resourceRuntimeManagementConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"function_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"runtime_version_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"update_runtime_on": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.UpdateRuntimeOnAuto,
+				ValidateDiagFunc: enum.Validate[types.UpdateRuntimeOn](),
+			},
+		},
+	}
+}
+
+func resourceRuntimeManagementConfigPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	functionName, qualifier := d.Get("function_name").(string), d.Get("qualifier").(string)
+	id := runtimeManagementConfigCreateResourceID(functionName, qualifier)
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(functionName),
+		UpdateRuntimeOn: types.UpdateRuntimeOn(d.Get("update_runtime_on").(string)),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if v, ok := d.GetOk("runtime_version_arn"); ok {
+		input.RuntimeVersionArn = aws.String(v.(string))
+	}
+
+	_, err := conn.PutRuntimeManagementConfig(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Lambda Runtime Management Config (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceRuntimeManagementConfigRead(ctx, d, meta)...)
+}
+
+func resourceRuntimeManagementConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	functionName, qualifier, err := runtimeManagementConfigParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findRuntimeManagementConfigByTwoPartKey(ctx, conn, functionName, qualifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Runtime Management Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Runtime Management Config (%s): %s", d.Id(), err)
+	}
+
+	d.Set("function_arn", output.FunctionArn)
+	d.Set("function_name", functionName)
+	d.Set("qualifier", qualifier)
+	d.Set("runtime_version_arn", output.RuntimeVersionArn)
+	d.Set("update_runtime_on", output.UpdateRuntimeOn)
+
+	return diags
+}
+
+func resourceRuntimeManagementConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	functionName, qualifier, err := runtimeManagementConfigParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// There is no API to delete a runtime management configuration, so revert to the default.
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(functionName),
+		UpdateRuntimeOn: types.UpdateRuntimeOnAuto,
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Deleting Lambda Runtime Management Config: %s", d.Id())
+	_, err = conn.PutRuntimeManagementConfig(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lambda Runtime Management Config (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const runtimeManagementConfigResourceIDSeparator = ","
+
+func runtimeManagementConfigCreateResourceID(functionName, qualifier string) string {
+	if qualifier == "" {
+		return functionName
+	}
+
+	parts := []string{functionName, qualifier}
+	id := strings.Join(parts, runtimeManagementConfigResourceIDSeparator)
+
+	return id
+}
+
+func runtimeManagementConfigParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, runtimeManagementConfigResourceIDSeparator)
+
+	if len(parts) == 1 && parts[0] != "" {
+		return parts[0], "", nil
+	}
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION-NAME%[2]sQUALIFIER or FUNCTION-NAME", id, runtimeManagementConfigResourceIDSeparator)
+}
+
+func findRuntimeManagementConfigByTwoPartKey(ctx context.Context, conn *lambda.Client, functionName, qualifier string) (*lambda.GetRuntimeManagementConfigOutput, error) {
+	input := &lambda.GetRuntimeManagementConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetRuntimeManagementConfig(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
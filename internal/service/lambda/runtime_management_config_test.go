@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaRuntimeManagementConfig_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_runtime_management_config.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigConfig_basic(rName, "FunctionUpdate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "function_arn", functionResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", functionResourceName, "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", "FunctionUpdate"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuntimeManagementConfigConfig_basic(rName, "Auto"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", "Auto"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaRuntimeManagementConfig_qualifier(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_runtime_management_config.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigConfig_qualifier(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "function_arn", functionResourceName, "qualified_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "qualifier", functionResourceName, "version"),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", "FunctionUpdate"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRuntimeManagementConfigDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_runtime_management_config" {
+				continue
+			}
+
+			functionName, qualifier, err := tflambda.RuntimeManagementConfigParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			output, err := tflambda.FindRuntimeManagementConfigByTwoPartKey(ctx, conn, functionName, qualifier)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if output.UpdateRuntimeOn == types.UpdateRuntimeOnAuto {
+				continue
+			}
+
+			return fmt.Errorf("Lambda Runtime Management Config %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRuntimeManagementConfigExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		functionName, qualifier, err := tflambda.RuntimeManagementConfigParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		_, err = tflambda.FindRuntimeManagementConfigByTwoPartKey(ctx, conn, functionName, qualifier)
+
+		return err
+	}
+}
+
+func testAccRuntimeManagementConfigConfig_basic(rName, updateRuntimeOn string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name     = aws_lambda_function.test.function_name
+  update_runtime_on = %[2]q
+}
+`, rName, updateRuntimeOn))
+}
+
+func testAccRuntimeManagementConfigConfig_qualifier(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+  publish       = true
+}
+
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name     = aws_lambda_function.test.function_name
+  qualifier         = aws_lambda_function.test.version
+  update_runtime_on = "FunctionUpdate"
+}
+`, rName))
+}
@@ -112,10 +112,11 @@ func ResourceContainerServiceDeploymentVersion() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"healthy_threshold": {
-										Type:     schema.TypeInt,
-										Optional: true,
-										ForceNew: true,
-										Default:  2,
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      2,
+										ValidateFunc: validation.IntBetween(2, 10),
 									},
 									"interval_seconds": {
 										Type:         schema.TypeInt,
@@ -144,10 +145,11 @@ func ResourceContainerServiceDeploymentVersion() *schema.Resource {
 										ValidateFunc: validation.IntBetween(2, 60),
 									},
 									"unhealthy_threshold": {
-										Type:     schema.TypeInt,
-										Optional: true,
-										ForceNew: true,
-										Default:  2,
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      2,
+										ValidateFunc: validation.IntBetween(2, 10),
 									},
 								},
 							},
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_lightsail_container_service_deployment_version")
+func DataSourceContainerServiceDeploymentVersion() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceContainerServiceDeploymentVersionRead,
+
+		Schema: map[string]*schema.Schema{
+			"container": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"container_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"image": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ports": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_endpoint": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"container_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"health_check": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"healthy_threshold": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"interval_seconds": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"success_codes": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"timeout_seconds": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"unhealthy_threshold": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceContainerServiceDeploymentVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LightsailClient(ctx)
+	serviceName := d.Get("service_name").(string)
+
+	var version int
+
+	if v, ok := d.GetOk("version"); ok {
+		version = v.(int)
+	} else {
+		// Default to the deployment the container service is currently running.
+		cs, err := FindContainerServiceByName(ctx, conn, serviceName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lightsail Container Service (%s): %s", serviceName, err)
+		}
+
+		if cs.CurrentDeployment == nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lightsail Container Service (%s): no current deployment", serviceName)
+		}
+
+		version = int(aws.ToInt32(cs.CurrentDeployment.Version))
+	}
+
+	deployment, err := FindContainerServiceDeploymentByVersion(ctx, conn, serviceName, version)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lightsail Container Service (%s) Deployment Version (%d): %s", serviceName, version, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", serviceName, version))
+	d.Set("created_at", aws.ToTime(deployment.CreatedAt).Format(time.RFC3339))
+	d.Set("service_name", serviceName)
+	d.Set("state", deployment.State)
+	d.Set("version", deployment.Version)
+
+	if err := d.Set("container", flattenContainerServiceDeploymentContainers(deployment.Containers)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting container: %s", err)
+	}
+
+	if err := d.Set("public_endpoint", flattenContainerServiceDeploymentPublicEndpoint(deployment.PublicEndpoint)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting public_endpoint: %s", err)
+	}
+
+	return diags
+}
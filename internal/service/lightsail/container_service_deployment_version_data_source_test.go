@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lightsail_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLightsailContainerServiceDeploymentVersionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	containerName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lightsail_container_service_deployment_version.test"
+	resourceName := "aws_lightsail_container_service_deployment_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(lightsail.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerServiceDeploymentVersionDataSourceConfig_basic(rName, containerName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_at", resourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "container.#", resourceName, "container.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "container.0.container_name", containerName),
+					resource.TestCheckResourceAttr(dataSourceName, "container.0.image", helloWorldImage),
+					resource.TestCheckResourceAttrPair(dataSourceName, "public_endpoint.#", resourceName, "public_endpoint.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "public_endpoint.0.health_check.0.path", resourceName, "public_endpoint.0.health_check.0.path"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_name", resourceName, "service_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "state", resourceName, "state"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", resourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContainerServiceDeploymentVersionDataSourceConfig_basic(rName, containerName string) string {
+	return acctest.ConfigCompose(
+		testAccContainerServiceDeploymentVersionConfig_Container_publicEndpoint(rName, containerName),
+		`
+data "aws_lightsail_container_service_deployment_version" "test" {
+  service_name = aws_lightsail_container_service_deployment_version.test.service_name
+}
+`)
+}
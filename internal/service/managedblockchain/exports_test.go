@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain
+
+// Exports for use in tests only.
+var (
+	ResourceAccessor = resourceAccessor
+	ResourceMember   = resourceMember
+	ResourceNode     = resourceNode
+	ResourceProposal = resourceProposal
+
+	FindAccessorByID         = findAccessorByID
+	FindMemberByTwoPartKey   = findMemberByTwoPartKey
+	FindNodeByThreePartKey   = findNodeByThreePartKey
+	FindProposalByTwoPartKey = findProposalByTwoPartKey
+)
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func logConfigurationsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cloudwatch": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enabled": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandLogConfigurations(tfList []interface{}) *managedblockchain.LogConfigurations {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &managedblockchain.LogConfigurations{}
+
+	if v, ok := tfMap["cloudwatch"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Cloudwatch = &managedblockchain.LogConfiguration{}
+
+		if v, ok := tfMap["enabled"].(bool); ok {
+			apiObject.Cloudwatch.Enabled = aws.Bool(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenLogConfigurations(apiObject *managedblockchain.LogConfigurations) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Cloudwatch; v != nil {
+		tfMap["cloudwatch"] = []interface{}{map[string]interface{}{
+			"enabled": aws.BoolValue(v.Enabled),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,493 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_managedblockchain_member", name="Member")
+// @Tags(identifierAttribute="arn")
+func resourceMember() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMemberCreate,
+		ReadWithoutTimeout:   resourceMemberRead,
+		UpdateWithoutTimeout: resourceMemberUpdate,
+		DeleteWithoutTimeout: resourceMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"framework_attributes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fabric": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"admin_username": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ca_endpoint": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"framework_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fabric": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"admin_password": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringLenBetween(8, 32),
+									},
+									"admin_username": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 16),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"invitation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.Any(verify.ValidARN, validation.StringInSlice([]string{"AWS_OWNED_KMS_KEY"}, false)),
+			},
+			"log_publishing_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fabric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ca_logs": logConfigurationsSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"member_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	memberResourceIDPartCount = 2
+)
+
+func resourceMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	networkID := d.Get("network_id").(string)
+	name := d.Get("name").(string)
+	input := &managedblockchain.CreateMemberInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		InvitationId:       aws.String(d.Get("invitation_id").(string)),
+		MemberConfiguration: &managedblockchain.MemberConfiguration{
+			FrameworkConfiguration: expandMemberFrameworkConfiguration(d.Get("framework_configuration").([]interface{})),
+			Name:                   aws.String(name),
+			Tags:                   getTagsIn(ctx),
+		},
+		NetworkId: aws.String(networkID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.MemberConfiguration.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.MemberConfiguration.KmsKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("log_publishing_configuration"); ok {
+		input.MemberConfiguration.LogPublishingConfiguration = expandMemberLogPublishingConfiguration(v.([]interface{}))
+	}
+
+	output, err := conn.CreateMemberWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Managed Blockchain Member (%s): %s", name, err)
+	}
+
+	memberID := aws.StringValue(output.MemberId)
+	id, err := flex.FlattenResourceId([]string{networkID, memberID}, memberResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitMemberAvailable(ctx, conn, networkID, memberID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Managed Blockchain Member (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceMemberRead(ctx, d, meta)...)
+}
+
+func resourceMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), memberResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	networkID, memberID := parts[0], parts[1]
+	member, err := findMemberByTwoPartKey(ctx, conn, networkID, memberID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Managed Blockchain Member (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", member.Arn)
+	d.Set("creation_date", aws.TimeValue(member.CreationDate).Format(time.RFC3339))
+	d.Set("description", member.Description)
+	if err := d.Set("framework_attributes", flattenMemberFrameworkAttributes(member.FrameworkAttributes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting framework_attributes: %s", err)
+	}
+	d.Set("kms_key_arn", member.KmsKeyArn)
+	if err := d.Set("log_publishing_configuration", flattenMemberLogPublishingConfiguration(member.LogPublishingConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting log_publishing_configuration: %s", err)
+	}
+	d.Set("member_id", member.Id)
+	d.Set("name", member.Name)
+	d.Set("network_id", member.NetworkId)
+	d.Set("status", member.Status)
+
+	setTagsOut(ctx, member.Tags)
+
+	return diags
+}
+
+func resourceMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	if d.HasChange("log_publishing_configuration") {
+		parts, err := flex.ExpandResourceId(d.Id(), memberResourceIDPartCount, false)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		networkID, memberID := parts[0], parts[1]
+		input := &managedblockchain.UpdateMemberInput{
+			LogPublishingConfiguration: expandMemberLogPublishingConfiguration(d.Get("log_publishing_configuration").([]interface{})),
+			MemberId:                   aws.String(memberID),
+			NetworkId:                  aws.String(networkID),
+		}
+
+		_, err = conn.UpdateMemberWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Managed Blockchain Member (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitMemberAvailable(ctx, conn, networkID, memberID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Managed Blockchain Member (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMemberRead(ctx, d, meta)...)
+}
+
+func resourceMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), memberResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	networkID, memberID := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting Managed Blockchain Member: %s", d.Id())
+	_, err = conn.DeleteMemberWithContext(ctx, &managedblockchain.DeleteMemberInput{
+		MemberId:  aws.String(memberID),
+		NetworkId: aws.String(networkID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Managed Blockchain Member (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitMemberDeleted(ctx, conn, networkID, memberID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Managed Blockchain Member (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMemberByTwoPartKey(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID string) (*managedblockchain.Member, error) {
+	input := &managedblockchain.GetMemberInput{
+		MemberId:  aws.String(memberID),
+		NetworkId: aws.String(networkID),
+	}
+
+	output, err := conn.GetMemberWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Member == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Member.Status); status == managedblockchain.MemberStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Member, nil
+}
+
+func statusMember(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findMemberByTwoPartKey(ctx, conn, networkID, memberID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitMemberAvailable(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID string, timeout time.Duration) (*managedblockchain.Member, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{managedblockchain.MemberStatusCreating, managedblockchain.MemberStatusUpdating},
+		Target:  []string{managedblockchain.MemberStatusAvailable},
+		Refresh: statusMember(ctx, conn, networkID, memberID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*managedblockchain.Member); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMemberDeleted(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID string, timeout time.Duration) (*managedblockchain.Member, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{managedblockchain.MemberStatusAvailable, managedblockchain.MemberStatusDeleting},
+		Target:  []string{},
+		Refresh: statusMember(ctx, conn, networkID, memberID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*managedblockchain.Member); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandMemberFrameworkConfiguration(tfList []interface{}) *managedblockchain.MemberFrameworkConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &managedblockchain.MemberFrameworkConfiguration{}
+
+	if v, ok := tfMap["fabric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Fabric = &managedblockchain.MemberFabricConfiguration{
+			AdminPassword: aws.String(tfMap["admin_password"].(string)),
+			AdminUsername: aws.String(tfMap["admin_username"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandMemberLogPublishingConfiguration(tfList []interface{}) *managedblockchain.MemberLogPublishingConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &managedblockchain.MemberLogPublishingConfiguration{}
+
+	if v, ok := tfMap["fabric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Fabric = &managedblockchain.MemberFabricLogPublishingConfiguration{
+			CaLogs: expandLogConfigurations(tfMap["ca_logs"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenMemberFrameworkAttributes(apiObject *managedblockchain.MemberFrameworkAttributes) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Fabric; v != nil {
+		tfMap["fabric"] = []interface{}{map[string]interface{}{
+			"admin_username": aws.StringValue(v.AdminUsername),
+			"ca_endpoint":    aws.StringValue(v.CaEndpoint),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMemberLogPublishingConfiguration(apiObject *managedblockchain.MemberLogPublishingConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Fabric; v != nil {
+		tfMap["fabric"] = []interface{}{map[string]interface{}{
+			"ca_logs": flattenLogConfigurations(v.CaLogs),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmanagedblockchain "github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Members can only be created from an invitation to an existing Hyperledger Fabric network.
+func TestAccManagedBlockchainMember_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	networkID := acctest.SkipIfEnvVarNotSet(t, "MANAGEDBLOCKCHAIN_NETWORK_ID")
+	invitationID := acctest.SkipIfEnvVarNotSet(t, "MANAGEDBLOCKCHAIN_INVITATION_ID")
+	var v managedblockchain.Member
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_managedblockchain_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ManagedBlockchainServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMemberDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberConfig_basic(rName, networkID, invitationID, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "framework_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "framework_attributes.0.fabric.0.admin_username", "admin"),
+					resource.TestCheckResourceAttrSet(resourceName, "framework_attributes.0.fabric.0.ca_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_configuration.0.fabric.0.ca_logs.0.cloudwatch.0.enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "member_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "network_id", networkID),
+					resource.TestCheckResourceAttr(resourceName, "status", "AVAILABLE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"framework_configuration", "invitation_id"},
+			},
+			{
+				Config: testAccMemberConfig_basic(rName, networkID, invitationID, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_configuration.0.fabric.0.ca_logs.0.cloudwatch.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMemberExists(ctx context.Context, n string, v *managedblockchain.Member) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+		output, err := tfmanagedblockchain.FindMemberByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckMemberDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_managedblockchain_member" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmanagedblockchain.FindMemberByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Managed Blockchain Member %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccMemberConfig_basic(rName, networkID, invitationID string, caLogsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_managedblockchain_member" "test" {
+  name          = %[1]q
+  network_id    = %[2]q
+  invitation_id = %[3]q
+
+  framework_configuration {
+    fabric {
+      admin_username = "admin"
+      admin_password = "Password123"
+    }
+  }
+
+  log_publishing_configuration {
+    fabric {
+      ca_logs {
+        cloudwatch {
+          enabled = %[4]t
+        }
+      }
+    }
+  }
+}
+`, rName, networkID, invitationID, caLogsEnabled)
+}
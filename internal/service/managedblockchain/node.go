@@ -0,0 +1,494 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_managedblockchain_node", name="Node")
+// @Tags(identifierAttribute="arn")
+func resourceNode() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNodeCreate,
+		ReadWithoutTimeout:   resourceNodeRead,
+		UpdateWithoutTimeout: resourceNodeUpdate,
+		DeleteWithoutTimeout: resourceNodeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"framework_attributes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ethereum": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"http_endpoint": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"web_socket_endpoint": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"fabric": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"peer_endpoint": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"peer_event_endpoint": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"kms_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_publishing_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fabric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"chaincode_logs": logConfigurationsSchema(),
+									"peer_logs":      logConfigurationsSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"member_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_db": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(managedblockchain.StateDBType_Values(), false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	// Nodes on public networks (e.g. Ethereum) don't belong to a member, so the member ID part may be empty.
+	nodeResourceIDPartCount = 3
+)
+
+func resourceNodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	networkID := d.Get("network_id").(string)
+	input := &managedblockchain.CreateNodeInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		NetworkId:          aws.String(networkID),
+		NodeConfiguration: &managedblockchain.NodeConfiguration{
+			InstanceType: aws.String(d.Get("instance_type").(string)),
+		},
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.NodeConfiguration.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("log_publishing_configuration"); ok {
+		input.NodeConfiguration.LogPublishingConfiguration = expandNodeLogPublishingConfiguration(v.([]interface{}))
+	}
+
+	var memberID string
+	if v, ok := d.GetOk("member_id"); ok {
+		memberID = v.(string)
+		input.MemberId = aws.String(memberID)
+	}
+
+	if v, ok := d.GetOk("state_db"); ok {
+		input.NodeConfiguration.StateDB = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateNodeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Managed Blockchain Node (%s): %s", networkID, err)
+	}
+
+	nodeID := aws.StringValue(output.NodeId)
+	id, err := flex.FlattenResourceId([]string{networkID, memberID, nodeID}, nodeResourceIDPartCount, true)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitNodeAvailable(ctx, conn, networkID, memberID, nodeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Managed Blockchain Node (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceNodeRead(ctx, d, meta)...)
+}
+
+func resourceNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), nodeResourceIDPartCount, true)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	networkID, memberID, nodeID := parts[0], parts[1], parts[2]
+	node, err := findNodeByThreePartKey(ctx, conn, networkID, memberID, nodeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Node (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Managed Blockchain Node (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", node.Arn)
+	d.Set("availability_zone", node.AvailabilityZone)
+	d.Set("creation_date", aws.TimeValue(node.CreationDate).Format(time.RFC3339))
+	if err := d.Set("framework_attributes", flattenNodeFrameworkAttributes(node.FrameworkAttributes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting framework_attributes: %s", err)
+	}
+	d.Set("instance_type", node.InstanceType)
+	d.Set("kms_key_arn", node.KmsKeyArn)
+	if err := d.Set("log_publishing_configuration", flattenNodeLogPublishingConfiguration(node.LogPublishingConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting log_publishing_configuration: %s", err)
+	}
+	d.Set("member_id", node.MemberId)
+	d.Set("network_id", node.NetworkId)
+	d.Set("node_id", node.Id)
+	d.Set("state_db", node.StateDB)
+	d.Set("status", node.Status)
+
+	setTagsOut(ctx, node.Tags)
+
+	return diags
+}
+
+func resourceNodeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	if d.HasChange("log_publishing_configuration") {
+		parts, err := flex.ExpandResourceId(d.Id(), nodeResourceIDPartCount, true)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		networkID, memberID, nodeID := parts[0], parts[1], parts[2]
+		input := &managedblockchain.UpdateNodeInput{
+			LogPublishingConfiguration: expandNodeLogPublishingConfiguration(d.Get("log_publishing_configuration").([]interface{})),
+			NetworkId:                  aws.String(networkID),
+			NodeId:                     aws.String(nodeID),
+		}
+
+		if memberID != "" {
+			input.MemberId = aws.String(memberID)
+		}
+
+		_, err = conn.UpdateNodeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Managed Blockchain Node (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitNodeAvailable(ctx, conn, networkID, memberID, nodeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Managed Blockchain Node (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceNodeRead(ctx, d, meta)...)
+}
+
+func resourceNodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), nodeResourceIDPartCount, true)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	networkID, memberID, nodeID := parts[0], parts[1], parts[2]
+	input := &managedblockchain.DeleteNodeInput{
+		NetworkId: aws.String(networkID),
+		NodeId:    aws.String(nodeID),
+	}
+
+	if memberID != "" {
+		input.MemberId = aws.String(memberID)
+	}
+
+	log.Printf("[DEBUG] Deleting Managed Blockchain Node: %s", d.Id())
+	_, err = conn.DeleteNodeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Managed Blockchain Node (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitNodeDeleted(ctx, conn, networkID, memberID, nodeID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Managed Blockchain Node (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findNodeByThreePartKey(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID, nodeID string) (*managedblockchain.Node, error) {
+	input := &managedblockchain.GetNodeInput{
+		NetworkId: aws.String(networkID),
+		NodeId:    aws.String(nodeID),
+	}
+
+	if memberID != "" {
+		input.MemberId = aws.String(memberID)
+	}
+
+	output, err := conn.GetNodeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Node == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Node.Status); status == managedblockchain.NodeStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Node, nil
+}
+
+func statusNode(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID, nodeID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findNodeByThreePartKey(ctx, conn, networkID, memberID, nodeID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitNodeAvailable(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID, nodeID string, timeout time.Duration) (*managedblockchain.Node, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{managedblockchain.NodeStatusCreating, managedblockchain.NodeStatusUpdating},
+		Target:  []string{managedblockchain.NodeStatusAvailable},
+		Refresh: statusNode(ctx, conn, networkID, memberID, nodeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*managedblockchain.Node); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitNodeDeleted(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID, nodeID string, timeout time.Duration) (*managedblockchain.Node, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			managedblockchain.NodeStatusAvailable,
+			managedblockchain.NodeStatusDeleting,
+			managedblockchain.NodeStatusFailed,
+			managedblockchain.NodeStatusUnhealthy,
+		},
+		Target:  []string{},
+		Refresh: statusNode(ctx, conn, networkID, memberID, nodeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*managedblockchain.Node); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandNodeLogPublishingConfiguration(tfList []interface{}) *managedblockchain.NodeLogPublishingConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &managedblockchain.NodeLogPublishingConfiguration{}
+
+	if v, ok := tfMap["fabric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Fabric = &managedblockchain.NodeFabricLogPublishingConfiguration{
+			ChaincodeLogs: expandLogConfigurations(tfMap["chaincode_logs"].([]interface{})),
+			PeerLogs:      expandLogConfigurations(tfMap["peer_logs"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenNodeFrameworkAttributes(apiObject *managedblockchain.NodeFrameworkAttributes) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Ethereum; v != nil {
+		tfMap["ethereum"] = []interface{}{map[string]interface{}{
+			"http_endpoint":       aws.StringValue(v.HttpEndpoint),
+			"web_socket_endpoint": aws.StringValue(v.WebSocketEndpoint),
+		}}
+	}
+
+	if v := apiObject.Fabric; v != nil {
+		tfMap["fabric"] = []interface{}{map[string]interface{}{
+			"peer_endpoint":       aws.StringValue(v.PeerEndpoint),
+			"peer_event_endpoint": aws.StringValue(v.PeerEventEndpoint),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNodeLogPublishingConfiguration(apiObject *managedblockchain.NodeLogPublishingConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Fabric; v != nil {
+		tfMap["fabric"] = []interface{}{map[string]interface{}{
+			"chaincode_logs": flattenLogConfigurations(v.ChaincodeLogs),
+			"peer_logs":      flattenLogConfigurations(v.PeerLogs),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
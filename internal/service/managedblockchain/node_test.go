@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmanagedblockchain "github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccManagedBlockchainNode_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v managedblockchain.Node
+	resourceName := "aws_managedblockchain_node.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ManagedBlockchainServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNodeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeConfig_ethereum(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone", "data.aws_availability_zones.available", "names.0"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "framework_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "framework_attributes.0.ethereum.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "framework_attributes.0.ethereum.0.http_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "framework_attributes.0.ethereum.0.web_socket_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "bc.t3.xlarge"),
+					resource.TestCheckResourceAttr(resourceName, "member_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "network_id", "n-ethereum-mainnet"),
+					resource.TestCheckResourceAttrSet(resourceName, "node_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccManagedBlockchainNode_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v managedblockchain.Node
+	resourceName := "aws_managedblockchain_node.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ManagedBlockchainServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNodeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeConfig_ethereum(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmanagedblockchain.ResourceNode(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNodeExists(ctx context.Context, n string, v *managedblockchain.Node) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, true)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+		output, err := tfmanagedblockchain.FindNodeByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckNodeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_managedblockchain_node" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, true)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmanagedblockchain.FindNodeByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Managed Blockchain Node %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNodeConfig_ethereum() string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), `
+resource "aws_managedblockchain_node" "test" {
+  network_id        = "n-ethereum-mainnet"
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_type     = "bc.t3.xlarge"
+}
+`)
+}
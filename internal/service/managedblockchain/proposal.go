@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_managedblockchain_proposal", name="Proposal")
+// @Tags(identifierAttribute="arn")
+func resourceProposal() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProposalCreate,
+		ReadWithoutTimeout:   resourceProposalRead,
+		UpdateWithoutTimeout: resourceProposalUpdate,
+		DeleteWithoutTimeout: resourceProposalDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"invitations": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"actions.0.invitations", "actions.0.removals"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"principal": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+								},
+							},
+						},
+						"removals": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"member_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"no_vote_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"outstanding_vote_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"proposal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"proposed_by_member_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"yes_vote_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	proposalResourceIDPartCount = 2
+)
+
+func resourceProposalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	networkID := d.Get("network_id").(string)
+	input := &managedblockchain.CreateProposalInput{
+		Actions:            expandProposalActions(d.Get("actions").([]interface{})),
+		ClientRequestToken: aws.String(id.UniqueId()),
+		MemberId:           aws.String(d.Get("member_id").(string)),
+		NetworkId:          aws.String(networkID),
+		Tags:               getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateProposalWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Managed Blockchain Proposal (%s): %s", networkID, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{networkID, aws.StringValue(output.ProposalId)}, proposalResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceProposalRead(ctx, d, meta)...)
+}
+
+func resourceProposalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), proposalResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	networkID, proposalID := parts[0], parts[1]
+	proposal, err := findProposalByTwoPartKey(ctx, conn, networkID, proposalID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Proposal (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Managed Blockchain Proposal (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("actions", flattenProposalActions(proposal.Actions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting actions: %s", err)
+	}
+	d.Set("arn", proposal.Arn)
+	d.Set("creation_date", aws.TimeValue(proposal.CreationDate).Format(time.RFC3339))
+	d.Set("description", proposal.Description)
+	d.Set("expiration_date", aws.TimeValue(proposal.ExpirationDate).Format(time.RFC3339))
+	d.Set("member_id", proposal.ProposedByMemberId)
+	d.Set("network_id", proposal.NetworkId)
+	d.Set("no_vote_count", proposal.NoVoteCount)
+	d.Set("outstanding_vote_count", proposal.OutstandingVoteCount)
+	d.Set("proposal_id", proposal.ProposalId)
+	d.Set("proposed_by_member_name", proposal.ProposedByMemberName)
+	d.Set("status", proposal.Status)
+	d.Set("yes_vote_count", proposal.YesVoteCount)
+
+	setTagsOut(ctx, proposal.Tags)
+
+	return diags
+}
+
+func resourceProposalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceProposalRead(ctx, d, meta)...)
+}
+
+func resourceProposalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Managed Blockchain Proposal (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func findProposalByTwoPartKey(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, proposalID string) (*managedblockchain.Proposal, error) {
+	input := &managedblockchain.GetProposalInput{
+		NetworkId:  aws.String(networkID),
+		ProposalId: aws.String(proposalID),
+	}
+
+	output, err := conn.GetProposalWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Proposal == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Proposal, nil
+}
+
+func expandProposalActions(tfList []interface{}) *managedblockchain.ProposalActions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &managedblockchain.ProposalActions{}
+
+	if v, ok := tfMap["invitations"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Invitations = append(apiObject.Invitations, &managedblockchain.InviteAction{
+				Principal: aws.String(tfMap["principal"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["removals"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Removals = append(apiObject.Removals, &managedblockchain.RemoveAction{
+				MemberId: aws.String(tfMap["member_id"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenProposalActions(apiObject *managedblockchain.ProposalActions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var invitations, removals []interface{}
+
+	for _, v := range apiObject.Invitations {
+		invitations = append(invitations, map[string]interface{}{
+			"principal": aws.StringValue(v.Principal),
+		})
+	}
+
+	for _, v := range apiObject.Removals {
+		removals = append(removals, map[string]interface{}{
+			"member_id": aws.StringValue(v.MemberId),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"invitations": invitations,
+		"removals":    removals,
+	}}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmanagedblockchain "github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Proposals can only be created by a member of an existing Hyperledger Fabric network.
+func TestAccManagedBlockchainProposal_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	networkID := acctest.SkipIfEnvVarNotSet(t, "MANAGEDBLOCKCHAIN_NETWORK_ID")
+	memberID := acctest.SkipIfEnvVarNotSet(t, "MANAGEDBLOCKCHAIN_MEMBER_ID")
+	var v managedblockchain.Proposal
+	resourceName := "aws_managedblockchain_proposal.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ManagedBlockchainServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		// Proposals cannot be deleted.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProposalConfig_basic(networkID, memberID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProposalExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.invitations.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "actions.0.invitations.0.principal", "data.aws_caller_identity.invitee", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.removals.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", "invite"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttr(resourceName, "member_id", memberID),
+					resource.TestCheckResourceAttr(resourceName, "network_id", networkID),
+					resource.TestCheckResourceAttrSet(resourceName, "proposal_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "proposed_by_member_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProposalExists(ctx context.Context, n string, v *managedblockchain.Proposal) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+		output, err := tfmanagedblockchain.FindProposalByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccProposalConfig_basic(networkID, memberID string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "invitee" {
+  provider = "awsalternate"
+}
+
+resource "aws_managedblockchain_proposal" "test" {
+  network_id  = %[1]q
+  member_id   = %[2]q
+  description = "invite"
+
+  actions {
+    invitations {
+      principal = data.aws_caller_identity.invitee.account_id
+    }
+  }
+}
+`, networkID, memberID))
+}
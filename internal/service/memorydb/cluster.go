@@ -10,15 +10,18 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/memorydb"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/memorydb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/memorydb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -46,7 +49,10 @@ func ResourceCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(clusterDeletedTimeout),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			customizeDiffClusterMultiRegionTLS,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"acl_name": {
@@ -75,6 +81,12 @@ func ResourceCluster() *schema.Resource {
 				Optional: true,
 				Default:  "Managed by Terraform",
 			},
+			"engine": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ClusterEngine_Values(), false),
+			},
 			"engine_patch_version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -105,6 +117,11 @@ func ResourceCluster() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
 			},
+			"multi_region_cluster_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"name": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -274,7 +291,7 @@ func endpointSchema() *schema.Schema {
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	conn := meta.(*conns.AWSClient).MemoryDBConn(ctx)
+	conn := meta.(*conns.AWSClient).MemoryDBClient(ctx)
 
 	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
 	input := &memorydb.CreateClusterInput{
@@ -282,9 +299,9 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
 		ClusterName:             aws.String(name),
 		NodeType:                aws.String(d.Get("node_type").(string)),
-		NumReplicasPerShard:     aws.Int64(int64(d.Get("num_replicas_per_shard").(int))),
-		NumShards:               aws.Int64(int64(d.Get("num_shards").(int))),
-		Tags:                    getTagsIn(ctx),
+		NumReplicasPerShard:     aws.Int32(int32(d.Get("num_replicas_per_shard").(int))),
+		NumShards:               aws.Int32(int32(d.Get("num_shards").(int))),
+		Tags:                    getTagsInV2(ctx),
 		TLSEnabled:              aws.Bool(d.Get("tls_enabled").(bool)),
 	}
 
@@ -296,6 +313,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("engine"); ok {
+		input.Engine = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("engine_version"); ok {
 		input.EngineVersion = aws.String(v.(string))
 	}
@@ -308,21 +329,25 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.MaintenanceWindow = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("multi_region_cluster_name"); ok {
+		input.MultiRegionClusterName = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("parameter_group_name"); ok {
 		input.ParameterGroupName = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("port"); ok {
-		input.Port = aws.Int64(int64(v.(int)))
+		input.Port = aws.Int32(int32(v.(int)))
 	}
 
 	if v, ok := d.GetOk("security_group_ids"); ok {
-		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+		input.SecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
 	if v, ok := d.GetOk("snapshot_arns"); ok && len(v.([]interface{})) > 0 {
 		v := v.([]interface{})
-		input.SnapshotArns = flex.ExpandStringList(v)
+		input.SnapshotArns = flex.ExpandStringValueList(v)
 		log.Printf("[DEBUG] Restoring MemoryDB Cluster (%s) from S3 snapshots %#v", name, v)
 	}
 
@@ -332,7 +357,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	if v, ok := d.GetOk("snapshot_retention_limit"); ok {
-		input.SnapshotRetentionLimit = aws.Int64(int64(v.(int)))
+		input.SnapshotRetentionLimit = aws.Int32(int32(v.(int)))
 	}
 
 	if v, ok := d.GetOk("snapshot_window"); ok {
@@ -347,14 +372,13 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.SubnetGroupName = aws.String(v.(string))
 	}
 
-	log.Printf("[DEBUG] Creating MemoryDB Cluster: %s", input)
-	_, err := conn.CreateClusterWithContext(ctx, input)
+	_, err := conn.CreateCluster(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating MemoryDB Cluster (%s): %s", name, err)
 	}
 
-	if err := waitClusterAvailable(ctx, conn, name, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitClusterAvailable(ctx, conn, name, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Cluster (%s) to be created: %s", name, err)
 	}
 
@@ -366,7 +390,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	conn := meta.(*conns.AWSClient).MemoryDBConn(ctx)
+	conn := meta.(*conns.AWSClient).MemoryDBClient(ctx)
 
 	if d.HasChangesExcept("final_snapshot_name", "tags", "tags_all") {
 		waitParameterGroupInSync := false
@@ -384,6 +408,10 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.Description = aws.String(d.Get("description").(string))
 		}
 
+		if d.HasChange("engine") {
+			input.Engine = aws.String(d.Get("engine").(string))
+		}
+
 		if d.HasChange("engine_version") {
 			input.EngineVersion = aws.String(d.Get("engine_version").(string))
 		}
@@ -397,14 +425,14 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if d.HasChange("num_replicas_per_shard") {
-			input.ReplicaConfiguration = &memorydb.ReplicaConfigurationRequest{
-				ReplicaCount: aws.Int64(int64(d.Get("num_replicas_per_shard").(int))),
+			input.ReplicaConfiguration = &awstypes.ReplicaConfigurationRequest{
+				ReplicaCount: int32(d.Get("num_replicas_per_shard").(int)),
 			}
 		}
 
 		if d.HasChange("num_shards") {
-			input.ShardConfiguration = &memorydb.ShardConfigurationRequest{
-				ShardCount: aws.Int64(int64(d.Get("num_shards").(int))),
+			input.ShardConfiguration = &awstypes.ShardConfigurationRequest{
+				ShardCount: int32(d.Get("num_shards").(int)),
 			}
 		}
 
@@ -424,12 +452,12 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 				return sdkdiag.AppendErrorf(diags, "unable to update MemoryDB Cluster (%s): removing all security groups is not possible", d.Id())
 			}
 
-			input.SecurityGroupIds = flex.ExpandStringSet(v)
+			input.SecurityGroupIds = flex.ExpandStringValueSet(v)
 			waitSecurityGroupsActive = true
 		}
 
 		if d.HasChange("snapshot_retention_limit") {
-			input.SnapshotRetentionLimit = aws.Int64(int64(d.Get("snapshot_retention_limit").(int)))
+			input.SnapshotRetentionLimit = aws.Int32(int32(d.Get("snapshot_retention_limit").(int)))
 		}
 
 		if d.HasChange("snapshot_window") {
@@ -448,25 +476,24 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
-		log.Printf("[DEBUG] Updating MemoryDB Cluster (%s)", d.Id())
+		_, err := conn.UpdateCluster(ctx, input)
 
-		_, err := conn.UpdateClusterWithContext(ctx, input)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating MemoryDB Cluster (%s): %s", d.Id(), err)
 		}
 
-		if err := waitClusterAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitClusterAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Cluster (%s) to be modified: %s", d.Id(), err)
 		}
 
 		if waitParameterGroupInSync {
-			if err := waitClusterParameterGroupInSync(ctx, conn, d.Id()); err != nil {
+			if _, err := waitClusterParameterGroupInSync(ctx, conn, d.Id()); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Cluster (%s) parameter group to be in sync: %s", d.Id(), err)
 			}
 		}
 
 		if waitSecurityGroupsActive {
-			if err := waitClusterSecurityGroupsActive(ctx, conn, d.Id()); err != nil {
+			if _, err := waitClusterSecurityGroupsActive(ctx, conn, d.Id()); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Cluster (%s) security groups to be available: %s", d.Id(), err)
 			}
 		}
@@ -478,9 +505,9 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	conn := meta.(*conns.AWSClient).MemoryDBConn(ctx)
+	conn := meta.(*conns.AWSClient).MemoryDBClient(ctx)
 
-	cluster, err := FindClusterByName(ctx, conn, d.Id())
+	cluster, err := findClusterByName(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] MemoryDB Cluster (%s) not found, removing from state", d.Id())
@@ -501,7 +528,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		d.Set("port", v.Port)
 	}
 
-	if v := aws.StringValue(cluster.DataTiering); v != "" {
+	if v := string(cluster.DataTiering); v != "" {
 		b, err := strconv.ParseBool(v)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "reading data_tiering for MemoryDB Cluster (%s): %s", d.Id(), err)
@@ -511,12 +538,14 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	d.Set("description", cluster.Description)
+	d.Set("engine", cluster.Engine)
 	d.Set("engine_patch_version", cluster.EnginePatchVersion)
 	d.Set("engine_version", cluster.EngineVersion)
 	d.Set("kms_key_arn", cluster.KmsKeyId) // KmsKeyId is actually an ARN here.
 	d.Set("maintenance_window", cluster.MaintenanceWindow)
+	d.Set("multi_region_cluster_name", cluster.MultiRegionClusterName)
 	d.Set("name", cluster.Name)
-	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(cluster.Name)))
+	d.Set("name_prefix", create.NamePrefixFromName(aws.ToString(cluster.Name)))
 	d.Set("node_type", cluster.NodeType)
 
 	numReplicasPerShard, err := deriveClusterNumReplicasPerShard(cluster)
@@ -528,11 +557,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("num_shards", cluster.NumberOfShards)
 	d.Set("parameter_group_name", cluster.ParameterGroupName)
 
-	var securityGroupIds []*string
-	for _, v := range cluster.SecurityGroups {
-		securityGroupIds = append(securityGroupIds, v.SecurityGroupId)
-	}
-	d.Set("security_group_ids", flex.FlattenStringSet(securityGroupIds))
+	d.Set("security_group_ids", flattenSecurityGroupIDs(cluster.SecurityGroups))
 
 	if err := d.Set("shards", flattenShards(cluster.Shards)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "failed to set shards for MemoryDB Cluster (%s): %s", d.Id(), err)
@@ -541,7 +566,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("snapshot_retention_limit", cluster.SnapshotRetentionLimit)
 	d.Set("snapshot_window", cluster.SnapshotWindow)
 
-	if aws.StringValue(cluster.SnsTopicStatus) == ClusterSNSTopicStatusActive {
+	if aws.ToString(cluster.SnsTopicStatus) == ClusterSNSTopicStatusActive {
 		d.Set("sns_topic_arn", cluster.SnsTopicArn)
 	} else {
 		d.Set("sns_topic_arn", "")
@@ -556,7 +581,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	conn := meta.(*conns.AWSClient).MemoryDBConn(ctx)
+	conn := meta.(*conns.AWSClient).MemoryDBClient(ctx)
 
 	input := &memorydb.DeleteClusterInput{
 		ClusterName: aws.String(d.Id()),
@@ -567,9 +592,9 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	log.Printf("[DEBUG] Deleting MemoryDB Cluster: (%s)", d.Id())
-	_, err := conn.DeleteClusterWithContext(ctx, input)
+	_, err := conn.DeleteCluster(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeClusterNotFoundFault) {
+	if errs.IsA[*awstypes.ClusterNotFoundFault](err) {
 		return diags
 	}
 
@@ -577,13 +602,181 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "deleting MemoryDB Cluster (%s): %s", d.Id(), err)
 	}
 
-	if err := waitClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Cluster (%s) to be deleted: %s", d.Id(), err)
 	}
 
 	return diags
 }
 
+func customizeDiffClusterMultiRegionTLS(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if v, ok := diff.GetOk("multi_region_cluster_name"); !ok || v.(string) == "" {
+		return nil
+	}
+
+	return validateMultiRegionClusterTLSEnabled(diff.Get("tls_enabled").(bool))
+}
+
+func findClusterByName(ctx context.Context, conn *memorydb.Client, name string) (*awstypes.Cluster, error) {
+	input := &memorydb.DescribeClustersInput{
+		ClusterName:      aws.String(name),
+		ShowShardDetails: aws.Bool(true),
+	}
+
+	output, err := conn.DescribeClusters(ctx, input)
+
+	if errs.IsA[*awstypes.ClusterNotFoundFault](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Clusters)
+}
+
+// statusCluster fetches the MemoryDB Cluster and its status.
+func statusCluster(ctx context.Context, conn *memorydb.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := findClusterByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cluster, aws.ToString(cluster.Status), nil
+	}
+}
+
+// statusClusterParameterGroup fetches the MemoryDB Cluster and its parameter group status.
+func statusClusterParameterGroup(ctx context.Context, conn *memorydb.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := findClusterByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cluster, aws.ToString(cluster.ParameterGroupStatus), nil
+	}
+}
+
+// statusClusterSecurityGroups fetches the MemoryDB Cluster and its security group status.
+func statusClusterSecurityGroups(ctx context.Context, conn *memorydb.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := findClusterByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, sg := range cluster.SecurityGroups {
+			// When at least one security group change is being applied (whether
+			// that be adding or removing an SG), say that we're still in progress.
+
+			if aws.ToString(sg.Status) != ClusterSecurityGroupStatusActive {
+				return cluster, ClusterSecurityGroupStatusModifying, nil
+			}
+		}
+
+		return cluster, ClusterSecurityGroupStatusActive, nil
+	}
+}
+
+// waitClusterAvailable waits for MemoryDB Cluster to reach an active state after modifications.
+func waitClusterAvailable(ctx context.Context, conn *memorydb.Client, name string, timeout time.Duration) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ClusterStatusCreating, ClusterStatusUpdating, ClusterStatusSnapshotting},
+		Target:  []string{ClusterStatusAvailable},
+		Refresh: statusCluster(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitClusterDeleted waits for MemoryDB Cluster to be deleted.
+func waitClusterDeleted(ctx context.Context, conn *memorydb.Client, name string, timeout time.Duration) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ClusterStatusDeleting},
+		Target:  []string{},
+		Refresh: statusCluster(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitClusterParameterGroupInSync waits for MemoryDB Cluster to come in sync
+// with a new parameter group.
+func waitClusterParameterGroupInSync(ctx context.Context, conn *memorydb.Client, name string) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ClusterParameterGroupStatusApplying},
+		Target:  []string{ClusterParameterGroupStatusInSync},
+		Refresh: statusClusterParameterGroup(ctx, conn, name),
+		Timeout: clusterParameterGroupInSyncTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitClusterSecurityGroupsActive waits for MemoryDB Cluster to apply all
+// security group-related changes.
+func waitClusterSecurityGroupsActive(ctx context.Context, conn *memorydb.Client, name string) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ClusterSecurityGroupStatusModifying},
+		Target:  []string{ClusterSecurityGroupStatusActive},
+		Refresh: statusClusterSecurityGroups(ctx, conn, name),
+		Timeout: clusterSecurityGroupsActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func shardHash(v interface{}) int {
 	return create.StringHashcode(v.(map[string]interface{})["name"].(string))
 }
@@ -592,52 +785,54 @@ func nodeHash(v interface{}) int {
 	return create.StringHashcode(v.(map[string]interface{})["name"].(string))
 }
 
-func flattenEndpoint(endpoint *memorydb.Endpoint) []interface{} {
+func flattenEndpoint(endpoint *awstypes.Endpoint) []interface{} {
 	if endpoint == nil {
 		return []interface{}{}
 	}
 
 	m := map[string]interface{}{}
 
-	if v := aws.StringValue(endpoint.Address); v != "" {
+	if v := aws.ToString(endpoint.Address); v != "" {
 		m["address"] = v
 	}
 
-	if v := aws.Int64Value(endpoint.Port); v != 0 {
+	if v := endpoint.Port; v != 0 {
 		m["port"] = v
 	}
 
 	return []interface{}{m}
 }
 
-func flattenShards(shards []*memorydb.Shard) *schema.Set {
+func flattenSecurityGroupIDs(apiObjects []awstypes.SecurityGroupMembership) []string {
+	var securityGroupIDs []string
+
+	for _, v := range apiObjects {
+		securityGroupIDs = append(securityGroupIDs, aws.ToString(v.SecurityGroupId))
+	}
+
+	return securityGroupIDs
+}
+
+func flattenShards(shards []awstypes.Shard) *schema.Set {
 	shardSet := schema.NewSet(shardHash, nil)
 
 	for _, shard := range shards {
-		if shard == nil {
-			continue
-		}
-
 		nodeSet := schema.NewSet(nodeHash, nil)
 
 		for _, node := range shard.Nodes {
-			if node == nil {
-				continue
-			}
-
 			nodeSet.Add(map[string]interface{}{
-				"availability_zone": aws.StringValue(node.AvailabilityZone),
-				"create_time":       aws.TimeValue(node.CreateTime).Format(time.RFC3339),
+				"availability_zone": aws.ToString(node.AvailabilityZone),
+				"create_time":       aws.ToTime(node.CreateTime).Format(time.RFC3339),
 				"endpoint":          flattenEndpoint(node.Endpoint),
-				"name":              aws.StringValue(node.Name),
+				"name":              aws.ToString(node.Name),
 			})
 		}
 
 		shardSet.Add(map[string]interface{}{
-			"name":      aws.StringValue(shard.Name),
-			"num_nodes": int(aws.Int64Value(shard.NumberOfNodes)),
+			"name":      aws.ToString(shard.Name),
+			"num_nodes": int(aws.ToInt32(shard.NumberOfNodes)),
 			"nodes":     nodeSet,
-			"slots":     aws.StringValue(shard.Slots),
+			"slots":     aws.ToString(shard.Slots),
 		})
 	}
 
@@ -649,15 +844,15 @@ func flattenShards(shards []*memorydb.Shard) *schema.Set {
 // assume that it's the same as that of the largest shard.
 //
 // For the sake of caution, this search is limited to stable shards.
-func deriveClusterNumReplicasPerShard(cluster *memorydb.Cluster) (int, error) {
-	var maxNumberOfNodesPerShard int64
+func deriveClusterNumReplicasPerShard(cluster *awstypes.Cluster) (int, error) {
+	var maxNumberOfNodesPerShard int32
 
 	for _, shard := range cluster.Shards {
-		if aws.StringValue(shard.Status) != ClusterShardStatusAvailable {
+		if aws.ToString(shard.Status) != ClusterShardStatusAvailable {
 			continue
 		}
 
-		n := aws.Int64Value(shard.NumberOfNodes)
+		n := aws.ToInt32(shard.NumberOfNodes)
 		if n > maxNumberOfNodesPerShard {
 			maxNumberOfNodesPerShard = n
 		}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -41,10 +42,12 @@ func TestAccMemoryDBCluster_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint.0.port", "6379"),
 					resource.TestCheckResourceAttr(resourceName, "data_tiering", "false"),
 					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "redis"),
 					resource.TestCheckResourceAttrSet(resourceName, "engine_patch_version"),
 					resource.TestCheckResourceAttrSet(resourceName, "engine_version"),
 					resource.TestCheckResourceAttr(resourceName, "kms_key_arn", ""),
 					resource.TestCheckResourceAttrSet(resourceName, "maintenance_window"),
+					resource.TestCheckResourceAttr(resourceName, "multi_region_cluster_name", ""),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "node_type", "db.t4g.small"),
 					resource.TestCheckResourceAttr(resourceName, "num_replicas_per_shard", "1"),
@@ -373,6 +376,7 @@ func TestAccMemoryDBCluster_delete_withFinalSnapshot(t *testing.T) {
 			{
 				Config: testAccClusterConfig_baseNetwork(rName), // empty Config not supported
 				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterNotExistsByName(ctx, rName),
 					testAccCheckSnapshotExistsByName(ctx, rName),
 				),
 			},
@@ -515,6 +519,47 @@ func TestAccMemoryDBCluster_Update_engineVersion(t *testing.T) {
 	})
 }
 
+func TestAccMemoryDBCluster_Update_engine(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MemoryDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_engine(rName, "redis", "7.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "engine", "redis"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "7.1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClusterConfig_engine(rName, "valkey", "7.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "engine", "valkey"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "7.2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccMemoryDBCluster_Update_maintenanceWindow(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -581,6 +626,7 @@ func TestAccMemoryDBCluster_Update_nodeType(t *testing.T) {
 				Config: testAccClusterConfig_nodeType(rName, "db.t4g.medium"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName),
+					testAccCheckClusterAvailable(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "node_type", "db.t4g.medium"),
 				),
 			},
@@ -768,6 +814,7 @@ func TestAccMemoryDBCluster_Update_parameterGroup(t *testing.T) {
 				Config: testAccClusterConfig_parameterGroup(rName, rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName),
+					testAccCheckClusterAvailable(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "parameter_group_name", rName),
 				),
 			},
@@ -815,6 +862,7 @@ func TestAccMemoryDBCluster_Update_securityGroupIds(t *testing.T) {
 				Config: testAccClusterConfig_securityGroups(rName, 2, 2),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName),
+					testAccCheckClusterAvailable(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "2"), // add one
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_ids.*", "aws_security_group.test.0", "id"),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_ids.*", "aws_security_group.test.1", "id"),
@@ -1062,7 +1110,7 @@ func TestAccMemoryDBCluster_Update_tags(t *testing.T) {
 
 func testAccCheckClusterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn(ctx)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBClient(ctx)
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_memorydb_cluster" {
@@ -1097,7 +1145,7 @@ func testAccCheckClusterExists(ctx context.Context, n string) resource.TestCheck
 			return fmt.Errorf("No MemoryDB Cluster ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn(ctx)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBClient(ctx)
 
 		_, err := tfmemorydb.FindClusterByName(ctx, conn, rs.Primary.Attributes["name"])
 
@@ -1105,6 +1153,59 @@ func testAccCheckClusterExists(ctx context.Context, n string) resource.TestCheck
 	}
 }
 
+// testAccCheckClusterAvailable verifies that an update has finished applying before Terraform returned.
+func testAccCheckClusterAvailable(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBClient(ctx)
+
+		cluster, err := tfmemorydb.FindClusterByName(ctx, conn, rs.Primary.Attributes["name"])
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(cluster.Status), tfmemorydb.ClusterStatusAvailable; got != want {
+			return fmt.Errorf("MemoryDB Cluster %s status = %s, want %s", rs.Primary.ID, got, want)
+		}
+
+		if got, want := aws.ToString(cluster.ParameterGroupStatus), tfmemorydb.ClusterParameterGroupStatusInSync; got != want {
+			return fmt.Errorf("MemoryDB Cluster %s parameter group status = %s, want %s", rs.Primary.ID, got, want)
+		}
+
+		for _, sg := range cluster.SecurityGroups {
+			if got, want := aws.ToString(sg.Status), tfmemorydb.ClusterSecurityGroupStatusActive; got != want {
+				return fmt.Errorf("MemoryDB Cluster %s security group %s status = %s, want %s", rs.Primary.ID, aws.ToString(sg.SecurityGroupId), got, want)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckClusterNotExistsByName verifies that a delete has finished before Terraform returned.
+func testAccCheckClusterNotExistsByName(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBClient(ctx)
+
+		_, err := tfmemorydb.FindClusterByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MemoryDB Cluster %s still exists", name)
+	}
+}
+
 func testAccCheckSnapshotExistsByName(ctx context.Context, snapshotName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn(ctx)
@@ -1319,6 +1420,24 @@ resource "aws_memorydb_cluster" "test" {
 	)
 }
 
+func testAccClusterConfig_engine(rName, engine, engineVersion string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfig_baseNetwork(rName),
+		fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  acl_name               = "open-access"
+  engine                 = %[2]q
+  engine_version         = %[3]q
+  name                   = %[1]q
+  node_type              = "db.t4g.small"
+  num_replicas_per_shard = 0
+  num_shards             = 1
+  subnet_group_name      = aws_memorydb_subnet_group.test.id
+}
+`, rName, engine, engineVersion),
+	)
+}
+
 func testAccClusterConfig_finalSnapshotName(rName, finalSnapshotName string) string {
 	return acctest.ConfigCompose(
 		testAccClusterConfig_baseNetwork(rName),
@@ -42,6 +42,18 @@ func ClusterStatus_Values() []string {
 	}
 }
 
+const (
+	ClusterEngineRedis  = "redis"
+	ClusterEngineValkey = "valkey"
+)
+
+func ClusterEngine_Values() []string {
+	return []string{
+		ClusterEngineRedis,
+		ClusterEngineValkey,
+	}
+}
+
 const (
 	ClusterParameterGroupStatusApplying = "applying"
 	ClusterParameterGroupStatusInSync   = "in-sync"
@@ -0,0 +1,475 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package memorydb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/memorydb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/memorydb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Multi-Region Cluster")
+// @Tags(identifierAttribute="arn")
+func newMultiRegionClusterResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &multiRegionClusterResource{}
+
+	r.SetDefaultCreateTimeout(clusterAvailableTimeout)
+	r.SetDefaultUpdateTimeout(clusterAvailableTimeout)
+	r.SetDefaultDeleteTimeout(clusterDeletedTimeout)
+
+	return r, nil
+}
+
+type multiRegionClusterResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *multiRegionClusterResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_memorydb_multi_region_cluster"
+}
+
+func (r *multiRegionClusterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"engine": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ClusterEngine_Values()...),
+				},
+			},
+			"engine_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"multi_region_cluster_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"multi_region_cluster_name_suffix": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"multi_region_parameter_group_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node_type": schema.StringAttribute{
+				Required: true,
+			},
+			"num_shards": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"tls_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"update_strategy": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UpdateStrategy](),
+				Optional:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *multiRegionClusterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data multiRegionClusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MemoryDBClient(ctx)
+
+	input := &memorydb.CreateMultiRegionClusterInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsInV2(ctx)
+
+	output, err := conn.CreateMultiRegionCluster(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating MemoryDB Multi-Region Cluster (%s)", data.MultiRegionClusterNameSuffix.ValueString()), err))
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.MultiRegionClusterName = fwflex.StringToFramework(ctx, output.MultiRegionCluster.MultiRegionClusterName)
+	data.setID()
+
+	cluster, err := waitMultiRegionClusterAvailable(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for MemoryDB Multi-Region Cluster (%s) create", data.ID.ValueString()), err))
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, cluster)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *multiRegionClusterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data multiRegionClusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic("parsing resource ID", err))
+
+		return
+	}
+
+	conn := r.Meta().MemoryDBClient(ctx)
+
+	output, err := findMultiRegionClusterByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading MemoryDB Multi-Region Cluster (%s)", data.ID.ValueString()), err))
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *multiRegionClusterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new multiRegionClusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MemoryDBClient(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.EngineVersion.Equal(old.EngineVersion) ||
+		!new.MultiRegionParameterGroupName.Equal(old.MultiRegionParameterGroupName) ||
+		!new.NodeType.Equal(old.NodeType) ||
+		!new.NumShards.Equal(old.NumShards) {
+		input := &memorydb.UpdateMultiRegionClusterInput{
+			MultiRegionClusterName: fwflex.StringFromFramework(ctx, new.ID),
+			UpdateStrategy:         new.UpdateStrategy.ValueEnum(),
+		}
+
+		if !new.Description.Equal(old.Description) {
+			input.Description = fwflex.StringFromFramework(ctx, new.Description)
+		}
+
+		if !new.EngineVersion.Equal(old.EngineVersion) {
+			input.EngineVersion = fwflex.StringFromFramework(ctx, new.EngineVersion)
+		}
+
+		if !new.MultiRegionParameterGroupName.Equal(old.MultiRegionParameterGroupName) {
+			input.MultiRegionParameterGroupName = fwflex.StringFromFramework(ctx, new.MultiRegionParameterGroupName)
+		}
+
+		if !new.NodeType.Equal(old.NodeType) {
+			input.NodeType = fwflex.StringFromFramework(ctx, new.NodeType)
+		}
+
+		if !new.NumShards.Equal(old.NumShards) {
+			input.ShardConfiguration = &awstypes.ShardConfigurationRequest{
+				ShardCount: fwflex.Int32ValueFromFramework(ctx, new.NumShards),
+			}
+		}
+
+		_, err := conn.UpdateMultiRegionCluster(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating MemoryDB Multi-Region Cluster (%s)", new.ID.ValueString()), err))
+
+			return
+		}
+
+		cluster, err := waitMultiRegionClusterAvailable(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for MemoryDB Multi-Region Cluster (%s) update", new.ID.ValueString()), err))
+
+			return
+		}
+
+		response.Diagnostics.Append(new.refreshFromOutput(ctx, cluster)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.Status = old.Status
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *multiRegionClusterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data multiRegionClusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MemoryDBClient(ctx)
+
+	_, err := conn.DeleteMultiRegionCluster(ctx, &memorydb.DeleteMultiRegionClusterInput{
+		MultiRegionClusterName: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.MultiRegionClusterNotFoundFault](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting MemoryDB Multi-Region Cluster (%s)", data.ID.ValueString()), err))
+
+		return
+	}
+
+	if _, err := waitMultiRegionClusterDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for MemoryDB Multi-Region Cluster (%s) delete", data.ID.ValueString()), err))
+
+		return
+	}
+}
+
+func (r *multiRegionClusterResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data multiRegionClusterResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.TLSEnabled.IsNull() || data.TLSEnabled.IsUnknown() {
+		return
+	}
+
+	if err := validateMultiRegionClusterTLSEnabled(data.TLSEnabled.ValueBool()); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("tls_enabled"), "Invalid Attribute Configuration", err.Error())
+	}
+}
+
+func (r *multiRegionClusterResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findMultiRegionClusterByName(ctx context.Context, conn *memorydb.Client, name string) (*awstypes.MultiRegionCluster, error) {
+	input := &memorydb.DescribeMultiRegionClustersInput{
+		MultiRegionClusterName: aws.String(name),
+		ShowClusterDetails:     aws.Bool(true),
+	}
+
+	output, err := conn.DescribeMultiRegionClusters(ctx, input)
+
+	if errs.IsA[*awstypes.MultiRegionClusterNotFoundFault](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.MultiRegionClusters)
+}
+
+func statusMultiRegionCluster(ctx context.Context, conn *memorydb.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findMultiRegionClusterByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitMultiRegionClusterAvailable(ctx context.Context, conn *memorydb.Client, name string, timeout time.Duration) (*awstypes.MultiRegionCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ClusterStatusCreating, ClusterStatusUpdating},
+		Target:  []string{ClusterStatusAvailable},
+		Refresh: statusMultiRegionCluster(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.MultiRegionCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMultiRegionClusterDeleted(ctx context.Context, conn *memorydb.Client, name string, timeout time.Duration) (*awstypes.MultiRegionCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ClusterStatusDeleting},
+		Target:  []string{},
+		Refresh: statusMultiRegionCluster(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.MultiRegionCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type multiRegionClusterResourceModel struct {
+	ARN                           types.String                                `tfsdk:"arn"`
+	Description                   types.String                                `tfsdk:"description"`
+	Engine                        types.String                                `tfsdk:"engine"`
+	EngineVersion                 types.String                                `tfsdk:"engine_version"`
+	ID                            types.String                                `tfsdk:"id"`
+	MultiRegionClusterName        types.String                                `tfsdk:"multi_region_cluster_name"`
+	MultiRegionClusterNameSuffix  types.String                                `tfsdk:"multi_region_cluster_name_suffix"`
+	MultiRegionParameterGroupName types.String                                `tfsdk:"multi_region_parameter_group_name"`
+	NodeType                      types.String                                `tfsdk:"node_type"`
+	NumShards                     types.Int64                                 `tfsdk:"num_shards"`
+	Status                        types.String                                `tfsdk:"status"`
+	Tags                          types.Map                                   `tfsdk:"tags"`
+	TagsAll                       types.Map                                   `tfsdk:"tags_all"`
+	Timeouts                      timeouts.Value                              `tfsdk:"timeouts"`
+	TLSEnabled                    types.Bool                                  `tfsdk:"tls_enabled"`
+	UpdateStrategy                fwtypes.StringEnum[awstypes.UpdateStrategy] `tfsdk:"update_strategy"`
+}
+
+func (data *multiRegionClusterResourceModel) InitFromID() error {
+	data.MultiRegionClusterName = data.ID
+
+	return nil
+}
+
+func (data *multiRegionClusterResourceModel) setID() {
+	data.ID = data.MultiRegionClusterName
+}
+
+func (data *multiRegionClusterResourceModel) refreshFromOutput(ctx context.Context, apiObject *awstypes.MultiRegionCluster) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	updateStrategy := data.UpdateStrategy
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Different field name on Describe.
+	data.NumShards = fwflex.Int32ToFramework(ctx, apiObject.NumberOfShards)
+	// Not returned by the API.
+	data.UpdateStrategy = updateStrategy
+	// The API prepends a generated prefix to the suffix, e.g. "virxk-<suffix>".
+	if data.MultiRegionClusterNameSuffix.IsNull() {
+		if _, suffix, ok := strings.Cut(data.MultiRegionClusterName.ValueString(), "-"); ok {
+			data.MultiRegionClusterNameSuffix = types.StringValue(suffix)
+		}
+	}
+
+	return diags
+}
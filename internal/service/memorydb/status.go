@@ -29,66 +29,6 @@ func statusACL(ctx context.Context, conn *memorydb.MemoryDB, aclName string) ret
 	}
 }
 
-// statusCluster fetches the MemoryDB Cluster and its status.
-func statusCluster(ctx context.Context, conn *memorydb.MemoryDB, clusterName string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		cluster, err := FindClusterByName(ctx, conn, clusterName)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return cluster, aws.StringValue(cluster.Status), nil
-	}
-}
-
-// statusClusterParameterGroup fetches the MemoryDB Cluster and its parameter group status.
-func statusClusterParameterGroup(ctx context.Context, conn *memorydb.MemoryDB, clusterName string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		cluster, err := FindClusterByName(ctx, conn, clusterName)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return cluster, aws.StringValue(cluster.ParameterGroupStatus), nil
-	}
-}
-
-// statusClusterSecurityGroups fetches the MemoryDB Cluster and its security group status.
-func statusClusterSecurityGroups(ctx context.Context, conn *memorydb.MemoryDB, clusterName string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		cluster, err := FindClusterByName(ctx, conn, clusterName)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		for _, sg := range cluster.SecurityGroups {
-			// When at least one security group change is being applied (whether
-			// that be adding or removing an SG), say that we're still in progress.
-
-			if aws.StringValue(sg.Status) != ClusterSecurityGroupStatusActive {
-				return cluster, ClusterSecurityGroupStatusModifying, nil
-			}
-		}
-
-		return cluster, ClusterSecurityGroupStatusActive, nil
-	}
-}
-
 // statusSnapshot fetches the MemoryDB Snapshot and its status.
 func statusSnapshot(ctx context.Context, conn *memorydb.MemoryDB, snapshotName string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -4,6 +4,8 @@
 package memorydb
 
 import (
+	"errors"
+
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -45,3 +47,15 @@ func validateResourceNamePrefix(maxLength int) schema.SchemaValidateFunc {
 			"Only lowercase alphanumeric characters and hyphens are allowed."),
 	)
 }
+
+// validateMultiRegionClusterTLSEnabled returns an error if TLS is disabled.
+//
+// Multi-Region clusters, and the regional clusters that are members of them,
+// require in-transit encryption.
+func validateMultiRegionClusterTLSEnabled(tlsEnabled bool) error {
+	if !tlsEnabled {
+		return errors.New("tls_enabled must be true for MemoryDB Multi-Region clusters")
+	}
+
+	return nil
+}
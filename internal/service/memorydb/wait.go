@@ -57,64 +57,6 @@ func waitACLDeleted(ctx context.Context, conn *memorydb.MemoryDB, aclId string)
 	return err
 }
 
-// waitClusterAvailable waits for MemoryDB Cluster to reach an active state after modifications.
-func waitClusterAvailable(ctx context.Context, conn *memorydb.MemoryDB, clusterId string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{ClusterStatusCreating, ClusterStatusUpdating, ClusterStatusSnapshotting},
-		Target:  []string{ClusterStatusAvailable},
-		Refresh: statusCluster(ctx, conn, clusterId),
-		Timeout: timeout,
-	}
-
-	_, err := stateConf.WaitForStateContext(ctx)
-
-	return err
-}
-
-// waitClusterDeleted waits for MemoryDB Cluster to be deleted.
-func waitClusterDeleted(ctx context.Context, conn *memorydb.MemoryDB, clusterId string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{ClusterStatusDeleting},
-		Target:  []string{},
-		Refresh: statusCluster(ctx, conn, clusterId),
-		Timeout: timeout,
-	}
-
-	_, err := stateConf.WaitForStateContext(ctx)
-
-	return err
-}
-
-// waitClusterParameterGroupInSync waits for MemoryDB Cluster to come in sync
-// with a new parameter group.
-func waitClusterParameterGroupInSync(ctx context.Context, conn *memorydb.MemoryDB, clusterId string) error {
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{ClusterParameterGroupStatusApplying},
-		Target:  []string{ClusterParameterGroupStatusInSync},
-		Refresh: statusClusterParameterGroup(ctx, conn, clusterId),
-		Timeout: clusterParameterGroupInSyncTimeout,
-	}
-
-	_, err := stateConf.WaitForStateContext(ctx)
-
-	return err
-}
-
-// waitClusterSecurityGroupsActive waits for MemoryDB Cluster to apply all
-// security group-related changes.
-func waitClusterSecurityGroupsActive(ctx context.Context, conn *memorydb.MemoryDB, clusterId string) error {
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{ClusterSecurityGroupStatusModifying},
-		Target:  []string{ClusterSecurityGroupStatusActive},
-		Refresh: statusClusterSecurityGroups(ctx, conn, clusterId),
-		Timeout: clusterSecurityGroupsActiveTimeout,
-	}
-
-	_, err := stateConf.WaitForStateContext(ctx)
-
-	return err
-}
-
 // waitUserActive waits for MemoryDB user to reach an active state after modifications.
 func waitUserActive(ctx context.Context, conn *memorydb.MemoryDB, userId string) error {
 	stateConf := &retry.StateChangeConf{
@@ -0,0 +1,373 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/neptunegraph/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Import Task")
+func newImportTaskResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &importTaskResource{}
+
+	r.SetDefaultCreateTimeout(120 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type importTaskResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[importTaskResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *importTaskResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_neptunegraph_import_task"
+}
+
+func (r *importTaskResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"blank_node_handling": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BlankNodeHandling](),
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"format": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Format](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"parquet_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ParquetType](),
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *importTaskResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data importTaskResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NeptuneGraphClient(ctx)
+
+	input := &neptunegraph.StartImportTaskInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.StartImportTask(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating Neptune Analytics Import Task (%s)", data.GraphIdentifier.ValueString()), err))
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.TaskId)
+
+	task, err := waitImportTaskSucceeded(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Neptune Analytics Import Task (%s) create", data.ID.ValueString()), err))
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, task)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *importTaskResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data importTaskResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NeptuneGraphClient(ctx)
+
+	output, err := findImportTaskByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Neptune Analytics Import Task (%s)", data.ID.ValueString()), err))
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *importTaskResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data importTaskResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NeptuneGraphClient(ctx)
+
+	output, err := findImportTaskByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading Neptune Analytics Import Task (%s)", data.ID.ValueString()), err))
+
+		return
+	}
+
+	// Completed import tasks cannot be deleted; only in-progress tasks are cancelled.
+	switch output.Status {
+	case awstypes.ImportTaskStatusSucceeded, awstypes.ImportTaskStatusFailed, awstypes.ImportTaskStatusCancelled:
+		return
+	}
+
+	_, err = conn.CancelImportTask(ctx, &neptunegraph.CancelImportTaskInput{
+		TaskIdentifier: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("cancelling Neptune Analytics Import Task (%s)", data.ID.ValueString()), err))
+
+		return
+	}
+
+	if _, err := waitImportTaskCancelled(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for Neptune Analytics Import Task (%s) cancel", data.ID.ValueString()), err))
+
+		return
+	}
+}
+
+func findImportTaskByID(ctx context.Context, conn *neptunegraph.Client, id string) (*neptunegraph.GetImportTaskOutput, error) {
+	input := &neptunegraph.GetImportTaskInput{
+		TaskIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetImportTask(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Status; status == awstypes.ImportTaskStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusImportTask(ctx context.Context, conn *neptunegraph.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findImportTaskByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitImportTaskSucceeded(ctx context.Context, conn *neptunegraph.Client, id string, timeout time.Duration) (*neptunegraph.GetImportTaskOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.ImportTaskStatusInitializing,
+			awstypes.ImportTaskStatusExporting,
+			awstypes.ImportTaskStatusAnalyzingData,
+			awstypes.ImportTaskStatusImporting,
+			awstypes.ImportTaskStatusReprovisioning,
+			awstypes.ImportTaskStatusRollingBack,
+		),
+		Target:  enum.Slice(awstypes.ImportTaskStatusSucceeded),
+		Refresh: statusImportTask(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptunegraph.GetImportTaskOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitImportTaskCancelled(ctx context.Context, conn *neptunegraph.Client, id string, timeout time.Duration) (*neptunegraph.GetImportTaskOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ImportTaskStatusCancelling),
+		Target:  enum.Slice(awstypes.ImportTaskStatusCancelled),
+		Refresh: statusImportTask(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptunegraph.GetImportTaskOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type importTaskResourceModel struct {
+	BlankNodeHandling fwtypes.StringEnum[awstypes.BlankNodeHandling] `tfsdk:"blank_node_handling"`
+	FailOnError       types.Bool                                     `tfsdk:"fail_on_error"`
+	Format            fwtypes.StringEnum[awstypes.Format]            `tfsdk:"format"`
+	GraphIdentifier   types.String                                   `tfsdk:"graph_identifier"`
+	ID                types.String                                   `tfsdk:"id"`
+	ParquetType       fwtypes.StringEnum[awstypes.ParquetType]       `tfsdk:"parquet_type"`
+	RoleARN           fwtypes.ARN                                    `tfsdk:"role_arn"`
+	Source            types.String                                   `tfsdk:"source"`
+	Status            types.String                                   `tfsdk:"status"`
+	Timeouts          timeouts.Value                                 `tfsdk:"timeouts"`
+}
+
+func (data *importTaskResourceModel) refreshFromOutput(ctx context.Context, output *neptunegraph.GetImportTaskOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	blankNodeHandling, failOnError := data.BlankNodeHandling, data.FailOnError
+	diags.Append(fwflex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Different field name on Get.
+	if data.GraphIdentifier.IsNull() {
+		data.GraphIdentifier = fwflex.StringToFramework(ctx, output.GraphId)
+	}
+	// Not returned by the API.
+	data.BlankNodeHandling = blankNodeHandling
+	if failOnError.IsNull() || failOnError.IsUnknown() {
+		failOnError = types.BoolValue(true)
+	}
+	data.FailOnError = failOnError
+
+	return diags
+}
@@ -74,6 +74,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceTLSInspectionConfiguration,
+			TypeName: "aws_networkfirewall_tls_inspection_configuration",
+			Name:     "TLS Inspection Configuration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 	}
 }
 
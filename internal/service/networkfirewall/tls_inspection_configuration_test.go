@@ -0,0 +1,429 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnetworkfirewall "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, acctest.RandomDomain().String())
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+	certificateResourceName := "aws_acm_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_basic(rName, certificate, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "network-firewall", regexache.MustCompile(`tls-configuration/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "certificates.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificates.0.certificate_arn", certificateResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "number_of_associations", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_port.*", map[string]string{
+						"from_port": "443",
+						"to_port":   "443",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.protocols.*", "6"),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.server_certificate.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.server_certificate.0.resource_arn", certificateResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "tls_inspection_configuration_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "update_token"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, acctest.RandomDomain().String())
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_basic(rName, certificate, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfnetworkfirewall.ResourceTLSInspectionConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, acctest.RandomDomain().String())
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_tags1(rName, certificate, key, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTLSInspectionConfigurationConfig_tags2(rName, certificate, key, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTLSInspectionConfigurationConfig_tags1(rName, certificate, key, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_checkCertificateRevocationStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, key)
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+	certificateResourceName := "aws_acm_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_checkCertificateRevocationStatus(rName, certificate, key, networkfirewall.RevocationCheckActionDrop, networkfirewall.RevocationCheckActionPass),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate_authority.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority.0.certificate_arn", certificateResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.certificate_authority_arn", certificateResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.check_certificate_revocation_status.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.check_certificate_revocation_status.0.revoked_status_action", networkfirewall.RevocationCheckActionDrop),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.check_certificate_revocation_status.0.unknown_status_action", networkfirewall.RevocationCheckActionPass),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTLSInspectionConfigurationConfig_checkCertificateRevocationStatus(rName, certificate, key, networkfirewall.RevocationCheckActionReject, networkfirewall.RevocationCheckActionDrop),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.check_certificate_revocation_status.0.revoked_status_action", networkfirewall.RevocationCheckActionReject),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.check_certificate_revocation_status.0.unknown_status_action", networkfirewall.RevocationCheckActionDrop),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_firewallPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, acctest.RandomDomain().String())
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+	policyResourceName := "aws_networkfirewall_firewall_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_firewallPolicy(rName, certificate, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					testAccCheckFirewallPolicyExists(ctx, policyResourceName, &firewallPolicy),
+					resource.TestCheckResourceAttrPair(policyResourceName, "firewall_policy.0.tls_inspection_configuration_arn", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTLSInspectionConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_networkfirewall_tls_inspection_configuration" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+			_, err := tfnetworkfirewall.FindTLSInspectionConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("NetworkFirewall TLS Inspection Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTLSInspectionConfigurationExists(ctx context.Context, n string, v *networkfirewall.DescribeTLSInspectionConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No NetworkFirewall TLS Inspection Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+		output, err := tfnetworkfirewall.FindTLSInspectionConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTLSInspectionConfigurationConfig_baseCertificate(certificate, key string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  certificate_body = "%[1]s"
+  private_key      = "%[2]s"
+}
+`, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(key))
+}
+
+func testAccTLSInspectionConfigurationConfig_basic(rName, certificate, key string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_baseCertificate(certificate, key), fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+
+      scope {
+        protocols = [6]
+
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+
+        destination_port {
+          from_port = 443
+          to_port   = 443
+        }
+
+        source {
+          address_definition = "0.0.0.0/0"
+        }
+
+        source_port {
+          from_port = 0
+          to_port   = 65535
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccTLSInspectionConfigurationConfig_tags1(rName, certificate, key, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_baseCertificate(certificate, key), fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+
+      scope {
+        protocols = [6]
+
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+
+        destination_port {
+          from_port = 443
+          to_port   = 443
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccTLSInspectionConfigurationConfig_tags2(rName, certificate, key, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_baseCertificate(certificate, key), fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+
+      scope {
+        protocols = [6]
+
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+
+        destination_port {
+          from_port = 443
+          to_port   = 443
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccTLSInspectionConfigurationConfig_checkCertificateRevocationStatus(rName, certificate, key, revokedStatusAction, unknownStatusAction string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_baseCertificate(certificate, key), fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name        = %[1]q
+  description = "outbound inspection"
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      certificate_authority_arn = aws_acm_certificate.test.arn
+
+      check_certificate_revocation_status {
+        revoked_status_action = %[2]q
+        unknown_status_action = %[3]q
+      }
+
+      scope {
+        protocols = [6]
+
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+
+        destination_port {
+          from_port = 443
+          to_port   = 443
+        }
+      }
+    }
+  }
+}
+`, rName, revokedStatusAction, unknownStatusAction))
+}
+
+func testAccTLSInspectionConfigurationConfig_firewallPolicy(rName, certificate, key string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_basic(rName, certificate, key), fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+    tls_inspection_configuration_arn   = aws_networkfirewall_tls_inspection_configuration.test.arn
+  }
+}
+`, rName))
+}
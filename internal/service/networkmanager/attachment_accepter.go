@@ -5,6 +5,7 @@ package networkmanager
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -88,90 +90,49 @@ func resourceAttachmentAccepterCreate(ctx context.Context, d *schema.ResourceDat
 
 	conn := meta.(*conns.AWSClient).NetworkManagerConn(ctx)
 
-	var state string
 	attachmentID := d.Get("attachment_id").(string)
 	attachmentType := d.Get("attachment_type").(string)
 
-	switch attachmentType {
-	case networkmanager.AttachmentTypeVpc:
-		vpcAttachment, err := FindVPCAttachmentByID(ctx, conn, attachmentID)
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading Network Manager VPC Attachment (%s): %s", attachmentID, err)
-		}
-
-		state = aws.StringValue(vpcAttachment.Attachment.State)
-
-		d.SetId(attachmentID)
+	// Attachments created in another account are briefly CREATING before they can be accepted.
+	state, err := waitAttachmentAccepterAcceptable(ctx, conn, attachmentType, attachmentID, d.Timeout(schema.TimeoutCreate))
 
-	case networkmanager.AttachmentTypeSiteToSiteVpn:
-		vpnAttachment, err := FindSiteToSiteVPNAttachmentByID(ctx, conn, attachmentID)
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading Network Manager Site To Site VPN Attachment (%s): %s", attachmentID, err)
-		}
-
-		state = aws.StringValue(vpnAttachment.Attachment.State)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Network Manager %s Attachment (%s) create: %s", attachmentType, attachmentID, err)
+	}
 
-		d.SetId(attachmentID)
+	d.SetId(attachmentID)
 
-	case networkmanager.AttachmentTypeConnect:
-		connectAttachment, err := FindConnectAttachmentByID(ctx, conn, attachmentID)
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading Network Manager Connect Attachment (%s): %s", attachmentID, err)
+	if state == networkmanager.AttachmentStatePendingAttachmentAcceptance || state == networkmanager.AttachmentStatePendingTagAcceptance {
+		input := &networkmanager.AcceptAttachmentInput{
+			AttachmentId: aws.String(attachmentID),
 		}
 
-		state = aws.StringValue(connectAttachment.Attachment.State)
-
-		d.SetId(attachmentID)
-
-	case networkmanager.AttachmentTypeTransitGatewayRouteTable:
-		tgwAttachment, err := FindTransitGatewayRouteTableAttachmentByID(ctx, conn, attachmentID)
+		_, err := conn.AcceptAttachmentWithContext(ctx, input)
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading Network Manager Transit Gateway Route Table Attachment (%s): %s", attachmentID, err)
+			return sdkdiag.AppendErrorf(diags, "accepting Network Manager Attachment (%s): %s", attachmentID, err)
 		}
-
-		state = aws.StringValue(tgwAttachment.Attachment.State)
-
-		d.SetId(attachmentID)
-
-	default:
-		return sdkdiag.AppendErrorf(diags, "unsupported Network Manager Attachment type: %s", attachmentType)
 	}
 
-	if state == networkmanager.AttachmentStatePendingAttachmentAcceptance || state == networkmanager.AttachmentStatePendingTagAcceptance {
-		input := &networkmanager.AcceptAttachmentInput{
-			AttachmentId: aws.String(attachmentID),
+	switch attachmentType {
+	case networkmanager.AttachmentTypeVpc:
+		if _, err := waitVPCAttachmentAvailable(ctx, conn, attachmentID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Network Manager VPC Attachment (%s) to be attached: %s", attachmentID, err)
 		}
 
-		_, err := conn.AcceptAttachmentWithContext(ctx, input)
+	case networkmanager.AttachmentTypeSiteToSiteVpn:
+		if _, err := waitSiteToSiteVPNAttachmentAvailable(ctx, conn, attachmentID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Network Manager VPN Attachment (%s) create: %s", attachmentID, err)
+		}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "accepting Network Manager Attachment (%s): %s", attachmentID, err)
+	case networkmanager.AttachmentTypeConnect:
+		if _, err := waitConnectAttachmentAvailable(ctx, conn, attachmentID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Network Manager Connect Attachment (%s) create: %s", attachmentID, err)
 		}
 
-		switch attachmentType {
-		case networkmanager.AttachmentTypeVpc:
-			if _, err := waitVPCAttachmentAvailable(ctx, conn, attachmentID, d.Timeout(schema.TimeoutCreate)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for Network Manager VPC Attachment (%s) to be attached: %s", attachmentID, err)
-			}
-
-		case networkmanager.AttachmentTypeSiteToSiteVpn:
-			if _, err := waitSiteToSiteVPNAttachmentAvailable(ctx, conn, attachmentID, d.Timeout(schema.TimeoutCreate)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for Network Manager VPN Attachment (%s) create: %s", attachmentID, err)
-			}
-
-		case networkmanager.AttachmentTypeConnect:
-			if _, err := waitConnectAttachmentAvailable(ctx, conn, attachmentID, d.Timeout(schema.TimeoutCreate)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for Network Manager Connect Attachment (%s) create: %s", attachmentID, err)
-			}
-
-		case networkmanager.AttachmentTypeTransitGatewayRouteTable:
-			if _, err := waitTransitGatewayRouteTableAttachmentAvailable(ctx, conn, attachmentID, d.Timeout(schema.TimeoutCreate)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for Network Manager Transit Gateway Route Table Attachment (%s) create: %s", attachmentID, err)
-			}
+	case networkmanager.AttachmentTypeTransitGatewayRouteTable:
+		if _, err := waitTransitGatewayRouteTableAttachmentAvailable(ctx, conn, attachmentID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Network Manager Transit Gateway Route Table Attachment (%s) create: %s", attachmentID, err)
 		}
 	}
 
@@ -285,3 +246,44 @@ func resourceAttachmentAccepterDelete(ctx context.Context, d *schema.ResourceDat
 
 	return diags
 }
+
+func statusAttachmentAccepterState(ctx context.Context, conn *networkmanager.NetworkManager, attachmentType, id string) retry.StateRefreshFunc {
+	switch attachmentType {
+	case networkmanager.AttachmentTypeConnect:
+		return statusConnectAttachmentState(ctx, conn, id)
+	case networkmanager.AttachmentTypeSiteToSiteVpn:
+		return statusSiteToSiteVPNAttachmentState(ctx, conn, id)
+	case networkmanager.AttachmentTypeTransitGatewayRouteTable:
+		return statusTransitGatewayRouteTableAttachmentState(ctx, conn, id)
+	case networkmanager.AttachmentTypeVpc:
+		return StatusVPCAttachmentState(ctx, conn, id)
+	}
+
+	return func() (interface{}, string, error) {
+		return nil, "", fmt.Errorf("unsupported Network Manager Attachment type: %s", attachmentType)
+	}
+}
+
+func waitAttachmentAccepterAcceptable(ctx context.Context, conn *networkmanager.NetworkManager, attachmentType, id string, timeout time.Duration) (string, error) {
+	var state string
+	refresh := statusAttachmentAccepterState(ctx, conn, attachmentType, id)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{networkmanager.AttachmentStateCreating},
+		Target: []string{
+			networkmanager.AttachmentStateAvailable,
+			networkmanager.AttachmentStatePendingAttachmentAcceptance,
+			networkmanager.AttachmentStatePendingNetworkUpdate,
+			networkmanager.AttachmentStatePendingTagAcceptance,
+		},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			output, s, err := refresh()
+			state = s
+			return output, s, err
+		},
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return state, err
+}
@@ -5,12 +5,14 @@ package networkmanager_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-testing/config"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -161,6 +163,37 @@ func TestAccNetworkManagerVPCAttachment_Attached_basic(t *testing.T) {
 	}
 }
 
+func TestAccNetworkManagerVPCAttachment_Attached_creating(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_networkmanager_attachment_accepter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var attachmentID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCAttachmentIDDestroy(ctx, &attachmentID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCAttachmentConfig_base(rName, true),
+				// Create the attachment outside of Terraform and don't wait for it, so that the accepter finds it CREATING.
+				Check: testAccCreateVPCAttachment(ctx, &attachmentID),
+			},
+			{
+				Config: testAccVPCAttachmentConfig_Attached_external(rName),
+				ConfigVariables: config.Variables{
+					"attachment_id": testAccLazyStringVariable{value: &attachmentID},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attachment_type", "VPC"),
+					resource.TestCheckResourceAttr(resourceName, "state", networkmanager.AttachmentStateAvailable),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkManagerVPCAttachment_disappears(t *testing.T) {
 	const (
 		resourceName = "aws_networkmanager_vpc_attachment.test"
@@ -594,6 +627,66 @@ func testAccCheckAttachmentRecreated(v1, v2 *networkmanager.Attachment, expectRe
 	return nil
 }
 
+// testAccCreateVPCAttachment creates a VPC attachment for the test VPC and subnets without waiting for it to be created.
+func testAccCreateVPCAttachment(ctx context.Context, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn(ctx)
+
+		resources := s.RootModule().Resources
+		var subnetARNs []string
+		for _, n := range []string{"aws_subnet.test.0", "aws_subnet.test.1"} {
+			rs, ok := resources[n]
+			if !ok {
+				return fmt.Errorf("Not found: %s", n)
+			}
+			subnetARNs = append(subnetARNs, rs.Primary.Attributes["arn"])
+		}
+
+		input := &networkmanager.CreateVpcAttachmentInput{
+			CoreNetworkId: aws.String(resources["aws_networkmanager_core_network_policy_attachment.test"].Primary.Attributes["core_network_id"]),
+			SubnetArns:    aws.StringSlice(subnetARNs),
+			VpcArn:        aws.String(resources["aws_vpc.test"].Primary.Attributes["arn"]),
+		}
+
+		output, err := conn.CreateVpcAttachmentWithContext(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		*id = aws.StringValue(output.VpcAttachment.Attachment.AttachmentId)
+
+		return nil
+	}
+}
+
+func testAccCheckVPCAttachmentIDDestroy(ctx context.Context, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn(ctx)
+
+		_, err := tfnetworkmanager.FindVPCAttachmentByID(ctx, conn, *id)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Network Manager VPC Attachment %s still exists", *id)
+	}
+}
+
+// testAccLazyStringVariable is a string configuration variable whose value is read when the test step runs.
+type testAccLazyStringVariable struct {
+	value *string
+}
+
+func (v testAccLazyStringVariable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(*v.value)
+}
+
 func testAccVPCAttachmentConfig_basic(rName string, requireAcceptance bool) string {
 	return acctest.ConfigCompose(
 		testAccVPCAttachmentConfig_base(rName, requireAcceptance), `
@@ -621,6 +714,20 @@ resource "aws_networkmanager_attachment_accepter" "test" {
 `)
 }
 
+func testAccVPCAttachmentConfig_Attached_external(rName string) string {
+	return acctest.ConfigCompose(
+		testAccVPCAttachmentConfig_base(rName, true), `
+variable "attachment_id" {
+  type = string
+}
+
+resource "aws_networkmanager_attachment_accepter" "test" {
+  attachment_id   = var.attachment_id
+  attachment_type = "VPC"
+}
+`)
+}
+
 func testAccVPCAttachmentConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		testAccVPCAttachmentConfig_base(rName, false),
@@ -344,6 +344,10 @@ func ResourceDomain() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"dashboard_endpoint_v2": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"domain_endpoint_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -381,6 +385,10 @@ func ResourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"domain_endpoint_v2_hosted_zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"domain_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -453,11 +461,21 @@ func ResourceDomain() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"endpoint_v2": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"engine_version": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
+			"ip_address_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(opensearchservice.IPAddressType_Values(), false),
+			},
 			"kibana_endpoint": {
 				Type:       schema.TypeString,
 				Computed:   true,
@@ -640,6 +658,10 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.EngineVersion = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("ip_address_type"); ok {
+		input.IPAddressType = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("access_policies"); ok {
 		policy, err := structure.NormalizeJsonString(v.(string))
 
@@ -872,9 +894,11 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.SetId(aws.StringValue(ds.ARN))
 	d.Set("arn", ds.ARN)
+	d.Set("domain_endpoint_v2_hosted_zone_id", ds.DomainEndpointV2HostedZoneId)
 	d.Set("domain_id", ds.DomainId)
 	d.Set("domain_name", ds.DomainName)
 	d.Set("engine_version", ds.EngineVersion)
+	d.Set("ip_address_type", ds.IPAddressType)
 
 	if err := d.Set("ebs_options", flattenEBSOptions(ds.EBSOptions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting ebs_options: %s", err)
@@ -930,7 +954,9 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 		endpoints := flex.FlattenStringMap(ds.Endpoints)
 		d.Set("endpoint", endpoints["vpc"])
+		d.Set("endpoint_v2", endpoints["vpcv2"])
 		d.Set("dashboard_endpoint", getDashboardEndpoint(d))
+		d.Set("dashboard_endpoint_v2", getDashboardEndpointV2(d))
 		d.Set("kibana_endpoint", getKibanaEndpoint(d))
 		if ds.Endpoint != nil {
 			return sdkdiag.AppendErrorf(diags, "%q: OpenSearch Domain in VPC expected to have null Endpoint value", d.Id())
@@ -941,6 +967,8 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 			d.Set("dashboard_endpoint", getDashboardEndpoint(d))
 			d.Set("kibana_endpoint", getKibanaEndpoint(d))
 		}
+		d.Set("endpoint_v2", ds.EndpointV2)
+		d.Set("dashboard_endpoint_v2", getDashboardEndpointV2(d))
 		if ds.Endpoints != nil {
 			return sdkdiag.AppendErrorf(diags, "%q: OpenSearch Domain not in VPC expected to have null Endpoints value", d.Id())
 		}
@@ -1036,6 +1064,10 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			}
 		}
 
+		if d.HasChange("ip_address_type") {
+			input.IPAddressType = aws.String(d.Get("ip_address_type").(string))
+		}
+
 		if d.HasChange("encrypt_at_rest") {
 			input.EncryptionAtRestOptions = nil
 			if v, ok := d.GetOk("encrypt_at_rest"); ok {
@@ -1091,11 +1123,21 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.VPCOptions = expandVPCOptions(s)
 		}
 
-		_, err := conn.UpdateDomainConfigWithContext(ctx, &input)
+		output, err := conn.UpdateDomainConfigWithContext(ctx, &input)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): %s", d.Id(), err)
 		}
 
+		// Changes such as enabling Multi-AZ with Standby are applied by a blue/green deployment
+		// that can still be in progress after the domain stops reporting Processing.
+		if output.DomainConfig != nil && output.DomainConfig.ChangeProgressDetails != nil {
+			if changeID := aws.StringValue(output.DomainConfig.ChangeProgressDetails.ChangeId); changeID != "" {
+				if _, err := waitDomainChangeProgressCompleted(ctx, conn, d.Get("domain_name").(string), changeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): waiting for change (%s) to complete: %s", d.Id(), changeID, err)
+				}
+			}
+		}
+
 		if err := waitForDomainUpdate(ctx, conn, d.Get("domain_name").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): waiting for completion: %s", d.Id(), err)
 		}
@@ -1198,6 +1240,14 @@ func getDashboardEndpoint(d *schema.ResourceData) string {
 	return d.Get("endpoint").(string) + "/_dashboards"
 }
 
+func getDashboardEndpointV2(d *schema.ResourceData) string {
+	if v := d.Get("endpoint_v2").(string); v != "" {
+		return v + "/_dashboards"
+	}
+
+	return ""
+}
+
 func getKibanaEndpoint(d *schema.ResourceData) string {
 	return d.Get("endpoint").(string) + "/_plugin/kibana/"
 }
@@ -208,10 +208,18 @@ func DataSourceDomain() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"dashboard_endpoint_v2": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"deleted": {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"domain_endpoint_v2_hosted_zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"domain_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -268,10 +276,18 @@ func DataSourceDomain() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"endpoint_v2": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"engine_version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ip_address_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"kibana_endpoint": {
 				Type:       schema.TypeString,
 				Computed:   true,
@@ -446,9 +462,13 @@ func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	d.Set("arn", ds.ARN)
+	d.Set("domain_endpoint_v2_hosted_zone_id", ds.DomainEndpointV2HostedZoneId)
 	d.Set("domain_id", ds.DomainId)
 	d.Set("endpoint", ds.Endpoint)
+	d.Set("endpoint_v2", ds.EndpointV2)
 	d.Set("dashboard_endpoint", getDashboardEndpoint(d))
+	d.Set("dashboard_endpoint_v2", getDashboardEndpointV2(d))
+	d.Set("ip_address_type", ds.IPAddressType)
 	d.Set("kibana_endpoint", getKibanaEndpoint(d))
 
 	if err := d.Set("advanced_security_options", flattenAdvancedSecurityOptions(ds.AdvancedSecurityOptions)); err != nil {
@@ -494,7 +514,9 @@ func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta inte
 		if err := d.Set("endpoint", endpoints["vpc"]); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting endpoint: %s", err)
 		}
+		d.Set("endpoint_v2", endpoints["vpcv2"])
 		d.Set("dashboard_endpoint", getDashboardEndpoint(d))
+		d.Set("dashboard_endpoint_v2", getDashboardEndpointV2(d))
 		d.Set("kibana_endpoint", getKibanaEndpoint(d))
 		if ds.Endpoint != nil {
 			return sdkdiag.AppendErrorf(diags, "%q: OpenSearch domain in VPC expected to have null Endpoint value", d.Id())
@@ -6,6 +6,7 @@ package opensearch
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -39,6 +40,29 @@ func waitUpgradeSucceeded(ctx context.Context, conn *opensearchservice.OpenSearc
 	return nil, err
 }
 
+func waitDomainChangeProgressCompleted(ctx context.Context, conn *opensearchservice.OpenSearchService, name, changeID string, timeout time.Duration) (*opensearchservice.ChangeProgressStatusDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{opensearchservice.OverallChangeStatusPending, opensearchservice.OverallChangeStatusProcessing},
+		Target:     []string{opensearchservice.OverallChangeStatusCompleted},
+		Refresh:    statusDomainChangeProgress(ctx, conn, name, changeID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.ChangeProgressStatusDetails); ok {
+		if aws.StringValue(output.Status) == opensearchservice.OverallChangeStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("pending properties: %s", strings.Join(aws.StringValueSlice(output.PendingProperties), ", ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitForDomainCreation(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName string, timeout time.Duration) error {
 	var out *opensearchservice.DomainStatus
 	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
@@ -120,7 +120,7 @@ func (d *dataSourceCollection) Read(ctx context.Context, req datasource.ReadRequ
 		output, err := findCollectionByName(ctx, conn, data.Name.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, DSNameCollection, data.ID.String(), err),
+				create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, DSNameCollection, data.Name.String(), err),
 				err.Error(),
 			)
 			return
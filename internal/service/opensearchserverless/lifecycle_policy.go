@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -67,7 +68,8 @@ func (r *resourceLifecyclePolicy) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 			"policy": schema.StringAttribute{
-				Required: true,
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 20480),
 				},
@@ -280,18 +282,16 @@ func (rd *resourceLifecyclePolicyData) refreshFromOutput(ctx context.Context, ou
 		return diags
 	}
 
-	p := string(policyBytes)
-
-	rd.Policy = flex.StringToFramework(ctx, &p)
+	rd.Policy = jsontypes.NewNormalizedValue(string(policyBytes))
 
 	return diags
 }
 
 type resourceLifecyclePolicyData struct {
-	Description   types.String `tfsdk:"description"`
-	ID            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Policy        types.String `tfsdk:"policy"`
-	PolicyVersion types.String `tfsdk:"policy_version"`
-	Type          types.String `tfsdk:"type"`
+	Description   types.String         `tfsdk:"description"`
+	ID            types.String         `tfsdk:"id"`
+	Name          types.String         `tfsdk:"name"`
+	Policy        jsontypes.Normalized `tfsdk:"policy"`
+	PolicyVersion types.String         `tfsdk:"policy_version"`
+	Type          types.String         `tfsdk:"type"`
 }
@@ -123,6 +123,36 @@ func TestAccOpenSearchServerlessLifecyclePolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccOpenSearchServerlessLifecyclePolicy_policyFormatting(t *testing.T) {
+	ctx := acctest.Context(t)
+	var lifecyclepolicy types.LifecyclePolicyDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearchserverless_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheckLifecyclePolicy(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName, &lifecyclepolicy),
+				),
+			},
+			{
+				Config:   testAccLifecyclePolicyConfig_heredoc(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckLifecyclePolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessClient(ctx)
@@ -245,3 +275,28 @@ resource "aws_opensearchserverless_lifecycle_policy" "test" {
 }
 `, rName, description)
 }
+
+func testAccLifecyclePolicyConfig_heredoc(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_opensearchserverless_lifecycle_policy" "test" {
+  name   = %[1]q
+  type   = "retention"
+  policy = <<-EOT
+    {
+      "Rules": [
+        {
+          "ResourceType": "index",
+          "Resource": ["index/%[1]s/*"],
+          "MinIndexRetention": "81d"
+        },
+        {
+          "ResourceType": "index",
+          "Resource": ["index/sales/%[1]s*"],
+          "NoMinIndexRetention": true
+        }
+      ]
+    }
+  EOT
+}
+`, rName)
+}
@@ -153,7 +153,7 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 	if needsModify {
 		log.Printf("[DEBUG] %s: Updating Green environment", operation)
 
-		err := dbInstanceModify(ctx, h.conn, d.Id(), modifyInput, timeout)
+		err := dbInstanceModify(ctx, h.conn, identifier, modifyInput, timeout)
 		if err != nil {
 			return fmt.Errorf("updating Green environment: %s", err)
 		}
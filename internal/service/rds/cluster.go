@@ -201,6 +201,11 @@ func ResourceCluster() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"enable_limitless_database": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -489,7 +494,7 @@ func ResourceCluster() *schema.Resource {
 						"min_capacity": {
 							Type:         schema.TypeFloat,
 							Required:     true,
-							ValidateFunc: validation.FloatBetween(0.5, 128),
+							ValidateFunc: validation.FloatBetween(0, 128),
 						},
 					},
 				},
@@ -1014,6 +1019,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			input.EnableHttpEndpoint = aws.Bool(v.(bool))
 		}
 
+		if v, ok := d.GetOk("enable_limitless_database"); ok {
+			input.EnableLimitlessDatabase = aws.Bool(v.(bool))
+		}
+
 		if v, ok := d.GetOk("enabled_cloudwatch_logs_exports"); ok && v.(*schema.Set).Len() > 0 {
 			input.EnableCloudwatchLogsExports = flex.ExpandStringSet(v.(*schema.Set))
 		}
@@ -1194,6 +1203,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	d.Set("enabled_cloudwatch_logs_exports", aws.StringValueSlice(dbc.EnabledCloudwatchLogsExports))
 	d.Set("enable_http_endpoint", dbc.HttpEndpointEnabled)
+	d.Set("enable_limitless_database", clusterLimitlessDatabaseEnabled(dbc.LimitlessDatabase))
 	d.Set("endpoint", dbc.Endpoint)
 	d.Set("engine", dbc.Engine)
 	d.Set("engine_mode", dbc.EngineMode)
@@ -1347,6 +1357,10 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.EnableHttpEndpoint = aws.Bool(d.Get("enable_http_endpoint").(bool))
 		}
 
+		if d.HasChange("enable_limitless_database") {
+			input.EnableLimitlessDatabase = aws.Bool(d.Get("enable_limitless_database").(bool))
+		}
+
 		if d.HasChange("enabled_cloudwatch_logs_exports") {
 			oraw, nraw := d.GetChange("enabled_cloudwatch_logs_exports")
 			o := oraw.(*schema.Set)
@@ -1388,6 +1402,8 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		if d.HasChange("master_user_secret_kms_key_id") {
 			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
 				input.MasterUserSecretKmsKeyId = aws.String(v.(string))
+				// InvalidParameterValue: A ManageMasterUserPassword value is required when MasterUserSecretKmsKeyId is specified.
+				input.ManageMasterUserPassword = aws.Bool(d.Get("manage_master_user_password").(bool))
 			}
 		}
 
@@ -1665,6 +1681,20 @@ func clusterSetResourceDataEngineVersionFromCluster(d *schema.ResourceData, c *r
 	compareActualEngineVersion(d, oldVersion, newVersion, pendingVersion)
 }
 
+// clusterLimitlessDatabaseEnabled returns whether Aurora Limitless Database is enabled, or being enabled, for a DB cluster.
+func clusterLimitlessDatabaseEnabled(apiObject *rds.LimitlessDatabase) bool {
+	if apiObject == nil {
+		return false
+	}
+
+	switch aws.StringValue(apiObject.Status) {
+	case "", rds.LimitlessDatabaseStatusNotInUse, rds.LimitlessDatabaseStatusDisabled, rds.LimitlessDatabaseStatusDisabling:
+		return false
+	default:
+		return true
+	}
+}
+
 func FindDBClusterByID(ctx context.Context, conn *rds.RDS, id string) (*rds.DBCluster, error) {
 	input := &rds.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(id),
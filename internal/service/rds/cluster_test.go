@@ -85,6 +85,7 @@ func TestAccRDSCluster_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "delete_automated_backups", "true"),
 					resource.TestCheckResourceAttr(resourceName, "domain", ""),
 					resource.TestCheckResourceAttr(resourceName, "domain_iam_role_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "enable_limitless_database", "false"),
 					resource.TestCheckResourceAttr(resourceName, "enabled_cloudwatch_logs_exports.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "engine", tfrds.ClusterEngineAuroraMySQL),
 					resource.TestCheckResourceAttrSet(resourceName, "engine_version"),
@@ -1733,6 +1734,14 @@ func TestAccRDSCluster_ManagedMasterPassword_convertToManaged(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", "true"),
 				),
 			},
+			{
+				Config: testAccClusterConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster1),
+					resource.TestCheckNoResourceAttr(resourceName, "manage_master_user_password"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -1847,6 +1856,15 @@ func TestAccRDSCluster_serverlessV2ScalingConfiguration(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.0.min_capacity", "8.5"),
 				),
 			},
+			{
+				Config: testAccClusterConfig_serverlessV2ScalingConfiguration(rName, 64.0, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.0.max_capacity", "64"),
+					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.0.min_capacity", "0"),
+				),
+			},
 		},
 	})
 }
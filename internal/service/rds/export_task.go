@@ -309,7 +309,7 @@ func statusExportTask(ctx context.Context, conn *rds.Client, id string) retry.St
 func waitExportTaskCreated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*awstypes.ExportTask, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{StatusStarting, StatusInProgress},
-		Target:     []string{StatusComplete, StatusFailed},
+		Target:     []string{StatusComplete},
 		Refresh:    statusExportTask(ctx, conn, id),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
@@ -318,6 +318,10 @@ func waitExportTaskCreated(ctx context.Context, conn *rds.Client, id string, tim
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*awstypes.ExportTask); ok {
+		if aws.ToString(out.Status) == StatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(out.FailureCause)))
+		}
+
 		return out, err
 	}
 
@@ -380,7 +384,7 @@ func (rd *resourceExportTaskData) refreshFromOutput(ctx context.Context, out *aw
 	rd.SourceType = flex.StringValueToFramework(ctx, out.SourceType)
 	rd.Status = flex.StringToFramework(ctx, out.Status)
 	rd.TaskEndTime = timeToFramework(ctx, out.TaskEndTime)
-	rd.TaskStartTime = timeToFramework(ctx, out.TaskEndTime)
+	rd.TaskStartTime = timeToFramework(ctx, out.TaskStartTime)
 	rd.WarningMessage = flex.StringToFramework(ctx, out.WarningMessage)
 }
 
@@ -106,7 +106,8 @@ func expandServerlessV2ScalingConfiguration(tfMap map[string]interface{}) *rds.S
 		apiObject.MaxCapacity = aws.Float64(v)
 	}
 
-	if v, ok := tfMap["min_capacity"].(float64); ok && v != 0.0 {
+	// A minimum capacity of 0 ACUs enables automatic pause and resume.
+	if v, ok := tfMap["min_capacity"].(float64); ok {
 		apiObject.MinCapacity = aws.Float64(v)
 	}
 
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_rds_global_cluster_switchover", name="Global Cluster Switchover")
+func resourceGlobalClusterSwitchover() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGlobalClusterSwitchoverCreate,
+		ReadWithoutTimeout:   resourceGlobalClusterSwitchoverRead,
+		UpdateWithoutTimeout: resourceGlobalClusterSwitchoverUpdate,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_data_loss": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"global_cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_db_cluster_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceGlobalClusterSwitchoverCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	globalClusterID := d.Get("global_cluster_identifier").(string)
+
+	if err := globalClusterSwitchover(ctx, conn, globalClusterID, d.Get("target_db_cluster_identifier").(string), d.Get("allow_data_loss").(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(globalClusterID)
+
+	return append(diags, resourceGlobalClusterSwitchoverRead(ctx, d, meta)...)
+}
+
+func resourceGlobalClusterSwitchoverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	globalCluster, err := FindGlobalClusterByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Global Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Global Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
+	if v := globalClusterWriterMember(globalCluster); v != nil {
+		d.Set("target_db_cluster_identifier", v.DBClusterArn)
+	}
+
+	return diags
+}
+
+func resourceGlobalClusterSwitchoverUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	if d.HasChange("target_db_cluster_identifier") {
+		if err := globalClusterSwitchover(ctx, conn, d.Id(), d.Get("target_db_cluster_identifier").(string), d.Get("allow_data_loss").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceGlobalClusterSwitchoverRead(ctx, d, meta)...)
+}
+
+// globalClusterSwitchover promotes the specified member DB cluster to be the writer of the global cluster.
+// A switchover is used unless data loss is allowed, in which case a failover is performed.
+func globalClusterSwitchover(ctx context.Context, conn *rds.RDS, globalClusterID, targetDBClusterARN string, allowDataLoss bool, timeout time.Duration) error {
+	globalCluster, err := FindGlobalClusterByID(ctx, conn, globalClusterID)
+
+	if err != nil {
+		return fmt.Errorf("reading RDS Global Cluster (%s): %w", globalClusterID, err)
+	}
+
+	if v := globalClusterWriterMember(globalCluster); v != nil && aws.StringValue(v.DBClusterArn) == targetDBClusterARN {
+		return nil
+	}
+
+	if allowDataLoss {
+		input := &rds.FailoverGlobalClusterInput{
+			AllowDataLoss:             aws.Bool(true),
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(targetDBClusterARN),
+		}
+
+		_, err = conn.FailoverGlobalClusterWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("failing over RDS Global Cluster (%s) to DB Cluster (%s): %w", globalClusterID, targetDBClusterARN, err)
+		}
+	} else {
+		input := &rds.SwitchoverGlobalClusterInput{
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(targetDBClusterARN),
+		}
+
+		_, err = conn.SwitchoverGlobalClusterWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("switching over RDS Global Cluster (%s) to DB Cluster (%s): %w", globalClusterID, targetDBClusterARN, err)
+		}
+	}
+
+	if _, err := waitGlobalClusterMemberPromoted(ctx, conn, globalClusterID, targetDBClusterARN, timeout); err != nil {
+		return fmt.Errorf("waiting for RDS Global Cluster (%s) DB Cluster (%s) promotion: %w", globalClusterID, targetDBClusterARN, err)
+	}
+
+	return nil
+}
+
+func globalClusterWriterMember(globalCluster *rds.GlobalCluster) *rds.GlobalClusterMember {
+	for _, v := range globalCluster.GlobalClusterMembers {
+		if aws.BoolValue(v.IsWriter) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func statusGlobalClusterMemberPromoted(ctx context.Context, conn *rds.RDS, globalClusterID, dbClusterARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGlobalClusterByID(ctx, conn, globalClusterID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if v := output.FailoverState; v != nil && aws.StringValue(v.Status) == rds.FailoverStatusCancelling {
+			return nil, "", fmt.Errorf("switchover to DB Cluster (%s) is being cancelled", aws.StringValue(v.ToDbClusterArn))
+		}
+
+		promoted := output.FailoverState == nil && aws.StringValue(output.Status) == GlobalClusterStatusAvailable
+		if v := globalClusterWriterMember(output); promoted && (v == nil || aws.StringValue(v.DBClusterArn) != dbClusterARN) {
+			promoted = false
+		}
+
+		return output, strconv.FormatBool(promoted), nil
+	}
+}
+
+func waitGlobalClusterMemberPromoted(ctx context.Context, conn *rds.RDS, globalClusterID, dbClusterARN string, timeout time.Duration) (*rds.GlobalCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{strconv.FormatBool(false)},
+		Target:  []string{strconv.FormatBool(true)},
+		Refresh: statusGlobalClusterMemberPromoted(ctx, conn, globalClusterID, dbClusterARN),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSGlobalClusterSwitchover_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var globalCluster1, globalCluster2 rds.GlobalCluster
+	rNameGlobal := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNamePrimary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameSecondary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_global_cluster_switchover.test"
+	globalClusterResourceName := "aws_rds_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckGlobalCluster(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckGlobalClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterSwitchoverConfig_basic(rNameGlobal, rNamePrimary, rNameSecondary, "primary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, globalClusterResourceName, &globalCluster1),
+					resource.TestCheckResourceAttrPair(resourceName, "global_cluster_identifier", globalClusterResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_db_cluster_identifier", "aws_rds_cluster.primary", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_data_loss"},
+			},
+			{
+				Config: testAccGlobalClusterSwitchoverConfig_basic(rNameGlobal, rNamePrimary, rNameSecondary, "secondary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, globalClusterResourceName, &globalCluster2),
+					testAccCheckGlobalClusterNotRecreated(&globalCluster1, &globalCluster2),
+					resource.TestCheckResourceAttrPair(resourceName, "target_db_cluster_identifier", "aws_rds_cluster.secondary", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGlobalClusterSwitchoverConfig_basic(rNameGlobal, rNamePrimary, rNameSecondary, target string) string {
+	return acctest.ConfigCompose(testAccGlobalClusterConfig_engineVersionUpgradeMultiRegion(rNameGlobal, rNamePrimary, rNameSecondary, "aurora-mysql", "8.0.mysql_aurora.3.03.1"), fmt.Sprintf(`
+resource "aws_rds_global_cluster_switchover" "test" {
+  global_cluster_identifier    = aws_rds_global_cluster.test.id
+  target_db_cluster_identifier = aws_rds_cluster.%[1]s.arn
+
+  depends_on = [aws_rds_cluster_instance.primary, aws_rds_cluster_instance.secondary]
+}
+`, target))
+}
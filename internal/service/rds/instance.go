@@ -134,6 +134,12 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"automation_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(rds.AutomationMode_Values(), false),
+			},
 			"availability_zone": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -553,6 +559,15 @@ func ResourceInstance() *schema.Resource {
 					},
 				},
 			},
+			"resume_full_automation_mode_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(60, 1440),
+			},
+			"resume_full_automation_mode_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"s3_import": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -1693,6 +1708,15 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	// RDS Custom DB instances are always created in full automation mode.
+	if v, ok := d.GetOk("automation_mode"); ok && v.(string) != rds.AutomationModeFull {
+		modifyDbInstanceInput.AutomationMode = aws.String(v.(string))
+		if v, ok := d.GetOk("resume_full_automation_mode_minutes"); ok {
+			modifyDbInstanceInput.ResumeFullAutomationModeMinutes = aws.Int64(int64(v.(int)))
+		}
+		requiresModifyDbInstance = true
+	}
+
 	var instance *rds.DBInstance
 	var err error
 	if instance, err = waitDBInstanceAvailableSDKv1(ctx, conn, identifier, d.Timeout(schema.TimeoutCreate)); err != nil {
@@ -1766,6 +1790,11 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("allocated_storage", v.AllocatedStorage)
 	d.Set("arn", v.DBInstanceArn)
 	d.Set("auto_minor_version_upgrade", v.AutoMinorVersionUpgrade)
+	// RDS Custom resumes full automation once the pause expires.
+	// Keep the paused mode so that the expiry doesn't show as a difference.
+	if automationMode := aws.StringValue(v.AutomationMode); automationMode != rds.AutomationModeFull || d.Get("automation_mode").(string) != rds.AutomationModeAllPaused || !automationModePauseExpired(d) {
+		d.Set("automation_mode", automationMode)
+	}
 	d.Set("availability_zone", v.AvailabilityZone)
 	d.Set("backup_retention_period", v.BackupRetentionPeriod)
 	d.Set("backup_target", v.BackupTarget)
@@ -1851,6 +1880,11 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("replicas", aws.StringValueSlice(v.ReadReplicaDBInstanceIdentifiers))
 	d.Set("replicate_source_db", v.ReadReplicaSourceDBInstanceIdentifier)
 	d.Set("resource_id", v.DbiResourceId)
+	if v.ResumeFullAutomationModeTime != nil {
+		d.Set("resume_full_automation_mode_time", aws.TimeValue(v.ResumeFullAutomationModeTime).Format(time.RFC3339))
+	} else if aws.StringValue(v.AutomationMode) != rds.AutomationModeFull {
+		d.Set("resume_full_automation_mode_time", nil)
+	}
 	d.Set("status", v.DBInstanceStatus)
 	d.Set("storage_encrypted", v.StorageEncrypted)
 	d.Set("storage_throughput", v.StorageThroughput)
@@ -2035,7 +2069,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					DBInstanceIdentifier: aws.String(sourceARN.Identifier),
 					DeletionProtection:   aws.Bool(false),
 				}
-				err := dbInstanceModify(ctx, conn, d.Id(), input, deadline.Remaining())
+				err := dbInstanceModify(ctx, conn, sourceARN.Identifier, input, deadline.Remaining())
 				if err != nil {
 					return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: disabling deletion protection: %s", d.Get("identifier").(string), err)
 				}
@@ -2134,6 +2168,16 @@ func dbInstancePopulateModify(input *rds_sdkv2.ModifyDBInstanceInput, d *schema.
 		input.AutoMinorVersionUpgrade = aws.Bool(d.Get("auto_minor_version_upgrade").(bool))
 	}
 
+	if automationMode := types.AutomationMode(d.Get("automation_mode").(string)); d.HasChange("automation_mode") || (d.HasChange("resume_full_automation_mode_minutes") && automationMode == types.AutomationModeAllPaused) {
+		needsModify = true
+		input.AutomationMode = automationMode
+		if automationMode == types.AutomationModeAllPaused {
+			if v, ok := d.GetOk("resume_full_automation_mode_minutes"); ok {
+				input.ResumeFullAutomationModeMinutes = aws.Int32(int32(v.(int)))
+			}
+		}
+	}
+
 	if d.HasChange("backup_retention_period") {
 		needsModify = true
 		input.BackupRetentionPeriod = aws.Int32(int32(d.Get("backup_retention_period").(int)))
@@ -2955,3 +2999,13 @@ func flattenEndpoint(apiObject *rds.Endpoint) map[string]interface{} {
 
 	return tfMap
 }
+
+// automationModePauseExpired returns whether the resume_full_automation_mode_time recorded in state has passed.
+func automationModePauseExpired(d *schema.ResourceData) bool {
+	t, err := time.Parse(time.RFC3339, d.Get("resume_full_automation_mode_time").(string))
+	if err != nil {
+		return false
+	}
+
+	return time.Now().After(t)
+}
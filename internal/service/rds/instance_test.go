@@ -377,6 +377,67 @@ func TestAccRDSInstance_customIAMInstanceProfile(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_customAutomationMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBInstance
+	resourceName := "aws_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionNot(t, endpoints.AwsUsGovPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_customAutomationMode(rName, "all-paused", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "automation_mode", "all-paused"),
+					resource.TestCheckResourceAttrSet(resourceName, "resume_full_automation_mode_time"),
+				),
+			},
+			{
+				// RDS Custom resumes full automation once the pause expires.
+				PreConfig: func() {
+					time.Sleep(time.Until(aws.TimeValue(v.ResumeFullAutomationModeTime)) + 5*time.Minute)
+				},
+				Config:   testAccInstanceConfig_customAutomationMode(rName, "all-paused", 60),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccInstanceConfig_customAutomationMode(rName, "full", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "automation_mode", "full"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_customAutomationMode(rName, "all-paused", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "automation_mode", "all-paused"),
+					resource.TestCheckResourceAttrSet(resourceName, "resume_full_automation_mode_time"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_customAutomationMode(rName, "full", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "automation_mode", "full"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_DBSubnetGroupName_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -11125,6 +11186,36 @@ resource "aws_db_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_customAutomationMode(rName, automationMode string, resumeMinutes int) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassCustomSQLServerWeb(),
+		fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name          = %[1]q
+  capabilities  = ["CAPABILITY_NAMED_IAM"]
+  template_body = file("test-fixtures/custom-sql-cloudformation.json")
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage                   = 20
+  auto_minor_version_upgrade          = false
+  automation_mode                     = %[2]q
+  custom_iam_instance_profile         = aws_cloudformation_stack.test.outputs["RDSCustomSQLServerInstanceProfile"]
+  engine                              = data.aws_rds_engine_version.default.engine
+  identifier                          = %[1]q
+  instance_class                      = data.aws_rds_orderable_db_instance.test.instance_class
+  kms_key_id                          = aws_cloudformation_stack.test.outputs["RDSCustomSQLServerKMSKey"]
+  password                            = "avoid-plaintext-passwords"
+  resume_full_automation_mode_minutes = %[3]d
+  username                            = "tfacctest"
+  skip_final_snapshot                 = true
+  storage_encrypted                   = true
+  vpc_security_group_ids              = [aws_cloudformation_stack.test.outputs["RDSCustomSecurityGroup"]]
+  db_subnet_group_name                = aws_cloudformation_stack.test.outputs["DBSubnetGroup"]
+}
+`, rName, automationMode, resumeMinutes))
+}
+
 func testAccInstanceConfig_customIAMInstanceProfile(rName string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassCustomSQLServerWeb(),
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -121,6 +122,12 @@ func resourceProxyEndpointCreate(ctx context.Context, d *schema.ResourceData, me
 		input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if input.TargetRole == types.DBProxyEndpointTargetRoleReadOnly {
+		if err := validateProxyReadOnlyTargets(ctx, conn, dbProxyName); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating RDS DB Proxy Endpoint (%s): %s", id, err)
+		}
+	}
+
 	_, err := conn.CreateDBProxyEndpoint(ctx, input)
 
 	if err != nil {
@@ -247,6 +254,32 @@ func proxyEndpointParseResourceID(id string) (string, string, error) {
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DBPROXYNAME%[2]sDBPROXYENDPOINTNAME", id, proxyEndpointResourceIDSeparator)
 }
 
+// validateProxyReadOnlyTargets returns an error if the DB proxy has targets and none of them is an Aurora DB cluster.
+// Read-only endpoints are only supported for Aurora DB clusters.
+func validateProxyReadOnlyTargets(ctx context.Context, conn *rds.Client, dbProxyName string) error {
+	input := &rds.DescribeDBProxyTargetsInput{
+		DBProxyName: aws.String(dbProxyName),
+	}
+	targets, err := findDBProxyTargets(ctx, conn, input, tfslices.PredicateTrue[*types.DBProxyTarget]())
+
+	// The DB proxy's targets may not be registered yet.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading RDS DB Proxy (%s) targets: %w", dbProxyName, err)
+	}
+
+	if len(targets) == 0 || slices.ContainsFunc(targets, func(v types.DBProxyTarget) bool {
+		return v.Type == types.TargetTypeTrackedCluster
+	}) {
+		return nil
+	}
+
+	return fmt.Errorf(`"target_role" %q is only supported for RDS DB Proxies with Aurora DB cluster targets`, types.DBProxyEndpointTargetRoleReadOnly)
+}
+
 func findDBProxyEndpointByTwoPartKey(ctx context.Context, conn *rds.Client, dbProxyName, dbProxyEndpointName string) (*types.DBProxyEndpoint, error) {
 	input := &rds.DescribeDBProxyEndpointsInput{
 		DBProxyName:         aws.String(dbProxyName),
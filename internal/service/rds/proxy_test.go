@@ -446,6 +446,62 @@ func TestAccRDSProxy_authSecretARN(t *testing.T) {
 	})
 }
 
+func TestAccRDSProxy_authClientPasswordAuthType(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbProxy types.DBProxy
+	resourceName := "aws_db_proxy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	nName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccDBProxyPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProxyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProxyConfig_authClientPasswordAuthType(rName, nName, "POSTGRES_SCRAM_SHA_256", "POSTGRES_MD5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "auth.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"client_password_auth_type": "POSTGRES_SCRAM_SHA_256",
+						"description":               "scram",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"client_password_auth_type": "POSTGRES_MD5",
+						"description":               "md5",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProxyConfig_authClientPasswordAuthType(rName, nName, "POSTGRES_MD5", "POSTGRES_SCRAM_SHA_256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "auth.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"client_password_auth_type": "POSTGRES_MD5",
+						"description":               "scram",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auth.*", map[string]string{
+						"client_password_auth_type": "POSTGRES_SCRAM_SHA_256",
+						"description":               "md5",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSProxy_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -955,6 +1011,49 @@ resource "aws_secretsmanager_secret_version" "test2" {
 `, rName, nName))
 }
 
+func testAccProxyConfig_authClientPasswordAuthType(rName, nName, clientPasswordAuthType1, clientPasswordAuthType2 string) string {
+	return acctest.ConfigCompose(testAccProxyConfig_base(rName), fmt.Sprintf(`
+resource "aws_db_proxy" "test" {
+  depends_on = [
+    aws_secretsmanager_secret_version.test,
+    aws_iam_role_policy.test
+  ]
+
+  name                   = %[1]q
+  engine_family          = "POSTGRESQL"
+  role_arn               = aws_iam_role.test.arn
+  vpc_security_group_ids = [aws_security_group.test.id]
+  vpc_subnet_ids         = aws_subnet.test[*].id
+
+  auth {
+    auth_scheme               = "SECRETS"
+    client_password_auth_type = %[3]q
+    description               = "scram"
+    iam_auth                  = "DISABLED"
+    secret_arn                = aws_secretsmanager_secret.test.arn
+  }
+
+  auth {
+    auth_scheme               = "SECRETS"
+    client_password_auth_type = %[4]q
+    description               = "md5"
+    iam_auth                  = "REQUIRED"
+    secret_arn                = aws_secretsmanager_secret.test2.arn
+  }
+}
+
+resource "aws_secretsmanager_secret" "test2" {
+  name                    = %[2]q
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test2" {
+  secret_id     = aws_secretsmanager_secret.test2.id
+  secret_string = "{\"username\":\"db_user2\",\"password\":\"db_user_password\"}"
+}
+`, rName, nName, clientPasswordAuthType1, clientPasswordAuthType2))
+}
+
 func testAccProxyConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return testAccProxyConfig_base(rName) + fmt.Sprintf(`
 resource "aws_db_proxy" "test" {
@@ -250,6 +250,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGlobalCluster,
 			TypeName: "aws_rds_global_cluster",
 		},
+		{
+			Factory:  resourceGlobalClusterSwitchover,
+			TypeName: "aws_rds_global_cluster_switchover",
+			Name:     "Global Cluster Switchover",
+		},
 		{
 			Factory:  ResourceReservedInstance,
 			TypeName: "aws_rds_reserved_instance",
@@ -9,15 +9,18 @@ import (
 	"log"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/route53resolver"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -35,14 +38,14 @@ func ResourceFirewallRule() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"action": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice(route53resolver.Action_Values(), false),
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.Action](),
 			},
 			"block_override_dns_type": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice(route53resolver.BlockOverrideDnsType_Values(), false),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.BlockOverrideDnsType](),
 			},
 			"block_override_domain": {
 				Type:         schema.TypeString,
@@ -55,22 +58,47 @@ func ResourceFirewallRule() *schema.Resource {
 				ValidateFunc: validation.IntBetween(0, 604800),
 			},
 			"block_response": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice(route53resolver.BlockResponse_Values(), false),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.BlockResponse](),
+			},
+			"confidence_threshold": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{"dns_threat_protection"},
+				ValidateDiagFunc: enum.Validate[awstypes.ConfidenceThreshold](),
+			},
+			"dns_threat_protection": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"dns_threat_protection", "firewall_domain_list_id"},
+				RequiredWith:     []string{"confidence_threshold"},
+				ValidateDiagFunc: enum.Validate[awstypes.DnsThreatProtection](),
 			},
 			"firewall_domain_list_id": {
 				Type:         schema.TypeString,
+				Optional:     true,
 				ForceNew:     true,
-				Required:     true,
+				ExactlyOneOf: []string{"dns_threat_protection", "firewall_domain_list_id"},
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
+			"firewall_domain_redirection_action": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"dns_threat_protection"},
+				ValidateDiagFunc: enum.Validate[awstypes.FirewallDomainRedirectionAction](),
+			},
 			"firewall_rule_group_id": {
 				Type:         schema.TypeString,
 				ForceNew:     true,
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
+			"firewall_threat_protection_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -80,28 +108,31 @@ func ResourceFirewallRule() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"q_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 16),
+			},
 		},
 	}
 }
 
 func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn(ctx)
+	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
 
-	firewallDomainListID := d.Get("firewall_domain_list_id").(string)
 	firewallRuleGroupID := d.Get("firewall_rule_group_id").(string)
-	ruleID := FirewallRuleCreateResourceID(firewallRuleGroupID, firewallDomainListID)
 	name := d.Get("name").(string)
 	input := &route53resolver.CreateFirewallRuleInput{
-		Action:               aws.String(d.Get("action").(string)),
-		CreatorRequestId:     aws.String(id.PrefixedUniqueId("tf-r53-resolver-firewall-rule-")),
-		FirewallRuleGroupId:  aws.String(firewallRuleGroupID),
-		FirewallDomainListId: aws.String(firewallDomainListID),
-		Name:                 aws.String(name),
-		Priority:             aws.Int64(int64(d.Get("priority").(int))),
+		Action:              awstypes.Action(d.Get("action").(string)),
+		CreatorRequestId:    aws.String(id.PrefixedUniqueId("tf-r53-resolver-firewall-rule-")),
+		FirewallRuleGroupId: aws.String(firewallRuleGroupID),
+		Name:                aws.String(name),
+		Priority:            aws.Int32(int32(d.Get("priority").(int))),
 	}
 
 	if v, ok := d.GetOk("block_override_dns_type"); ok {
-		input.BlockOverrideDnsType = aws.String(v.(string))
+		input.BlockOverrideDnsType = awstypes.BlockOverrideDnsType(v.(string))
 	}
 
 	if v, ok := d.GetOk("block_override_domain"); ok {
@@ -109,34 +140,60 @@ func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	if v, ok := d.GetOk("block_override_ttl"); ok {
-		input.BlockOverrideTtl = aws.Int64(int64(v.(int)))
+		input.BlockOverrideTtl = aws.Int32(int32(v.(int)))
 	}
 
 	if v, ok := d.GetOk("block_response"); ok {
-		input.BlockResponse = aws.String(v.(string))
+		input.BlockResponse = awstypes.BlockResponse(v.(string))
 	}
 
-	_, err := conn.CreateFirewallRuleWithContext(ctx, input)
+	if v, ok := d.GetOk("confidence_threshold"); ok {
+		input.ConfidenceThreshold = awstypes.ConfidenceThreshold(v.(string))
+	}
+
+	if v, ok := d.GetOk("dns_threat_protection"); ok {
+		input.DnsThreatProtection = awstypes.DnsThreatProtection(v.(string))
+	}
+
+	if v, ok := d.GetOk("firewall_domain_list_id"); ok {
+		input.FirewallDomainListId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("firewall_domain_redirection_action"); ok {
+		input.FirewallDomainRedirectionAction = awstypes.FirewallDomainRedirectionAction(v.(string))
+	}
+
+	if v, ok := d.GetOk("q_type"); ok {
+		input.Qtype = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateFirewallRule(ctx, input)
 
 	if err != nil {
 		return diag.Errorf("creating Route53 Resolver Firewall Rule (%s): %s", name, err)
 	}
 
-	d.SetId(ruleID)
+	// Rules are identified by their domain list or, for DNS Firewall Advanced rules, their threat protection.
+	firewallDomainListOrThreatProtectionID := aws.ToString(input.FirewallDomainListId)
+	if input.DnsThreatProtection != "" {
+		firewallDomainListOrThreatProtectionID = aws.ToString(output.FirewallRule.FirewallThreatProtectionId)
+	}
+
+	d.SetId(FirewallRuleCreateResourceID(firewallRuleGroupID, firewallDomainListOrThreatProtectionID, aws.ToString(input.Qtype)))
 
 	return resourceFirewallRuleRead(ctx, d, meta)
 }
 
 func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn(ctx)
+	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
 
-	firewallRuleGroupID, firewallDomainListID, err := FirewallRuleParseResourceID(d.Id())
+	firewallRuleGroupID, firewallDomainListOrThreatProtectionID, qType, err := FirewallRuleParseResourceID(d.Id())
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	firewallRule, err := FindFirewallRuleByTwoPartKey(ctx, conn, firewallRuleGroupID, firewallDomainListID)
+	firewallRule, err := FindFirewallRuleByThreePartKey(ctx, conn, firewallRuleGroupID, firewallDomainListOrThreatProtectionID, qType)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Route53 Resolver Firewall Rule (%s) not found, removing from state", d.Id())
@@ -153,33 +210,37 @@ func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("block_override_domain", firewallRule.BlockOverrideDomain)
 	d.Set("block_override_ttl", firewallRule.BlockOverrideTtl)
 	d.Set("block_response", firewallRule.BlockResponse)
+	d.Set("confidence_threshold", firewallRule.ConfidenceThreshold)
+	d.Set("dns_threat_protection", firewallRule.DnsThreatProtection)
 	d.Set("firewall_rule_group_id", firewallRule.FirewallRuleGroupId)
 	d.Set("firewall_domain_list_id", firewallRule.FirewallDomainListId)
+	d.Set("firewall_domain_redirection_action", firewallRule.FirewallDomainRedirectionAction)
+	d.Set("firewall_threat_protection_id", firewallRule.FirewallThreatProtectionId)
 	d.Set("name", firewallRule.Name)
 	d.Set("priority", firewallRule.Priority)
+	d.Set("q_type", firewallRule.Qtype)
 
 	return nil
 }
 
 func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn(ctx)
+	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
 
-	firewallRuleGroupID, firewallDomainListID, err := FirewallRuleParseResourceID(d.Id())
+	firewallRuleGroupID, _, qType, err := FirewallRuleParseResourceID(d.Id())
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	input := &route53resolver.UpdateFirewallRuleInput{
-		Action:               aws.String(d.Get("action").(string)),
-		FirewallDomainListId: aws.String(firewallDomainListID),
-		FirewallRuleGroupId:  aws.String(firewallRuleGroupID),
-		Name:                 aws.String(d.Get("name").(string)),
-		Priority:             aws.Int64(int64(d.Get("priority").(int))),
+		Action:              awstypes.Action(d.Get("action").(string)),
+		FirewallRuleGroupId: aws.String(firewallRuleGroupID),
+		Name:                aws.String(d.Get("name").(string)),
+		Priority:            aws.Int32(int32(d.Get("priority").(int))),
 	}
 
 	if v, ok := d.GetOk("block_override_dns_type"); ok {
-		input.BlockOverrideDnsType = aws.String(v.(string))
+		input.BlockOverrideDnsType = awstypes.BlockOverrideDnsType(v.(string))
 	}
 
 	if v, ok := d.GetOk("block_override_domain"); ok {
@@ -187,14 +248,33 @@ func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	if v, ok := d.GetOk("block_override_ttl"); ok {
-		input.BlockOverrideTtl = aws.Int64(int64(v.(int)))
+		input.BlockOverrideTtl = aws.Int32(int32(v.(int)))
 	}
 
 	if v, ok := d.GetOk("block_response"); ok {
-		input.BlockResponse = aws.String(v.(string))
+		input.BlockResponse = awstypes.BlockResponse(v.(string))
 	}
 
-	_, err = conn.UpdateFirewallRuleWithContext(ctx, input)
+	if v, ok := d.GetOk("confidence_threshold"); ok {
+		input.ConfidenceThreshold = awstypes.ConfidenceThreshold(v.(string))
+	}
+
+	if v, ok := d.GetOk("dns_threat_protection"); ok {
+		input.DnsThreatProtection = awstypes.DnsThreatProtection(v.(string))
+		input.FirewallThreatProtectionId = aws.String(d.Get("firewall_threat_protection_id").(string))
+	} else {
+		input.FirewallDomainListId = aws.String(d.Get("firewall_domain_list_id").(string))
+	}
+
+	if v, ok := d.GetOk("firewall_domain_redirection_action"); ok {
+		input.FirewallDomainRedirectionAction = awstypes.FirewallDomainRedirectionAction(v.(string))
+	}
+
+	if qType != "" {
+		input.Qtype = aws.String(qType)
+	}
+
+	_, err = conn.UpdateFirewallRule(ctx, input)
 
 	if err != nil {
 		return diag.Errorf("updating Route53 Resolver Firewall Rule (%s): %s", d.Id(), err)
@@ -204,21 +284,32 @@ func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn(ctx)
+	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
 
-	firewallRuleGroupID, firewallDomainListID, err := FirewallRuleParseResourceID(d.Id())
+	firewallRuleGroupID, firewallDomainListOrThreatProtectionID, qType, err := FirewallRuleParseResourceID(d.Id())
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	input := &route53resolver.DeleteFirewallRuleInput{
+		FirewallRuleGroupId: aws.String(firewallRuleGroupID),
+	}
+
+	if _, ok := d.GetOk("firewall_threat_protection_id"); ok {
+		input.FirewallThreatProtectionId = aws.String(firewallDomainListOrThreatProtectionID)
+	} else {
+		input.FirewallDomainListId = aws.String(firewallDomainListOrThreatProtectionID)
+	}
+
+	if qType != "" {
+		input.Qtype = aws.String(qType)
+	}
+
 	log.Printf("[DEBUG] Deleting Route53 Resolver Firewall Rule: %s", d.Id())
-	_, err = conn.DeleteFirewallRuleWithContext(ctx, &route53resolver.DeleteFirewallRuleInput{
-		FirewallDomainListId: aws.String(firewallDomainListID),
-		FirewallRuleGroupId:  aws.String(firewallRuleGroupID),
-	})
+	_, err = conn.DeleteFirewallRule(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, route53resolver.ErrCodeResourceNotFoundException) {
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return nil
 	}
 
@@ -231,72 +322,74 @@ func resourceFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, met
 
 const firewallRuleIDSeparator = ":"
 
-func FirewallRuleCreateResourceID(firewallRuleGroupID, firewallDomainListID string) string {
-	parts := []string{firewallRuleGroupID, firewallDomainListID}
+// FirewallRuleCreateResourceID returns the ID of a firewall rule.
+// The optional query type is only included when set, keeping IDs of rules without one unchanged.
+func FirewallRuleCreateResourceID(firewallRuleGroupID, firewallDomainListOrThreatProtectionID, qType string) string {
+	parts := []string{firewallRuleGroupID, firewallDomainListOrThreatProtectionID}
+	if qType != "" {
+		parts = append(parts, qType)
+	}
 	id := strings.Join(parts, firewallRuleIDSeparator)
 
 	return id
 }
 
-func FirewallRuleParseResourceID(id string) (string, string, error) {
-	parts := strings.SplitN(id, firewallRuleIDSeparator, 2)
+func FirewallRuleParseResourceID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, firewallRuleIDSeparator, 3)
 
-	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
-		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected firewall_rule_group_id%[2]sfirewall_domain_list_id", id, firewallRuleIDSeparator)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" || (len(parts) == 3 && parts[2] == "") {
+		return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected firewall_rule_group_id%[2]sfirewall_domain_list_id_or_firewall_threat_protection_id[%[2]sq_type]", id, firewallRuleIDSeparator)
 	}
 
-	return parts[0], parts[1], nil
+	if len(parts) == 2 {
+		return parts[0], parts[1], "", nil
+	}
+
+	return parts[0], parts[1], parts[2], nil
 }
 
-func FindFirewallRuleByTwoPartKey(ctx context.Context, conn *route53resolver.Route53Resolver, firewallRuleGroupID, firewallDomainListID string) (*route53resolver.FirewallRule, error) {
-	output, err := findFirewallRules(ctx, conn, firewallRuleGroupID, func(rule *route53resolver.FirewallRule) bool {
-		return aws.StringValue(rule.FirewallDomainListId) == firewallDomainListID
+func FindFirewallRuleByThreePartKey(ctx context.Context, conn *route53resolver.Client, firewallRuleGroupID, firewallDomainListOrThreatProtectionID, qType string) (*awstypes.FirewallRule, error) {
+	output, err := findFirewallRules(ctx, conn, firewallRuleGroupID, func(rule awstypes.FirewallRule) bool {
+		if aws.ToString(rule.FirewallDomainListId) != firewallDomainListOrThreatProtectionID && aws.ToString(rule.FirewallThreatProtectionId) != firewallDomainListOrThreatProtectionID {
+			return false
+		}
+
+		return aws.ToString(rule.Qtype) == qType
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	if len(output) == 0 || output[0] == nil {
-		return nil, tfresource.NewEmptyResultError(firewallRuleGroupID)
-	}
-
-	if count := len(output); count > 1 {
-		return nil, tfresource.NewTooManyResultsError(count, firewallRuleGroupID)
-	}
-
-	return output[0], nil
+	return tfresource.AssertSingleValueResult(output)
 }
 
-func findFirewallRules(ctx context.Context, conn *route53resolver.Route53Resolver, firewallRuleGroupID string, f func(*route53resolver.FirewallRule) bool) ([]*route53resolver.FirewallRule, error) {
+func findFirewallRules(ctx context.Context, conn *route53resolver.Client, firewallRuleGroupID string, filter tfslices.Predicate[awstypes.FirewallRule]) ([]awstypes.FirewallRule, error) {
 	input := &route53resolver.ListFirewallRulesInput{
 		FirewallRuleGroupId: aws.String(firewallRuleGroupID),
 	}
-	var output []*route53resolver.FirewallRule
+	var output []awstypes.FirewallRule
 
-	err := conn.ListFirewallRulesPagesWithContext(ctx, input, func(page *route53resolver.ListFirewallRulesOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
-		}
+	pages := route53resolver.NewListFirewallRulesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
 
-		for _, v := range page.FirewallRules {
-			if f(v) {
-				output = append(output, v)
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
 			}
 		}
 
-		return !lastPage
-	})
-
-	if tfawserr.ErrCodeEquals(err, route53resolver.ErrCodeResourceNotFoundException) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	if err != nil {
-		return nil, err
+		for _, v := range page.FirewallRules {
+			if filter(v) {
+				output = append(output, v)
+			}
+		}
 	}
 
 	return output, nil
@@ -8,7 +8,7 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/service/route53resolver"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -21,7 +21,7 @@ import (
 
 func TestAccRoute53ResolverFirewallRule_basic(t *testing.T) {
 	ctx := acctest.Context(t)
-	var v route53resolver.FirewallRule
+	var v awstypes.FirewallRule
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53_resolver_firewall_rule.test"
 
@@ -53,7 +53,7 @@ func TestAccRoute53ResolverFirewallRule_basic(t *testing.T) {
 
 func TestAccRoute53ResolverFirewallRule_block(t *testing.T) {
 	ctx := acctest.Context(t)
-	var v route53resolver.FirewallRule
+	var v awstypes.FirewallRule
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53_resolver_firewall_rule.test"
 
@@ -83,7 +83,7 @@ func TestAccRoute53ResolverFirewallRule_block(t *testing.T) {
 
 func TestAccRoute53ResolverFirewallRule_blockOverride(t *testing.T) {
 	ctx := acctest.Context(t)
-	var v route53resolver.FirewallRule
+	var v awstypes.FirewallRule
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53_resolver_firewall_rule.test"
 
@@ -114,9 +114,118 @@ func TestAccRoute53ResolverFirewallRule_blockOverride(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverFirewallRule_firewallDomainRedirectionAction(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.FirewallRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_firewall_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleConfig_firewallDomainRedirectionAction(rName, "TRUST_REDIRECTION_DOMAIN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "firewall_domain_redirection_action", "TRUST_REDIRECTION_DOMAIN"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFirewallRuleConfig_firewallDomainRedirectionAction(rName, "INSPECT_REDIRECTION_DOMAIN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "firewall_domain_redirection_action", "INSPECT_REDIRECTION_DOMAIN"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ResolverFirewallRule_qType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.FirewallRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_firewall_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleConfig_qType(rName, "A"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "q_type", "A"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFirewallRuleConfig_qType(rName, "AAAA"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "q_type", "AAAA"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ResolverFirewallRule_dnsThreatProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.FirewallRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_firewall_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleConfig_dnsThreatProtection(rName, "DGA", "HIGH"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "confidence_threshold", "HIGH"),
+					resource.TestCheckResourceAttr(resourceName, "dns_threat_protection", "DGA"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_domain_list_id", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "firewall_threat_protection_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFirewallRuleConfig_dnsThreatProtection(rName, "DNS_TUNNELING", "MEDIUM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "confidence_threshold", "MEDIUM"),
+					resource.TestCheckResourceAttr(resourceName, "dns_threat_protection", "DNS_TUNNELING"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoute53ResolverFirewallRule_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
-	var v route53resolver.FirewallRule
+	var v awstypes.FirewallRule
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53_resolver_firewall_rule.test"
 
@@ -140,20 +249,20 @@ func TestAccRoute53ResolverFirewallRule_disappears(t *testing.T) {
 
 func testAccCheckFirewallRuleDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn(ctx)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverClient(ctx)
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_route53_resolver_firewall_rule" {
 				continue
 			}
 
-			firewallRuleGroupID, firewallDomainListID, err := tfroute53resolver.FirewallRuleParseResourceID(rs.Primary.ID)
+			firewallRuleGroupID, firewallDomainListOrThreatProtectionID, qType, err := tfroute53resolver.FirewallRuleParseResourceID(rs.Primary.ID)
 
 			if err != nil {
 				return err
 			}
 
-			_, err = tfroute53resolver.FindFirewallRuleByTwoPartKey(ctx, conn, firewallRuleGroupID, firewallDomainListID)
+			_, err = tfroute53resolver.FindFirewallRuleByThreePartKey(ctx, conn, firewallRuleGroupID, firewallDomainListOrThreatProtectionID, qType)
 
 			if tfresource.NotFound(err) {
 				continue
@@ -170,7 +279,7 @@ func testAccCheckFirewallRuleDestroy(ctx context.Context) resource.TestCheckFunc
 	}
 }
 
-func testAccCheckFirewallRuleExists(ctx context.Context, n string, v *route53resolver.FirewallRule) resource.TestCheckFunc {
+func testAccCheckFirewallRuleExists(ctx context.Context, n string, v *awstypes.FirewallRule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -181,15 +290,15 @@ func testAccCheckFirewallRuleExists(ctx context.Context, n string, v *route53res
 			return fmt.Errorf("No Route53 Resolver Firewall Rule ID is set")
 		}
 
-		firewallRuleGroupID, firewallDomainListID, err := tfroute53resolver.FirewallRuleParseResourceID(rs.Primary.ID)
+		firewallRuleGroupID, firewallDomainListOrThreatProtectionID, qType, err := tfroute53resolver.FirewallRuleParseResourceID(rs.Primary.ID)
 
 		if err != nil {
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn(ctx)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverClient(ctx)
 
-		output, err := tfroute53resolver.FindFirewallRuleByTwoPartKey(ctx, conn, firewallRuleGroupID, firewallDomainListID)
+		output, err := tfroute53resolver.FindFirewallRuleByThreePartKey(ctx, conn, firewallRuleGroupID, firewallDomainListOrThreatProtectionID, qType)
 
 		if err != nil {
 			return err
@@ -265,3 +374,64 @@ resource "aws_route53_resolver_firewall_rule" "test" {
 }
 `, rName)
 }
+
+func testAccFirewallRuleConfig_firewallDomainRedirectionAction(rName, firewallDomainRedirectionAction string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_resolver_firewall_rule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_resolver_firewall_domain_list" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_resolver_firewall_rule" "test" {
+  name                               = %[1]q
+  action                             = "ALLOW"
+  firewall_domain_redirection_action = %[2]q
+  firewall_rule_group_id             = aws_route53_resolver_firewall_rule_group.test.id
+  firewall_domain_list_id            = aws_route53_resolver_firewall_domain_list.test.id
+  priority                           = 100
+}
+`, rName, firewallDomainRedirectionAction)
+}
+
+func testAccFirewallRuleConfig_qType(rName, qType string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_resolver_firewall_rule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_resolver_firewall_domain_list" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_resolver_firewall_rule" "test" {
+  name                    = %[1]q
+  action                  = "BLOCK"
+  block_response          = "NODATA"
+  firewall_rule_group_id  = aws_route53_resolver_firewall_rule_group.test.id
+  firewall_domain_list_id = aws_route53_resolver_firewall_domain_list.test.id
+  priority                = 100
+  q_type                  = %[2]q
+}
+`, rName, qType)
+}
+
+func testAccFirewallRuleConfig_dnsThreatProtection(rName, dnsThreatProtection, confidenceThreshold string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_resolver_firewall_rule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_resolver_firewall_rule" "test" {
+  name                   = %[1]q
+  action                 = "BLOCK"
+  block_response         = "NODATA"
+  confidence_threshold   = %[3]q
+  dns_threat_protection  = %[2]q
+  firewall_rule_group_id = aws_route53_resolver_firewall_rule_group.test.id
+  priority               = 100
+}
+`, rName, dnsThreatProtection, confidenceThreshold)
+}
@@ -6,8 +6,8 @@ package route53resolver
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -52,6 +52,10 @@ func DataSourceResolverFirewallRules() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"confidence_threshold": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"creation_time": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -60,14 +64,26 @@ func DataSourceResolverFirewallRules() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"dns_threat_protection": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"firewall_domain_list_id": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"firewall_domain_redirection_action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"firewall_rule_group_id": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"firewall_threat_protection_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"modification_time": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -80,6 +96,10 @@ func DataSourceResolverFirewallRules() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"q_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -92,15 +112,15 @@ func DataSourceResolverFirewallRules() *schema.Resource {
 }
 
 func dataSourceResolverFirewallFirewallRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn(ctx)
+	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
 
 	firewallRuleGroupID := d.Get("firewall_rule_group_id").(string)
-	rules, err := findFirewallRules(ctx, conn, firewallRuleGroupID, func(rule *route53resolver.FirewallRule) bool {
-		if v, ok := d.GetOk("action"); ok && aws.StringValue(rule.Action) != v.(string) {
+	rules, err := findFirewallRules(ctx, conn, firewallRuleGroupID, func(rule awstypes.FirewallRule) bool {
+		if v, ok := d.GetOk("action"); ok && string(rule.Action) != v.(string) {
 			return false
 		}
 
-		if v, ok := d.GetOk("priority"); ok && aws.Int64Value(rule.Priority) != int64(v.(int)) {
+		if v, ok := d.GetOk("priority"); ok && aws.ToInt32(rule.Priority) != int32(v.(int)) {
 			return false
 		}
 
@@ -126,7 +146,7 @@ func dataSourceResolverFirewallFirewallRulesRead(ctx context.Context, d *schema.
 	return nil
 }
 
-func flattenFirewallRules(apiObjects []*route53resolver.FirewallRule) []interface{} {
+func flattenFirewallRules(apiObjects []awstypes.FirewallRule) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
 	}
@@ -134,57 +154,54 @@ func flattenFirewallRules(apiObjects []*route53resolver.FirewallRule) []interfac
 	var tfList []interface{}
 
 	for _, apiObject := range apiObjects {
-		if apiObject == nil {
-			continue
-		}
 		tfList = append(tfList, flattenFirewallRule(apiObject))
 	}
 
 	return tfList
 }
 
-func flattenFirewallRule(apiObject *route53resolver.FirewallRule) map[string]interface{} {
-	if apiObject == nil {
-		return nil
+func flattenFirewallRule(apiObject awstypes.FirewallRule) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"action":                             string(apiObject.Action),
+		"block_override_dns_type":            string(apiObject.BlockOverrideDnsType),
+		"block_response":                     string(apiObject.BlockResponse),
+		"confidence_threshold":               string(apiObject.ConfidenceThreshold),
+		"dns_threat_protection":              string(apiObject.DnsThreatProtection),
+		"firewall_domain_redirection_action": string(apiObject.FirewallDomainRedirectionAction),
 	}
 
-	tfMap := map[string]interface{}{}
-
-	if apiObject.Action != nil {
-		tfMap["action"] = aws.StringValue(apiObject.Action)
-	}
-	if apiObject.BlockOverrideDnsType != nil {
-		tfMap["block_override_dns_type"] = aws.StringValue(apiObject.BlockOverrideDnsType)
-	}
 	if apiObject.BlockOverrideDomain != nil {
-		tfMap["block_override_domain"] = aws.StringValue(apiObject.BlockOverrideDomain)
+		tfMap["block_override_domain"] = aws.ToString(apiObject.BlockOverrideDomain)
 	}
 	if apiObject.BlockOverrideTtl != nil {
-		tfMap["block_override_ttl"] = aws.Int64Value(apiObject.BlockOverrideTtl)
-	}
-	if apiObject.BlockResponse != nil {
-		tfMap["block_response"] = aws.StringValue(apiObject.BlockResponse)
+		tfMap["block_override_ttl"] = aws.ToInt32(apiObject.BlockOverrideTtl)
 	}
 	if apiObject.CreationTime != nil {
-		tfMap["creation_time"] = aws.StringValue(apiObject.CreationTime)
+		tfMap["creation_time"] = aws.ToString(apiObject.CreationTime)
 	}
 	if apiObject.CreatorRequestId != nil {
-		tfMap["creator_request_id"] = aws.StringValue(apiObject.CreatorRequestId)
+		tfMap["creator_request_id"] = aws.ToString(apiObject.CreatorRequestId)
 	}
 	if apiObject.FirewallDomainListId != nil {
-		tfMap["firewall_domain_list_id"] = aws.StringValue(apiObject.FirewallDomainListId)
+		tfMap["firewall_domain_list_id"] = aws.ToString(apiObject.FirewallDomainListId)
 	}
 	if apiObject.FirewallRuleGroupId != nil {
-		tfMap["firewall_rule_group_id"] = aws.StringValue(apiObject.FirewallRuleGroupId)
+		tfMap["firewall_rule_group_id"] = aws.ToString(apiObject.FirewallRuleGroupId)
+	}
+	if apiObject.FirewallThreatProtectionId != nil {
+		tfMap["firewall_threat_protection_id"] = aws.ToString(apiObject.FirewallThreatProtectionId)
 	}
 	if apiObject.ModificationTime != nil {
-		tfMap["modification_time"] = aws.StringValue(apiObject.ModificationTime)
+		tfMap["modification_time"] = aws.ToString(apiObject.ModificationTime)
 	}
 	if apiObject.Name != nil {
-		tfMap["name"] = aws.StringValue(apiObject.Name)
+		tfMap["name"] = aws.ToString(apiObject.Name)
 	}
 	if apiObject.Priority != nil {
-		tfMap["priority"] = aws.Int64Value(apiObject.Priority)
+		tfMap["priority"] = aws.ToInt32(apiObject.Priority)
+	}
+	if apiObject.Qtype != nil {
+		tfMap["q_type"] = aws.ToString(apiObject.Qtype)
 	}
 	return tfMap
 }
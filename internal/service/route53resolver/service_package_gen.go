@@ -5,6 +5,8 @@ package route53resolver
 import (
 	"context"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	route53resolver_sdkv2 "github.com/aws/aws-sdk-go-v2/service/route53resolver"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	route53resolver_sdkv1 "github.com/aws/aws-sdk-go/service/route53resolver"
@@ -152,6 +154,17 @@ func (p *servicePackage) NewConn(ctx context.Context, config map[string]any) (*r
 	return route53resolver_sdkv1.New(sess.Copy(&aws_sdkv1.Config{Endpoint: aws_sdkv1.String(config["endpoint"].(string))})), nil
 }
 
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*route53resolver_sdkv2.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws_sdkv2.Config))
+
+	return route53resolver_sdkv2.NewFromConfig(cfg, func(o *route53resolver_sdkv2.Options) {
+		if endpoint := config["endpoint"].(string); endpoint != "" {
+			o.BaseEndpoint = aws_sdkv2.String(endpoint)
+		}
+	}), nil
+}
+
 func ServicePackage(ctx context.Context) conns.ServicePackage {
 	return &servicePackage{}
 }
@@ -7,12 +7,16 @@ import (
 	"fmt"
 	"log"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
@@ -388,28 +392,23 @@ func sweepFirewallRules(region string) error {
 				continue
 			}
 
-			input := &route53resolver.ListFirewallRulesInput{
-				FirewallRuleGroupId: aws.String(id),
-			}
-
-			err := conn.ListFirewallRulesPagesWithContext(ctx, input, func(page *route53resolver.ListFirewallRulesOutput, lastPage bool) bool {
-				if page == nil {
-					return !lastPage
-				}
+			rules, err := findFirewallRules(ctx, client.Route53ResolverClient(ctx), id, tfslices.PredicateTrue[awstypes.FirewallRule]())
 
-				for _, v := range page.FirewallRules {
-					r := ResourceFirewallRule()
-					d := r.Data(nil)
-					d.SetId(FirewallRuleCreateResourceID(aws.StringValue(v.FirewallRuleGroupId), aws.StringValue(v.FirewallDomainListId)))
+			if awsv2.SkipSweepError(err) {
+				continue
+			}
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			for _, v := range rules {
+				r := ResourceFirewallRule()
+				d := r.Data(nil)
+				if v.FirewallThreatProtectionId != nil {
+					d.SetId(FirewallRuleCreateResourceID(aws_sdkv2.ToString(v.FirewallRuleGroupId), aws_sdkv2.ToString(v.FirewallThreatProtectionId), aws_sdkv2.ToString(v.Qtype)))
+					d.Set("firewall_threat_protection_id", v.FirewallThreatProtectionId)
+				} else {
+					d.SetId(FirewallRuleCreateResourceID(aws_sdkv2.ToString(v.FirewallRuleGroupId), aws_sdkv2.ToString(v.FirewallDomainListId), aws_sdkv2.ToString(v.Qtype)))
 				}
 
-				return !lastPage
-			})
-
-			if awsv1.SkipSweepError(err) {
-				continue
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 			}
 
 			if err != nil {
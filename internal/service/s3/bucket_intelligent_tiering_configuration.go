@@ -35,6 +35,8 @@ func resourceBucketIntelligentTieringConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validateIntelligentTieringTieringsCustomDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -218,6 +220,12 @@ func resourceBucketIntelligentTieringConfigurationDelete(ctx context.Context, d
 	return diags
 }
 
+const (
+	intelligentTieringArchiveAccessMinDays     = 90
+	intelligentTieringDeepArchiveAccessMinDays = 180
+	intelligentTieringMaxDays                  = 730
+)
+
 const bucketIntelligentTieringConfigurationResourceIDSeparator = ":"
 
 func BucketIntelligentTieringConfigurationCreateResourceID(bucketName, configurationName string) string {
@@ -263,6 +271,112 @@ func findIntelligentTieringConfiguration(ctx context.Context, conn *s3.Client, b
 	return output.IntelligentTieringConfiguration, nil
 }
 
+func findIntelligentTieringConfigurations(ctx context.Context, conn *s3.Client, bucket string) ([]types.IntelligentTieringConfiguration, error) {
+	input := &s3.ListBucketIntelligentTieringConfigurationsInput{
+		Bucket: aws.String(bucket),
+	}
+	var output []types.IntelligentTieringConfiguration
+
+	for {
+		page, err := conn.ListBucketIntelligentTieringConfigurations(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		output = append(output, page.IntelligentTieringConfigurationList...)
+
+		if !aws.ToBool(page.IsTruncated) {
+			break
+		}
+
+		input.ContinuationToken = page.NextContinuationToken
+	}
+
+	return output, nil
+}
+
+// validateIntelligentTieringTieringsCustomDiff enforces the archive access tier day thresholds at plan time.
+// Reference: https://docs.aws.amazon.com/AmazonS3/latest/userguide/intelligent-tiering-overview.html.
+func validateIntelligentTieringTieringsCustomDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	configRaw := d.GetRawConfig()
+	if !configRaw.IsKnown() || configRaw.IsNull() {
+		return nil
+	}
+
+	tieringsRaw := configRaw.GetAttr("tiering")
+	if !tieringsRaw.IsWhollyKnown() || tieringsRaw.IsNull() {
+		return nil
+	}
+
+	var tierings []types.Tiering
+	for _, tieringRaw := range tieringsRaw.AsValueSlice() {
+		if tieringRaw.IsNull() {
+			continue
+		}
+
+		accessTier, days := tieringRaw.GetAttr("access_tier"), tieringRaw.GetAttr("days")
+		if accessTier.IsNull() || days.IsNull() {
+			continue
+		}
+
+		v, _ := days.AsBigFloat().Int64()
+		tierings = append(tierings, types.Tiering{
+			AccessTier: types.IntelligentTieringAccessTier(accessTier.AsString()),
+			Days:       aws.Int32(int32(v)),
+		})
+	}
+
+	return validateIntelligentTieringTierings(tierings)
+}
+
+func validateIntelligentTieringTierings(tierings []types.Tiering) error {
+	days := make(map[types.IntelligentTieringAccessTier]int32, len(tierings))
+
+	for _, tiering := range tierings {
+		accessTier, v := tiering.AccessTier, aws.ToInt32(tiering.Days)
+
+		if _, ok := days[accessTier]; ok {
+			return fmt.Errorf("only one tiering may be configured for access tier %s", accessTier)
+		}
+		days[accessTier] = v
+
+		var minDays int32
+		switch accessTier {
+		case types.IntelligentTieringAccessTierArchiveAccess:
+			minDays = intelligentTieringArchiveAccessMinDays
+		case types.IntelligentTieringAccessTierDeepArchiveAccess:
+			minDays = intelligentTieringDeepArchiveAccessMinDays
+		default:
+			continue
+		}
+
+		if v < minDays || v > intelligentTieringMaxDays {
+			return fmt.Errorf("days for access tier %s must be between %d and %d, got: %d", accessTier, minDays, intelligentTieringMaxDays, v)
+		}
+	}
+
+	archiveDays, okArchive := days[types.IntelligentTieringAccessTierArchiveAccess]
+	deepArchiveDays, okDeepArchive := days[types.IntelligentTieringAccessTierDeepArchiveAccess]
+
+	if okArchive && okDeepArchive && deepArchiveDays <= archiveDays {
+		return fmt.Errorf("days for access tier %s (%d) must be greater than days for access tier %s (%d)", types.IntelligentTieringAccessTierDeepArchiveAccess, deepArchiveDays, types.IntelligentTieringAccessTierArchiveAccess, archiveDays)
+	}
+
+	return nil
+}
+
 func expandIntelligentTieringFilter(ctx context.Context, tfMap map[string]interface{}) *types.IntelligentTieringFilter {
 	if tfMap == nil {
 		return nil
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -214,6 +215,87 @@ func TestAccS3BucketIntelligentTieringConfiguration_directoryBucket(t *testing.T
 	})
 }
 
+func TestAccS3BucketIntelligentTieringConfiguration_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	var itc types.IntelligentTieringConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_intelligent_tiering_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketIntelligentTieringConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketIntelligentTieringConfigurationConfig_status(rName, "Enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationExists(ctx, resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "status", "Enabled"),
+				),
+			},
+			{
+				Config: testAccBucketIntelligentTieringConfigurationConfig_status(rName, "Disabled"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationExists(ctx, resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "status", "Disabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketIntelligentTieringConfigurationConfig_status(rName, "Enabled"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationExists(ctx, resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "status", "Enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketIntelligentTieringConfiguration_tieringValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketIntelligentTieringConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationConfig_tierings(rName, 89, 180),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`days for access tier ARCHIVE_ACCESS must be between 90 and 730, got: 89`),
+			},
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationConfig_tierings(rName, 90, 731),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`days for access tier DEEP_ARCHIVE_ACCESS must be between 180 and 730, got: 731`),
+			},
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationConfig_tierings(rName, 365, 180),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`days for access tier DEEP_ARCHIVE_ACCESS \(180\) must be greater than days for access tier ARCHIVE_ACCESS \(365\)`),
+			},
+		},
+	})
+}
+
 func testAccCheckBucketIntelligentTieringConfigurationExists(ctx context.Context, n string, v *types.IntelligentTieringConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -289,6 +371,48 @@ resource "aws_s3_bucket" "test" {
 `, rName)
 }
 
+func testAccBucketIntelligentTieringConfigurationConfig_status(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+  status = %[2]q
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 180
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName, status)
+}
+
+func testAccBucketIntelligentTieringConfigurationConfig_tierings(rName string, archiveDays, deepArchiveDays int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  tiering {
+    access_tier = "ARCHIVE_ACCESS"
+    days        = %[2]d
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = %[3]d
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName, archiveDays, deepArchiveDays)
+}
+
 func testAccBucketIntelligentTieringConfigurationConfig_filterPrefix(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
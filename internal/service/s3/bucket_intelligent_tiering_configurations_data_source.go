@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKDataSource("aws_s3_bucket_intelligent_tiering_configurations", name="Bucket Intelligent-Tiering Configurations")
+func dataSourceBucketIntelligentTieringConfigurations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBucketIntelligentTieringConfigurationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"tags": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tiering": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_tier": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"days": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceBucketIntelligentTieringConfigurationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get("bucket").(string)
+
+	output, err := findIntelligentTieringConfigurations(ctx, conn, bucket)
+
+	if err != nil {
+		return diag.Errorf("listing S3 Bucket (%s) Intelligent-Tiering Configurations: %s", bucket, err)
+	}
+
+	var names []string
+	for _, v := range output {
+		names = append(names, aws.ToString(v.Id))
+	}
+
+	d.SetId(bucket)
+	if err := d.Set("configurations", flattenIntelligentTieringConfigurations(ctx, output)); err != nil {
+		return diag.Errorf("setting configurations: %s", err)
+	}
+	d.Set("names", names)
+
+	return nil
+}
+
+func flattenIntelligentTieringConfigurations(ctx context.Context, apiObjects []types.IntelligentTieringConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"name":    aws.ToString(apiObject.Id),
+			"status":  apiObject.Status,
+			"tiering": flattenTierings(apiObject.Tierings),
+		}
+
+		if v := apiObject.Filter; v != nil {
+			tfMap["filter"] = []interface{}{flattenIntelligentTieringFilter(ctx, v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketIntelligentTieringConfigurationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_intelligent_tiering_configurations.test"
+	resourceName := "aws_s3_bucket_intelligent_tiering_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketIntelligentTieringConfigurationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "configurations.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", resourceName, "name"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", "aws_s3_bucket_intelligent_tiering_configuration.test2", "name"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "configurations.*", map[string]string{
+						"filter.#":        "1",
+						"filter.0.prefix": "documents/",
+						"status":          "Disabled",
+						"tiering.#":       "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketIntelligentTieringConfigurationsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = "%[1]s-1"
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 180
+  }
+}
+
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test2" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = "%[1]s-2"
+  status = "Disabled"
+
+  filter {
+    prefix = "documents/"
+  }
+
+  tiering {
+    access_tier = "ARCHIVE_ACCESS"
+    days        = 90
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 365
+  }
+}
+
+data "aws_s3_bucket_intelligent_tiering_configurations" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  depends_on = [
+    aws_s3_bucket_intelligent_tiering_configuration.test,
+    aws_s3_bucket_intelligent_tiering_configuration.test2,
+  ]
+}
+`, rName)
+}
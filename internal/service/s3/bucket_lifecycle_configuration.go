@@ -4,6 +4,7 @@
 package s3
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log"
@@ -251,6 +252,8 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: validateLifecycleRuleTransitionsCustomDiff,
 	}
 }
 
@@ -258,6 +261,9 @@ func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.R
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
 
 	bucket := d.Get("bucket").(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
 	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
 	rules := expandLifecycleRules(ctx, d.Get("rule").([]interface{}))
 	input := &s3.PutBucketLifecycleConfigurationInput{
@@ -274,10 +280,6 @@ func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.R
 		return conn.PutBucketLifecycleConfiguration(ctx, input)
 	}, errCodeNoSuchBucket)
 
-	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "LifecycleConfiguration is not valid, expected CreateBucketConfiguration") {
-		err = errDirectoryBucket(err)
-	}
-
 	if err != nil {
 		return diag.Errorf("creating S3 Bucket (%s) Lifecycle Configuration: %s", bucket, err)
 	}
@@ -301,6 +303,10 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	const (
 		lifecycleConfigurationExtraRetryDelay    = 5 * time.Second
 		lifecycleConfigurationRulesSteadyTimeout = 2 * time.Minute
@@ -361,6 +367,10 @@ func resourceBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	rules := expandLifecycleRules(ctx, d.Get("rule").([]interface{}))
 	input := &s3.PutBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
@@ -397,6 +407,10 @@ func resourceBucketLifecycleConfigurationDelete(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	input := &s3.DeleteBucketLifecycleInput{
 		Bucket: aws.String(bucket),
 	}
@@ -1056,3 +1070,156 @@ func flattenTransitions(transitions []types.Transition) []interface{} {
 
 	return results
 }
+
+// validateLifecycleRuleTransitionsCustomDiff verifies at plan time that each rule's transitions
+// move objects to progressively colder storage classes, as required by the S3 API.
+// Reference: https://docs.aws.amazon.com/AmazonS3/latest/userguide/lifecycle-transition-general-considerations.html.
+func validateLifecycleRuleTransitionsCustomDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	configRaw := d.GetRawConfig()
+	if !configRaw.IsKnown() || configRaw.IsNull() {
+		return nil
+	}
+
+	rulesRaw := configRaw.GetAttr("rule")
+	if !rulesRaw.IsKnown() || rulesRaw.IsNull() {
+		return nil
+	}
+
+	for i, ruleRaw := range rulesRaw.AsValueSlice() {
+		if !ruleRaw.IsKnown() || ruleRaw.IsNull() {
+			continue
+		}
+
+		transitionsRaw := ruleRaw.GetAttr("transition")
+		if !transitionsRaw.IsKnown() || transitionsRaw.IsNull() {
+			continue
+		}
+
+		var transitions []lifecycleTransitionConfig
+		for _, transitionRaw := range transitionsRaw.AsValueSlice() {
+			if !transitionRaw.IsWhollyKnown() || transitionRaw.IsNull() {
+				continue
+			}
+
+			storageClass := transitionRaw.GetAttr("storage_class")
+			if storageClass.IsNull() {
+				continue
+			}
+
+			transition := lifecycleTransitionConfig{
+				storageClass: types.TransitionStorageClass(storageClass.AsString()),
+			}
+
+			if v := transitionRaw.GetAttr("date"); !v.IsNull() {
+				transition.date = v.AsString()
+			}
+
+			if v := transitionRaw.GetAttr("days"); !v.IsNull() {
+				days, _ := v.AsBigFloat().Int64()
+				transition.days = aws.Int64(days)
+			}
+
+			transitions = append(transitions, transition)
+		}
+
+		if err := validateLifecycleTransitionOrder(transitions); err != nil {
+			return fmt.Errorf("rule[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+type lifecycleTransitionConfig struct {
+	date         string
+	days         *int64
+	storageClass types.TransitionStorageClass
+}
+
+// lifecycleTransitionsAllowed lists, for each lifecycle transition storage class, the storage classes that
+// objects in it can subsequently be transitioned to.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/lifecycle-transition-general-considerations.html.
+var lifecycleTransitionsAllowed = map[types.TransitionStorageClass][]types.TransitionStorageClass{
+	types.TransitionStorageClassStandardIa: {
+		types.TransitionStorageClassIntelligentTiering,
+		types.TransitionStorageClassOnezoneIa,
+		types.TransitionStorageClassGlacierIr,
+		types.TransitionStorageClassGlacier,
+		types.TransitionStorageClassDeepArchive,
+	},
+	types.TransitionStorageClassIntelligentTiering: {
+		types.TransitionStorageClassOnezoneIa,
+		types.TransitionStorageClassGlacierIr,
+		types.TransitionStorageClassGlacier,
+		types.TransitionStorageClassDeepArchive,
+	},
+	types.TransitionStorageClassOnezoneIa: {
+		types.TransitionStorageClassGlacier,
+		types.TransitionStorageClassDeepArchive,
+	},
+	types.TransitionStorageClassGlacierIr: {
+		types.TransitionStorageClassGlacier,
+		types.TransitionStorageClassDeepArchive,
+	},
+	types.TransitionStorageClassGlacier: {
+		types.TransitionStorageClassDeepArchive,
+	},
+	types.TransitionStorageClassDeepArchive: {},
+}
+
+func validateLifecycleTransitionOrder(transitions []lifecycleTransitionConfig) error {
+	var byDays, byDate []lifecycleTransitionConfig
+
+	for _, transition := range transitions {
+		if _, ok := lifecycleTransitionsAllowed[transition.storageClass]; !ok {
+			continue
+		}
+
+		switch {
+		case transition.date != "":
+			byDate = append(byDate, transition)
+		case transition.days != nil:
+			byDays = append(byDays, transition)
+		}
+	}
+
+	slices.SortStableFunc(byDays, func(a, b lifecycleTransitionConfig) int {
+		if c := cmp.Compare(aws.ToInt64(a.days), aws.ToInt64(b.days)); c != 0 {
+			return c
+		}
+		return compareLifecycleTransitionStorageClasses(a.storageClass, b.storageClass)
+	})
+	slices.SortStableFunc(byDate, func(a, b lifecycleTransitionConfig) int {
+		if c := strings.Compare(a.date, b.date); c != 0 {
+			return c
+		}
+		return compareLifecycleTransitionStorageClasses(a.storageClass, b.storageClass)
+	})
+
+	for i := 1; i < len(byDays); i++ {
+		prev, curr := byDays[i-1], byDays[i]
+		if !lifecycleTransitionAllowed(prev.storageClass, curr.storageClass) {
+			return fmt.Errorf("transition to %s after %d days cannot follow transition to %s after %d days: objects in %[3]s cannot transition to %[1]s", curr.storageClass, aws.ToInt64(curr.days), prev.storageClass, aws.ToInt64(prev.days))
+		}
+	}
+
+	for i := 1; i < len(byDate); i++ {
+		prev, curr := byDate[i-1], byDate[i]
+		if !lifecycleTransitionAllowed(prev.storageClass, curr.storageClass) {
+			return fmt.Errorf("transition to %s on %s cannot follow transition to %s on %s: objects in %[3]s cannot transition to %[1]s", curr.storageClass, curr.date, prev.storageClass, prev.date)
+		}
+	}
+
+	return nil
+}
+
+func lifecycleTransitionAllowed(from, to types.TransitionStorageClass) bool {
+	return slices.Contains(lifecycleTransitionsAllowed[from], to)
+}
+
+// compareLifecycleTransitionStorageClasses orders transitions on the same day so that a storage class sorts
+// before those that it can transition to.
+func compareLifecycleTransitionStorageClasses(a, b types.TransitionStorageClass) int {
+	// Storage classes with more allowed onward transitions are warmer.
+	return cmp.Compare(len(lifecycleTransitionsAllowed[b]), len(lifecycleTransitionsAllowed[a]))
+}
@@ -899,6 +899,78 @@ func TestAccS3BucketLifecycleConfiguration_TransitionUpdateBetweenDaysAndDate_in
 	})
 }
 
+func TestLifecycleTransitionAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		from, to types.TransitionStorageClass
+		expected bool
+	}{
+		{types.TransitionStorageClassStandardIa, types.TransitionStorageClassIntelligentTiering, true},
+		{types.TransitionStorageClassStandardIa, types.TransitionStorageClassOnezoneIa, true},
+		{types.TransitionStorageClassIntelligentTiering, types.TransitionStorageClassStandardIa, false},
+		{types.TransitionStorageClassOnezoneIa, types.TransitionStorageClassGlacier, true},
+		{types.TransitionStorageClassOnezoneIa, types.TransitionStorageClassGlacierIr, false},
+		{types.TransitionStorageClassGlacierIr, types.TransitionStorageClassOnezoneIa, false},
+		{types.TransitionStorageClassGlacierIr, types.TransitionStorageClassDeepArchive, true},
+		{types.TransitionStorageClassGlacier, types.TransitionStorageClassGlacier, false},
+		{types.TransitionStorageClassDeepArchive, types.TransitionStorageClassGlacier, false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := tfs3.LifecycleTransitionAllowed(testCase.from, testCase.to), testCase.expected; got != want {
+			t.Errorf("LifecycleTransitionAllowed(%s, %s) = %t, want %t", testCase.from, testCase.to, got, want)
+		}
+	}
+}
+
+func TestAccS3BucketLifecycleConfiguration_TransitionOrder(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_transitionOrder(rName, string(types.TransitionStorageClassDeepArchive), 30, string(types.TransitionStorageClassGlacierIr), 180),
+				ExpectError: regexache.MustCompile(`transition to GLACIER_IR after 180 days cannot follow transition to DEEP_ARCHIVE after 30 days`),
+			},
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_transitionOrder(rName, string(types.TransitionStorageClassGlacier), 30, string(types.TransitionStorageClassGlacier), 90),
+				ExpectError: regexache.MustCompile(`transition to GLACIER after 90 days cannot follow transition to GLACIER after 30 days`),
+			},
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_transitionOrder(rName, string(types.TransitionStorageClassOnezoneIa), 30, string(types.TransitionStorageClassGlacierIr), 90),
+				ExpectError: regexache.MustCompile(`transition to GLACIER_IR after 90 days cannot follow transition to ONEZONE_IA after 30 days`),
+			},
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_transitionOrder(rName, string(types.TransitionStorageClassGlacierIr), 30, string(types.TransitionStorageClassDeepArchive), 180),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transition.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.0.transition.*", map[string]string{
+						"days":          "30",
+						"storage_class": string(types.TransitionStorageClassGlacierIr),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.0.transition.*", map[string]string{
+						"days":          "180",
+						"storage_class": string(types.TransitionStorageClassDeepArchive),
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/23228
 func TestAccS3BucketLifecycleConfiguration_EmptyFilter_NonCurrentVersions(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -1052,6 +1124,7 @@ func TestAccS3BucketLifecycleConfiguration_Update_filterWithAndToFilterWithPrefi
 func TestAccS3BucketLifecycleConfiguration_directoryBucket(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
@@ -1060,8 +1133,21 @@ func TestAccS3BucketLifecycleConfiguration_directoryBucket(t *testing.T) {
 		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccBucketLifecycleConfigurationConfig_directoryBucket(rName),
-				ExpectError: regexache.MustCompile(`directory buckets are not supported`),
+				Config: testAccBucketLifecycleConfigurationConfig_directoryBucket(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_directory_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.id", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.status", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.expiration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.expiration.0.days", "365"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
@@ -1069,8 +1155,6 @@ func TestAccS3BucketLifecycleConfiguration_directoryBucket(t *testing.T) {
 
 func testAccCheckBucketLifecycleConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
-
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_s3_bucket_lifecycle_configuration" {
 				continue
@@ -1081,6 +1165,11 @@ func testAccCheckBucketLifecycleConfigurationDestroy(ctx context.Context) resour
 				return err
 			}
 
+			conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+			if tfs3.IsDirectoryBucket(bucket) {
+				conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
+			}
+
 			_, err = tfs3.FindLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
 
 			if tfresource.NotFound(err) {
@@ -1105,13 +1194,16 @@ func testAccCheckBucketLifecycleConfigurationExists(ctx context.Context, n strin
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
-
 		bucket, expectedBucketOwner, err := tfs3.ParseResourceID(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
 
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		if tfs3.IsDirectoryBucket(bucket) {
+			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
+		}
+
 		_, err = tfs3.FindLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
 
 		return err
@@ -1504,6 +1596,42 @@ resource "aws_s3_bucket_lifecycle_configuration" "test" {
 `, rName, storageClass)
 }
 
+func testAccBucketLifecycleConfigurationConfig_transitionOrder(rName, storageClass1 string, days1 int, storageClass2 string, days2 int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    id = %[1]q
+
+    filter {
+      and {
+        prefix                   = "logs/"
+        object_size_greater_than = 131072
+        object_size_less_than    = 1073741824
+      }
+    }
+
+    transition {
+      days          = %[3]d
+      storage_class = %[2]q
+    }
+
+    transition {
+      days          = %[5]d
+      storage_class = %[4]q
+    }
+
+    status = "Enabled"
+  }
+}
+`, rName, storageClass1, days1, storageClass2, days2)
+}
+
 func testAccBucketLifecycleConfigurationConfig_dateTransition(rName, transitionDate, storageClass string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
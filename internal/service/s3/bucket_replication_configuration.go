@@ -5,6 +5,7 @@ package s3
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -308,6 +309,8 @@ func resourceBucketReplicationConfiguration() *schema.Resource {
 				Sensitive: true,
 			},
 		},
+
+		CustomizeDiff: validateReplicationTimeControlCustomDiff,
 	}
 }
 
@@ -445,6 +448,56 @@ func resourceBucketReplicationConfigurationDelete(ctx context.Context, d *schema
 	return diags
 }
 
+// validateReplicationTimeControlCustomDiff verifies at plan time that each destination with
+// S3 Replication Time Control (RTC) enabled also enables replication metrics with an event threshold,
+// as RTC cannot be configured without them.
+func validateReplicationTimeControlCustomDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i, tfMapRaw := range d.Get("rule").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		tfList, ok := tfMap["destination"].([]interface{})
+		if !ok || len(tfList) == 0 || tfList[0] == nil {
+			continue
+		}
+
+		destination := tfList[0].(map[string]interface{})
+
+		tfList, ok = destination["replication_time"].([]interface{})
+		if !ok || len(tfList) == 0 || tfList[0] == nil {
+			continue
+		}
+
+		if status := tfList[0].(map[string]interface{})["status"].(string); status != string(types.ReplicationTimeStatusEnabled) {
+			continue
+		}
+
+		tfList, ok = destination["metrics"].([]interface{})
+		if !ok || len(tfList) == 0 || tfList[0] == nil {
+			return fmt.Errorf("rule[%d].destination[0].metrics must be configured when replication_time is %s", i, types.ReplicationTimeStatusEnabled)
+		}
+
+		metrics := tfList[0].(map[string]interface{})
+
+		switch status := metrics["status"].(string); status {
+		case "":
+			// Unknown at plan time.
+			continue
+		case string(types.MetricsStatusEnabled):
+		default:
+			return fmt.Errorf("rule[%d].destination[0].metrics[0].status must be %s when replication_time is %s", i, types.MetricsStatusEnabled, types.ReplicationTimeStatusEnabled)
+		}
+
+		if tfList, ok := metrics["event_threshold"].([]interface{}); !ok || len(tfList) == 0 || tfList[0] == nil {
+			return fmt.Errorf("rule[%d].destination[0].metrics[0].event_threshold must be configured when replication_time is %s", i, types.ReplicationTimeStatusEnabled)
+		}
+	}
+
+	return nil
+}
+
 func findReplicationConfiguration(ctx context.Context, conn *s3.Client, bucket string) (*types.ReplicationConfiguration, error) {
 	input := &s3.GetBucketReplicationInput{
 		Bucket: aws.String(bucket),
@@ -499,6 +499,50 @@ func TestAccS3BucketReplicationConfiguration_replicationTimeControl(t *testing.T
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccBucketReplicationConfigurationConfig_rtcDisabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"id":                                              "foobar",
+						"delete_marker_replication.#":                     "1",
+						"delete_marker_replication.0.status":              string(types.DeleteMarkerReplicationStatusDisabled),
+						"destination.#":                                   "1",
+						"destination.0.replication_time.#":                "1",
+						"destination.0.replication_time.0.status":         string(types.ReplicationTimeStatusDisabled),
+						"destination.0.replication_time.0.time.#":         "1",
+						"destination.0.replication_time.0.time.0.minutes": "15",
+						"destination.0.metrics.#":                         "1",
+						"destination.0.metrics.0.status":                  string(types.MetricsStatusDisabled),
+						"destination.0.metrics.0.event_threshold.#":       "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketReplicationConfiguration_replicationTimeControlWithoutMetrics(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		CheckDestroy:             acctest.CheckWithProviders(testAccCheckBucketReplicationConfigurationDestroyWithProvider(ctx), &providers),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketReplicationConfigurationConfig_rtcWithoutMetrics(rName),
+				ExpectError: regexache.MustCompile(`rule\[0\]\.destination\[0\]\.metrics must be configured when replication_time is Enabled`),
+			},
 		},
 	})
 }
@@ -1431,6 +1475,75 @@ resource "aws_s3_bucket_replication_configuration" "test" {
 }`)
 }
 
+func testAccBucketReplicationConfigurationConfig_rtcDisabled(rName string) string {
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_base(rName), `
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id = "foobar"
+    filter {
+      prefix = "foo"
+    }
+    status = "Enabled"
+    delete_marker_replication {
+      status = "Disabled"
+    }
+    destination {
+      bucket = aws_s3_bucket.destination.arn
+      replication_time {
+        status = "Disabled"
+        time {
+          minutes = 15
+        }
+      }
+      metrics {
+        status = "Disabled"
+      }
+    }
+  }
+}`)
+}
+
+func testAccBucketReplicationConfigurationConfig_rtcWithoutMetrics(rName string) string {
+	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_base(rName), `
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id = "foobar"
+    filter {
+      prefix = "foo"
+    }
+    status = "Enabled"
+    delete_marker_replication {
+      status = "Enabled"
+    }
+    destination {
+      bucket = aws_s3_bucket.destination.arn
+      replication_time {
+        status = "Enabled"
+        time {
+          minutes = 15
+        }
+      }
+    }
+  }
+}`)
+}
+
 func testAccBucketReplicationConfigurationConfig_replicaMods(rName string) string {
 	return acctest.ConfigCompose(testAccBucketReplicationConfigurationConfig_base(rName), `
 resource "aws_s3_bucket_replication_configuration" "test" {
@@ -57,6 +57,7 @@ var (
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
 	HostedZoneIDForRegion                 = hostedZoneIDForRegion
 	IsDirectoryBucket                     = isDirectoryBucket
+	LifecycleTransitionAllowed            = lifecycleTransitionAllowed
 	ObjectListTags                        = objectListTags
 	ObjectUpdateTags                      = objectUpdateTags
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
@@ -636,6 +636,15 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	if hasObjectContentChanges(d) {
+		// The checksums S3 computes for the new object content are only known after upload.
+		if o, n := d.GetChange("checksum_algorithm"); o.(string) != "" || n.(string) != "" {
+			for _, key := range []string{"checksum_crc32", "checksum_crc32c", "checksum_sha1", "checksum_sha256"} {
+				if err := d.SetNewComputed(key); err != nil {
+					return err
+				}
+			}
+		}
+
 		return d.SetNewComputed("version_id")
 	}
 
@@ -1663,6 +1663,18 @@ func TestAccS3Object_checksumAlgorithm(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg="),
 				),
 			},
+			{
+				Config: testAccObjectConfig_checksumAlgorithmContent(rName, "SHA256", "abcdefghijklmnopqrstuvwxyz"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "abcdefghijklmnopqrstuvwxyz"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "ccSA35PWri8e+tFEfGbJUl4xYhjPUfyNntgy8trxi3M="),
+				),
+			},
 		},
 	})
 }
@@ -2827,6 +2839,22 @@ resource "aws_s3_object" "object" {
 `, rName, checksumAlgorithm)
 }
 
+func testAccObjectConfig_checksumAlgorithmContent(rName, checksumAlgorithm, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = %[3]q
+
+  checksum_algorithm = %[2]q
+}
+`, rName, checksumAlgorithm, content)
+}
+
 func testAccObjectConfig_keyWithSlashes(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -42,6 +42,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_s3_bucket",
 			Name:     "Bucket",
 		},
+		{
+			Factory:  dataSourceBucketIntelligentTieringConfigurations,
+			TypeName: "aws_s3_bucket_intelligent_tiering_configurations",
+			Name:     "Bucket Intelligent-Tiering Configurations",
+		},
 		{
 			Factory:  dataSourceBucketObject,
 			TypeName: "aws_s3_bucket_object",
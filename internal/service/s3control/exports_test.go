@@ -20,6 +20,7 @@ var (
 	ResourceObjectLambdaAccessPoint            = resourceObjectLambdaAccessPoint
 	ResourceObjectLambdaAccessPointPolicy      = resourceObjectLambdaAccessPointPolicy
 	ResourceStorageLensConfiguration           = resourceStorageLensConfiguration
+	ResourceStorageLensGroup                   = resourceStorageLensGroup
 
 	FindAccessGrantByTwoPartKey                            = findAccessGrantByTwoPartKey
 	FindAccessGrantsInstance                               = findAccessGrantsInstance
@@ -37,4 +38,5 @@ var (
 	FindObjectLambdaAccessPointPolicyAndStatusByTwoPartKey = findObjectLambdaAccessPointPolicyAndStatusByTwoPartKey
 	FindPublicAccessBlockByAccountID                       = findPublicAccessBlockByAccountID
 	FindStorageLensConfigurationByAccountIDAndConfigID     = findStorageLensConfigurationByAccountIDAndConfigID
+	FindStorageLensGroupByTwoPartKey                       = findStorageLensGroupByTwoPartKey
 )
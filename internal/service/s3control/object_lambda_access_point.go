@@ -328,6 +328,26 @@ func ObjectLambdaAccessPointParseResourceID(id string) (string, string, error) {
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]saccess-point-name", id, objectLambdaAccessPointResourceIDSeparator)
 }
 
+// objectLambdaAccessPointCreateResourceIDFromARN converts an Object Lambda Access Point ARN to a resource ID.
+func objectLambdaAccessPointCreateResourceIDFromARN(objectLambdaAccessPointARN string) (string, error) {
+	v, err := arn.Parse(objectLambdaAccessPointARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	if service := v.Service; service != "s3-object-lambda" {
+		return "", fmt.Errorf("unexpected service: %s", service)
+	}
+
+	resource := v.Resource
+	if !strings.HasPrefix(resource, "accesspoint/") {
+		return "", fmt.Errorf("unexpected resource: %s", resource)
+	}
+
+	return ObjectLambdaAccessPointCreateResourceID(v.AccountID, strings.TrimPrefix(resource, "accesspoint/")), nil
+}
+
 func expandObjectLambdaConfiguration(tfMap map[string]interface{}) *types.ObjectLambdaConfiguration {
 	if tfMap == nil {
 		return nil
@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
@@ -30,7 +31,7 @@ func resourceObjectLambdaAccessPointPolicy() *schema.Resource {
 		DeleteWithoutTimeout: resourceObjectLambdaAccessPointPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceObjectLambdaAccessPointPolicyImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -186,6 +187,20 @@ func resourceObjectLambdaAccessPointPolicyDelete(ctx context.Context, d *schema.
 	return nil
 }
 
+func resourceObjectLambdaAccessPointPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if arn.IsARN(d.Id()) {
+		resourceID, err := objectLambdaAccessPointCreateResourceIDFromARN(d.Id())
+
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(resourceID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func findObjectLambdaAccessPointPolicyAndStatusByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, name string) (string, *types.PolicyStatus, error) {
 	inputGAPPFOL := &s3control.GetAccessPointPolicyForObjectLambdaInput{
 		AccountId: aws.String(accountID),
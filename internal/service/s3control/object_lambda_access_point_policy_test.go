@@ -44,6 +44,12 @@ func TestAccS3ControlObjectLambdaAccessPointPolicy_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccObjectLambdaAccessPointPolicyImportStateIdFunc("aws_s3control_object_lambda_access_point.test"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -135,6 +141,17 @@ func TestAccS3ControlObjectLambdaAccessPointPolicy_update(t *testing.T) {
 	})
 }
 
+func testAccObjectLambdaAccessPointPolicyImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["arn"], nil
+	}
+}
+
 func testAccCheckObjectLambdaAccessPointPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
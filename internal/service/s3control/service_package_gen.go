@@ -107,6 +107,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Storage Lens Configuration",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  resourceStorageLensGroup,
+			TypeName: "aws_s3control_storage_lens_group",
+			Name:     "Storage Lens Group",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 	}
 }
 
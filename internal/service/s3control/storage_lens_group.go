@@ -0,0 +1,605 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3control_storage_lens_group", name="Storage Lens Group")
+// @Tags
+func resourceStorageLensGroup() *schema.Resource {
+	storageLensGroupFilterConditionsSchema := func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"match_any_prefix": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"match_any_suffix": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"match_any_tag": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"match_object_age": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days_greater_than": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"days_less_than": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"match_object_size": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes_greater_than": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"bytes_less_than": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStorageLensGroupCreate,
+		ReadWithoutTimeout:   resourceStorageLensGroupRead,
+		UpdateWithoutTimeout: resourceStorageLensGroupUpdate,
+		DeleteWithoutTimeout: resourceStorageLensGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: func() map[string]*schema.Schema {
+						s := storageLensGroupFilterConditionsSchema()
+
+						s["and"] = &schema.Schema{
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"filter.0.or"},
+							Elem: &schema.Resource{
+								Schema: storageLensGroupFilterConditionsSchema(),
+							},
+						}
+						s["or"] = &schema.Schema{
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"filter.0.and"},
+							Elem: &schema.Resource{
+								Schema: storageLensGroupFilterConditionsSchema(),
+							},
+						}
+
+						return s
+					}(),
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceStorageLensGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	name := d.Get("name").(string)
+	id := storageLensGroupCreateResourceID(accountID, name)
+	input := &s3control.CreateStorageLensGroupInput{
+		AccountId: aws.String(accountID),
+		StorageLensGroup: &types.StorageLensGroup{
+			Name: aws.String(name),
+		},
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.StorageLensGroup.Filter = expandStorageLensGroupFilter(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreateStorageLensGroup(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating S3 Storage Lens Group (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceStorageLensGroupRead(ctx, d, meta)
+}
+
+func resourceStorageLensGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, name, err := storageLensGroupParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := findStorageLensGroupByTwoPartKey(ctx, conn, accountID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Storage Lens Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Storage Lens Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", output.StorageLensGroupArn)
+	if output.Filter != nil {
+		if err := d.Set("filter", []interface{}{flattenStorageLensGroupFilter(output.Filter)}); err != nil {
+			return diag.Errorf("setting filter: %s", err)
+		}
+	} else {
+		d.Set("filter", nil)
+	}
+	d.Set("name", output.Name)
+
+	tags, err := listTags(ctx, conn, aws.ToString(output.StorageLensGroupArn), accountID)
+
+	if err != nil {
+		return diag.Errorf("listing tags for S3 Storage Lens Group (%s): %s", d.Id(), err)
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	return nil
+}
+
+func resourceStorageLensGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, name, err := storageLensGroupParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &s3control.UpdateStorageLensGroupInput{
+			AccountId: aws.String(accountID),
+			Name:      aws.String(name),
+			StorageLensGroup: &types.StorageLensGroup{
+				Name: aws.String(name),
+			},
+		}
+
+		if v, ok := d.GetOk("filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.StorageLensGroup.Filter = expandStorageLensGroupFilter(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateStorageLensGroup(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating S3 Storage Lens Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := updateTags(ctx, conn, d.Get("arn").(string), accountID, o, n); err != nil {
+			return diag.Errorf("updating S3 Storage Lens Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceStorageLensGroupRead(ctx, d, meta)
+}
+
+func resourceStorageLensGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, name, err := storageLensGroupParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Storage Lens Group: %s", d.Id())
+	_, err = conn.DeleteStorageLensGroup(ctx, &s3control.DeleteStorageLensGroupInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	})
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting S3 Storage Lens Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const storageLensGroupResourceIDSeparator = ":"
+
+func storageLensGroupCreateResourceID(accountID, name string) string {
+	parts := []string{accountID, name}
+	id := strings.Join(parts, storageLensGroupResourceIDSeparator)
+
+	return id
+}
+
+func storageLensGroupParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, storageLensGroupResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]sname", id, storageLensGroupResourceIDSeparator)
+}
+
+func findStorageLensGroupByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, name string) (*types.StorageLensGroup, error) {
+	input := &s3control.GetStorageLensGroupInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	output, err := conn.GetStorageLensGroup(ctx, input)
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.StorageLensGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.StorageLensGroup, nil
+}
+
+func expandStorageLensGroupFilter(tfMap map[string]interface{}) *types.StorageLensGroupFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.StorageLensGroupFilter{}
+
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.And = expandStorageLensGroupAndOperator(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["match_any_prefix"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnyPrefix = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["match_any_suffix"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnySuffix = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["match_any_tag"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.MatchAnyTag = expandStorageLensGroupS3Tags(v)
+	}
+
+	if v, ok := tfMap["match_object_age"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MatchObjectAge = expandMatchObjectAge(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["match_object_size"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MatchObjectSize = expandMatchObjectSize(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["or"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Or = expandStorageLensGroupOrOperator(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandStorageLensGroupAndOperator(tfMap map[string]interface{}) *types.StorageLensGroupAndOperator {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.StorageLensGroupAndOperator{}
+
+	if v, ok := tfMap["match_any_prefix"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnyPrefix = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["match_any_suffix"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnySuffix = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["match_any_tag"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.MatchAnyTag = expandStorageLensGroupS3Tags(v)
+	}
+
+	if v, ok := tfMap["match_object_age"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MatchObjectAge = expandMatchObjectAge(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["match_object_size"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MatchObjectSize = expandMatchObjectSize(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandStorageLensGroupOrOperator(tfMap map[string]interface{}) *types.StorageLensGroupOrOperator {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.StorageLensGroupOrOperator{}
+
+	if v, ok := tfMap["match_any_prefix"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnyPrefix = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["match_any_suffix"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnySuffix = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["match_any_tag"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.MatchAnyTag = expandStorageLensGroupS3Tags(v)
+	}
+
+	if v, ok := tfMap["match_object_age"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MatchObjectAge = expandMatchObjectAge(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["match_object_size"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MatchObjectSize = expandMatchObjectSize(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandStorageLensGroupS3Tags(tfMap map[string]interface{}) []types.S3Tag {
+	apiObjects := make([]types.S3Tag, 0, len(tfMap))
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, types.S3Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandMatchObjectAge(tfMap map[string]interface{}) *types.MatchObjectAge {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.MatchObjectAge{}
+
+	if v, ok := tfMap["days_greater_than"].(int); ok && v > 0 {
+		apiObject.DaysGreaterThan = int32(v)
+	}
+
+	if v, ok := tfMap["days_less_than"].(int); ok && v > 0 {
+		apiObject.DaysLessThan = int32(v)
+	}
+
+	return apiObject
+}
+
+func expandMatchObjectSize(tfMap map[string]interface{}) *types.MatchObjectSize {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.MatchObjectSize{}
+
+	if v, ok := tfMap["bytes_greater_than"].(int); ok && v > 0 {
+		apiObject.BytesGreaterThan = int64(v)
+	}
+
+	if v, ok := tfMap["bytes_less_than"].(int); ok && v > 0 {
+		apiObject.BytesLessThan = int64(v)
+	}
+
+	return apiObject
+}
+
+func flattenStorageLensGroupFilter(apiObject *types.StorageLensGroupFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"match_any_prefix": apiObject.MatchAnyPrefix,
+		"match_any_suffix": apiObject.MatchAnySuffix,
+		"match_any_tag":    flattenStorageLensGroupS3Tags(apiObject.MatchAnyTag),
+	}
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = []interface{}{flattenStorageLensGroupAndOperator(v)}
+	}
+
+	if v := apiObject.MatchObjectAge; v != nil {
+		tfMap["match_object_age"] = []interface{}{flattenMatchObjectAge(v)}
+	}
+
+	if v := apiObject.MatchObjectSize; v != nil {
+		tfMap["match_object_size"] = []interface{}{flattenMatchObjectSize(v)}
+	}
+
+	if v := apiObject.Or; v != nil {
+		tfMap["or"] = []interface{}{flattenStorageLensGroupOrOperator(v)}
+	}
+
+	return tfMap
+}
+
+func flattenStorageLensGroupAndOperator(apiObject *types.StorageLensGroupAndOperator) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"match_any_prefix": apiObject.MatchAnyPrefix,
+		"match_any_suffix": apiObject.MatchAnySuffix,
+		"match_any_tag":    flattenStorageLensGroupS3Tags(apiObject.MatchAnyTag),
+	}
+
+	if v := apiObject.MatchObjectAge; v != nil {
+		tfMap["match_object_age"] = []interface{}{flattenMatchObjectAge(v)}
+	}
+
+	if v := apiObject.MatchObjectSize; v != nil {
+		tfMap["match_object_size"] = []interface{}{flattenMatchObjectSize(v)}
+	}
+
+	return tfMap
+}
+
+func flattenStorageLensGroupOrOperator(apiObject *types.StorageLensGroupOrOperator) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"match_any_prefix": apiObject.MatchAnyPrefix,
+		"match_any_suffix": apiObject.MatchAnySuffix,
+		"match_any_tag":    flattenStorageLensGroupS3Tags(apiObject.MatchAnyTag),
+	}
+
+	if v := apiObject.MatchObjectAge; v != nil {
+		tfMap["match_object_age"] = []interface{}{flattenMatchObjectAge(v)}
+	}
+
+	if v := apiObject.MatchObjectSize; v != nil {
+		tfMap["match_object_size"] = []interface{}{flattenMatchObjectSize(v)}
+	}
+
+	return tfMap
+}
+
+func flattenStorageLensGroupS3Tags(apiObjects []types.S3Tag) map[string]interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{}, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap[aws.ToString(apiObject.Key)] = aws.ToString(apiObject.Value)
+	}
+
+	return tfMap
+}
+
+func flattenMatchObjectAge(apiObject *types.MatchObjectAge) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"days_greater_than": apiObject.DaysGreaterThan,
+		"days_less_than":    apiObject.DaysLessThan,
+	}
+
+	return tfMap
+}
+
+func flattenMatchObjectSize(apiObject *types.MatchObjectSize) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bytes_greater_than": apiObject.BytesGreaterThan,
+		"bytes_less_than":    apiObject.BytesLessThan,
+	}
+
+	return tfMap
+}
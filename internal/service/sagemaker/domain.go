@@ -1684,7 +1684,7 @@ func expandModelRegisterSettings(l []interface{}) *sagemaker.ModelRegisterSettin
 
 	config := &sagemaker.ModelRegisterSettings{}
 
-	if v, ok := m["model_register_settings"].(string); ok && v != "" {
+	if v, ok := m["cross_account_model_register_role_arn"].(string); ok && v != "" {
 		config.CrossAccountModelRegisterRoleArn = aws.String(v)
 	}
 
@@ -299,7 +299,8 @@ func testAccDomain_modelRegisterSettings(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "default_user_settings.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "default_user_settings.0.canvas_app_settings.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "default_user_settings.0.canvas_app_settings.0.model_register_settings.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "default_user_settings.0.canvas_app_settings.0.model_register_settings.0.status", "DISABLED"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_user_settings.0.canvas_app_settings.0.model_register_settings.0.cross_account_model_register_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "default_user_settings.0.canvas_app_settings.0.model_register_settings.0.status", "ENABLED"),
 				),
 			},
 			{
@@ -1452,7 +1453,8 @@ resource "aws_sagemaker_domain" "test" {
 
     canvas_app_settings {
       model_register_settings {
-        status = "DISABLED"
+        cross_account_model_register_role_arn = aws_iam_role.test.arn
+        status                                = "ENABLED"
       }
     }
   }
@@ -224,6 +224,12 @@ func ResourceEndpointConfiguration() *schema.Resource {
 					},
 				},
 			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"kms_key_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -314,6 +320,34 @@ func ResourceEndpointConfiguration() *schema.Resource {
 							ForceNew:     true,
 							ValidateFunc: validation.StringInSlice(sagemaker.ProductionVariantInstanceType_Values(), false),
 						},
+						"managed_instance_scaling": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_instance_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"min_instance_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(sagemaker.ManagedInstanceScalingStatus_Values(), false),
+									},
+								},
+							},
+						},
 						"model_data_download_timeout_in_seconds": {
 							Type:         schema.TypeInt,
 							Optional:     true,
@@ -322,12 +356,13 @@ func ResourceEndpointConfiguration() *schema.Resource {
 						},
 						"model_name": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 							ForceNew: true,
 						},
 						"routing_config": {
 							Type:     schema.TypeList,
 							Optional: true,
+							MaxItems: 1,
 							ForceNew: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -452,6 +487,34 @@ func ResourceEndpointConfiguration() *schema.Resource {
 							ForceNew:     true,
 							ValidateFunc: validation.StringInSlice(sagemaker.ProductionVariantInstanceType_Values(), false),
 						},
+						"managed_instance_scaling": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_instance_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"min_instance_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(sagemaker.ManagedInstanceScalingStatus_Values(), false),
+									},
+								},
+							},
+						},
 						"model_data_download_timeout_in_seconds": {
 							Type:         schema.TypeInt,
 							Optional:     true,
@@ -460,12 +523,13 @@ func ResourceEndpointConfiguration() *schema.Resource {
 						},
 						"model_name": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 							ForceNew: true,
 						},
 						"routing_config": {
 							Type:     schema.TypeList,
 							Optional: true,
+							MaxItems: 1,
 							ForceNew: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -541,6 +605,10 @@ func resourceEndpointConfigurationCreate(ctx context.Context, d *schema.Resource
 		Tags:               getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("execution_role_arn"); ok {
+		createOpts.ExecutionRoleArn = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("kms_key_arn"); ok {
 		createOpts.KmsKeyId = aws.String(v.(string))
 	}
@@ -586,6 +654,7 @@ func resourceEndpointConfigurationRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("arn", endpointConfig.EndpointConfigArn)
 	d.Set("name", endpointConfig.EndpointConfigName)
 	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(endpointConfig.EndpointConfigName)))
+	d.Set("execution_role_arn", endpointConfig.ExecutionRoleArn)
 	d.Set("kms_key_arn", endpointConfig.KmsKeyId)
 
 	if err := d.Set("production_variants", flattenProductionVariants(endpointConfig.ProductionVariants)); err != nil {
@@ -643,8 +712,11 @@ func expandProductionVariants(configured []interface{}) []*sagemaker.ProductionV
 	for _, lRaw := range configured {
 		data := lRaw.(map[string]interface{})
 
-		l := &sagemaker.ProductionVariant{
-			ModelName: aws.String(data["model_name"].(string)),
+		l := &sagemaker.ProductionVariant{}
+
+		// Variants that host inference components don't reference a model.
+		if v, ok := data["model_name"].(string); ok && v != "" {
+			l.ModelName = aws.String(v)
 		}
 
 		if v, ok := data["initial_instance_count"].(int); ok && v > 0 {
@@ -681,6 +753,10 @@ func expandProductionVariants(configured []interface{}) []*sagemaker.ProductionV
 			l.AcceleratorType = aws.String(v)
 		}
 
+		if v, ok := data["managed_instance_scaling"].([]interface{}); ok && len(v) > 0 {
+			l.ManagedInstanceScaling = expandManagedInstanceScaling(v)
+		}
+
 		if v, ok := data["routing_config"].([]interface{}); ok && len(v) > 0 {
 			l.RoutingConfig = expandRoutingConfig(v)
 		}
@@ -734,6 +810,10 @@ func flattenProductionVariants(list []*sagemaker.ProductionVariant) []map[string
 			l["instance_type"] = aws.StringValue(i.InstanceType)
 		}
 
+		if i.ManagedInstanceScaling != nil {
+			l["managed_instance_scaling"] = flattenManagedInstanceScaling(i.ManagedInstanceScaling)
+		}
+
 		if i.RoutingConfig != nil {
 			l["routing_config"] = flattenRoutingConfig(i.RoutingConfig)
 		}
@@ -954,6 +1034,30 @@ func expandEndpointConfigNotificationConfig(configured []interface{}) *sagemaker
 	return c
 }
 
+func expandManagedInstanceScaling(configured []interface{}) *sagemaker.ProductionVariantManagedInstanceScaling {
+	if len(configured) == 0 {
+		return nil
+	}
+
+	m := configured[0].(map[string]interface{})
+
+	c := &sagemaker.ProductionVariantManagedInstanceScaling{}
+
+	if v, ok := m["max_instance_count"].(int); ok && v > 0 {
+		c.MaxInstanceCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["min_instance_count"].(int); ok && v > 0 {
+		c.MinInstanceCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["status"].(string); ok && v != "" {
+		c.Status = aws.String(v)
+	}
+
+	return c
+}
+
 func expandRoutingConfig(configured []interface{}) *sagemaker.ProductionVariantRoutingConfig {
 	if len(configured) == 0 {
 		return nil
@@ -1092,6 +1196,28 @@ func flattenEndpointConfigNotificationConfig(config *sagemaker.AsyncInferenceNot
 	return []map[string]interface{}{cfg}
 }
 
+func flattenManagedInstanceScaling(config *sagemaker.ProductionVariantManagedInstanceScaling) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	cfg := map[string]interface{}{}
+
+	if config.MaxInstanceCount != nil {
+		cfg["max_instance_count"] = aws.Int64Value(config.MaxInstanceCount)
+	}
+
+	if config.MinInstanceCount != nil {
+		cfg["min_instance_count"] = aws.Int64Value(config.MinInstanceCount)
+	}
+
+	if config.Status != nil {
+		cfg["status"] = aws.StringValue(config.Status)
+	}
+
+	return []map[string]interface{}{cfg}
+}
+
 func flattenRoutingConfig(config *sagemaker.ProductionVariantRoutingConfig) []map[string]interface{} {
 	if config == nil {
 		return []map[string]interface{}{}
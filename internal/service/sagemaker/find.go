@@ -458,6 +458,31 @@ func FindEndpointByName(ctx context.Context, conn *sagemaker.SageMaker, name str
 	return output, nil
 }
 
+func FindInferenceComponentByName(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeInferenceComponentOutput, error) {
+	input := &sagemaker.DescribeInferenceComponentInput{
+		InferenceComponentName: aws.String(name),
+	}
+
+	output, err := conn.DescribeInferenceComponentWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, ErrCodeValidationException, "Could not find inference component") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindEndpointConfigByName(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeEndpointConfigOutput, error) {
 	input := &sagemaker.DescribeEndpointConfigInput{
 		EndpointConfigName: aws.String(name),
@@ -0,0 +1,530 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sagemaker_inference_component", name="Inference Component")
+// @Tags(identifierAttribute="arn")
+func ResourceInferenceComponent() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInferenceComponentCreate,
+		ReadWithoutTimeout:   resourceInferenceComponentRead,
+		UpdateWithoutTimeout: resourceInferenceComponentUpdate,
+		DeleteWithoutTimeout: resourceInferenceComponentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"runtime_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"copy_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"current_copy_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_resource_requirements": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_memory_required_in_mb": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(128),
+									},
+									"min_memory_required_in_mb": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(128),
+									},
+									"number_of_accelerator_devices_required": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(1),
+									},
+									"number_of_cpu_cores_required": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(0.25),
+									},
+								},
+							},
+						},
+						"container": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"artifact_url": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"deployed_image": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"resolution_time": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"resolved_image": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"specified_image": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"environment": {
+										Type:         schema.TypeMap,
+										Optional:     true,
+										ValidateFunc: validEnvironment,
+										Elem:         &schema.Schema{Type: schema.TypeString},
+									},
+									"image": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validImage,
+									},
+								},
+							},
+						},
+						"model_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validName,
+						},
+						"startup_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_startup_health_check_timeout_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(60, 3600),
+									},
+									"model_data_download_timeout_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(60, 3600),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"variant_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceInferenceComponentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &sagemaker.CreateInferenceComponentInput{
+		EndpointName:           aws.String(d.Get("endpoint_name").(string)),
+		InferenceComponentName: aws.String(name),
+		RuntimeConfig:          expandInferenceComponentRuntimeConfig(d.Get("runtime_config").([]interface{})),
+		Specification:          expandInferenceComponentSpecification(d.Get("specification").([]interface{})),
+		Tags:                   getTagsIn(ctx),
+		VariantName:            aws.String(d.Get("variant_name").(string)),
+	}
+
+	_, err := conn.CreateInferenceComponentWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SageMaker Inference Component (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := WaitInferenceComponentInService(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Inference Component (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceInferenceComponentRead(ctx, d, meta)...)
+}
+
+func resourceInferenceComponentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	output, err := FindInferenceComponentByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SageMaker Inference Component (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SageMaker Inference Component (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.InferenceComponentArn)
+	d.Set("endpoint_name", output.EndpointName)
+	d.Set("name", output.InferenceComponentName)
+	if err := d.Set("runtime_config", flattenInferenceComponentRuntimeConfigSummary(output.RuntimeConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting runtime_config: %s", err)
+	}
+	if err := d.Set("specification", flattenInferenceComponentSpecificationSummary(output.Specification)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting specification: %s", err)
+	}
+	d.Set("status", output.InferenceComponentStatus)
+	d.Set("variant_name", output.VariantName)
+
+	return diags
+}
+
+func resourceInferenceComponentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	// Scaling the number of copies doesn't redeploy the inference component.
+	if d.HasChange("runtime_config") && !d.HasChange("specification") {
+		input := &sagemaker.UpdateInferenceComponentRuntimeConfigInput{
+			DesiredRuntimeConfig:   expandInferenceComponentRuntimeConfig(d.Get("runtime_config").([]interface{})),
+			InferenceComponentName: aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateInferenceComponentRuntimeConfigWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker Inference Component (%s) runtime config: %s", d.Id(), err)
+		}
+
+		if _, err := WaitInferenceComponentInService(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Inference Component (%s) update: %s", d.Id(), err)
+		}
+	} else if d.HasChange("specification") {
+		input := &sagemaker.UpdateInferenceComponentInput{
+			InferenceComponentName: aws.String(d.Id()),
+			Specification:          expandInferenceComponentSpecification(d.Get("specification").([]interface{})),
+		}
+
+		if d.HasChange("runtime_config") {
+			input.RuntimeConfig = expandInferenceComponentRuntimeConfig(d.Get("runtime_config").([]interface{}))
+		}
+
+		_, err := conn.UpdateInferenceComponentWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker Inference Component (%s): %s", d.Id(), err)
+		}
+
+		if _, err := WaitInferenceComponentInService(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Inference Component (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceInferenceComponentRead(ctx, d, meta)...)
+}
+
+func resourceInferenceComponentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	log.Printf("[INFO] Deleting SageMaker Inference Component: %s", d.Id())
+	_, err := conn.DeleteInferenceComponentWithContext(ctx, &sagemaker.DeleteInferenceComponentInput{
+		InferenceComponentName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrMessageContains(err, ErrCodeValidationException, "Could not find inference component") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SageMaker Inference Component (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitInferenceComponentDeleted(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Inference Component (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandInferenceComponentRuntimeConfig(tfList []interface{}) *sagemaker.InferenceComponentRuntimeConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &sagemaker.InferenceComponentRuntimeConfig{
+		CopyCount: aws.Int64(int64(tfMap["copy_count"].(int))),
+	}
+}
+
+func expandInferenceComponentSpecification(tfList []interface{}) *sagemaker.InferenceComponentSpecification {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &sagemaker.InferenceComponentSpecification{}
+
+	if v, ok := tfMap["compute_resource_requirements"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ComputeResourceRequirements = expandInferenceComponentComputeResourceRequirements(v)
+	}
+
+	if v, ok := tfMap["container"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Container = expandInferenceComponentContainerSpecification(v)
+	}
+
+	if v, ok := tfMap["model_name"].(string); ok && v != "" {
+		apiObject.ModelName = aws.String(v)
+	}
+
+	if v, ok := tfMap["startup_parameters"].([]interface{}); ok && len(v) > 0 {
+		apiObject.StartupParameters = expandInferenceComponentStartupParameters(v)
+	}
+
+	return apiObject
+}
+
+func expandInferenceComponentComputeResourceRequirements(tfList []interface{}) *sagemaker.InferenceComponentComputeResourceRequirements {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &sagemaker.InferenceComponentComputeResourceRequirements{
+		MinMemoryRequiredInMb: aws.Int64(int64(tfMap["min_memory_required_in_mb"].(int))),
+	}
+
+	if v, ok := tfMap["max_memory_required_in_mb"].(int); ok && v > 0 {
+		apiObject.MaxMemoryRequiredInMb = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["number_of_accelerator_devices_required"].(float64); ok && v > 0 {
+		apiObject.NumberOfAcceleratorDevicesRequired = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["number_of_cpu_cores_required"].(float64); ok && v > 0 {
+		apiObject.NumberOfCpuCoresRequired = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandInferenceComponentContainerSpecification(tfList []interface{}) *sagemaker.InferenceComponentContainerSpecification {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &sagemaker.InferenceComponentContainerSpecification{}
+
+	if v, ok := tfMap["artifact_url"].(string); ok && v != "" {
+		apiObject.ArtifactUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["environment"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Environment = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["image"].(string); ok && v != "" {
+		apiObject.Image = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandInferenceComponentStartupParameters(tfList []interface{}) *sagemaker.InferenceComponentStartupParameters {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &sagemaker.InferenceComponentStartupParameters{}
+
+	if v, ok := tfMap["container_startup_health_check_timeout_in_seconds"].(int); ok && v > 0 {
+		apiObject.ContainerStartupHealthCheckTimeoutInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["model_data_download_timeout_in_seconds"].(int); ok && v > 0 {
+		apiObject.ModelDataDownloadTimeoutInSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenInferenceComponentRuntimeConfigSummary(apiObject *sagemaker.InferenceComponentRuntimeConfigSummary) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"copy_count":         aws.Int64Value(apiObject.DesiredCopyCount),
+		"current_copy_count": aws.Int64Value(apiObject.CurrentCopyCount),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInferenceComponentSpecificationSummary(apiObject *sagemaker.InferenceComponentSpecificationSummary) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"model_name": aws.StringValue(apiObject.ModelName),
+	}
+
+	if v := apiObject.ComputeResourceRequirements; v != nil {
+		tfMap["compute_resource_requirements"] = flattenInferenceComponentComputeResourceRequirements(v)
+	}
+
+	if v := apiObject.Container; v != nil {
+		tfMap["container"] = flattenInferenceComponentContainerSpecificationSummary(v)
+	}
+
+	if v := apiObject.StartupParameters; v != nil {
+		tfMap["startup_parameters"] = flattenInferenceComponentStartupParameters(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInferenceComponentComputeResourceRequirements(apiObject *sagemaker.InferenceComponentComputeResourceRequirements) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"max_memory_required_in_mb":              aws.Int64Value(apiObject.MaxMemoryRequiredInMb),
+		"min_memory_required_in_mb":              aws.Int64Value(apiObject.MinMemoryRequiredInMb),
+		"number_of_accelerator_devices_required": aws.Float64Value(apiObject.NumberOfAcceleratorDevicesRequired),
+		"number_of_cpu_cores_required":           aws.Float64Value(apiObject.NumberOfCpuCoresRequired),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInferenceComponentContainerSpecificationSummary(apiObject *sagemaker.InferenceComponentContainerSpecificationSummary) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"artifact_url": aws.StringValue(apiObject.ArtifactUrl),
+		"environment":  aws.StringValueMap(apiObject.Environment),
+	}
+
+	if v := apiObject.DeployedImage; v != nil {
+		tfMap["deployed_image"] = flattenDeployedImage(v)
+		tfMap["image"] = aws.StringValue(v.SpecifiedImage)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInferenceComponentStartupParameters(apiObject *sagemaker.InferenceComponentStartupParameters) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"container_startup_health_check_timeout_in_seconds": aws.Int64Value(apiObject.ContainerStartupHealthCheckTimeoutInSeconds),
+		"model_data_download_timeout_in_seconds":            aws.Int64Value(apiObject.ModelDataDownloadTimeoutInSeconds),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDeployedImage(apiObject *sagemaker.DeployedImage) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"resolved_image":  aws.StringValue(apiObject.ResolvedImage),
+		"specified_image": aws.StringValue(apiObject.SpecifiedImage),
+	}
+
+	if v := apiObject.ResolutionTime; v != nil {
+		tfMap["resolution_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
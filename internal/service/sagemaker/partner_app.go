@@ -0,0 +1,473 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Partner App")
+// @Tags(identifierAttribute="arn")
+func newPartnerAppResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &partnerAppResource{}
+
+	// Partner AI Apps provision a dedicated cluster and can take well over an hour to become available.
+	r.SetDefaultCreateTimeout(120 * time.Minute)
+	r.SetDefaultUpdateTimeout(120 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type partnerAppResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *partnerAppResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_sagemaker_partner_app"
+}
+
+func (r *partnerAppResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"auth_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PartnerAppAuthType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"base_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_iam_session_based_identity": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"execution_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"tier": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PartnerAppType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"application_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[partnerAppConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"admin_users": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"arguments": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"maintenance_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[partnerAppMaintenanceConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"maintenance_window_start": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *partnerAppResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data partnerAppResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SageMakerClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &sagemaker.CreatePartnerAppInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsInV2(ctx)
+
+	// Retry for IAM eventual consistency.
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreatePartnerApp(ctx, input)
+	}, ErrCodeValidationException, "role")
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("creating SageMaker Partner App (%s)", name), err))
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, outputRaw.(*sagemaker.CreatePartnerAppOutput).Arn)
+
+	output, err := waitPartnerAppCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for SageMaker Partner App (%s) create", name), err))
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.BaseURL = fwflex.StringToFramework(ctx, output.BaseUrl)
+	data.EnableIAMSessionBasedIdentity = fwflex.BoolToFramework(ctx, output.EnableIamSessionBasedIdentity)
+	data.Version = fwflex.StringToFramework(ctx, output.Version)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *partnerAppResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data partnerAppResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SageMakerClient(ctx)
+
+	output, err := findPartnerAppByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("reading SageMaker Partner App (%s)", data.ID.ValueString()), err))
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *partnerAppResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new partnerAppResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SageMakerClient(ctx)
+
+	if !new.ApplicationConfig.Equal(old.ApplicationConfig) ||
+		!new.EnableIAMSessionBasedIdentity.Equal(old.EnableIAMSessionBasedIdentity) ||
+		!new.MaintenanceConfig.Equal(old.MaintenanceConfig) ||
+		!new.Tier.Equal(old.Tier) {
+		input := &sagemaker.UpdatePartnerAppInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Arn = fwflex.StringFromFramework(ctx, new.ID)
+		input.ClientToken = aws.String(sdkid.UniqueId())
+		input.Tags = nil
+
+		_, err := conn.UpdatePartnerApp(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("updating SageMaker Partner App (%s)", new.ID.ValueString()), err))
+
+			return
+		}
+
+		output, err := waitPartnerAppUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for SageMaker Partner App (%s) update", new.ID.ValueString()), err))
+
+			return
+		}
+
+		new.EnableIAMSessionBasedIdentity = fwflex.BoolToFramework(ctx, output.EnableIamSessionBasedIdentity)
+		new.Version = fwflex.StringToFramework(ctx, output.Version)
+	} else {
+		new.Version = old.Version
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *partnerAppResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data partnerAppResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SageMakerClient(ctx)
+
+	_, err := conn.DeletePartnerApp(ctx, &sagemaker.DeletePartnerAppInput{
+		Arn:         fwflex.StringFromFramework(ctx, data.ID),
+		ClientToken: aws.String(sdkid.UniqueId()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFound](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("deleting SageMaker Partner App (%s)", data.ID.ValueString()), err))
+
+		return
+	}
+
+	if _, err := waitPartnerAppDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.Append(fwdiag.NewErrorDiagnostic(fmt.Sprintf("waiting for SageMaker Partner App (%s) delete", data.ID.ValueString()), err))
+
+		return
+	}
+}
+
+func (r *partnerAppResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findPartnerAppByARN(ctx context.Context, conn *sagemaker.Client, arn string) (*sagemaker.DescribePartnerAppOutput, error) {
+	input := &sagemaker.DescribePartnerAppInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.DescribePartnerApp(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Status; status == awstypes.PartnerAppStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusPartnerApp(ctx context.Context, conn *sagemaker.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPartnerAppByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitPartnerAppCreated(ctx context.Context, conn *sagemaker.Client, arn string, timeout time.Duration) (*sagemaker.DescribePartnerAppOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.PartnerAppStatusCreating),
+		Target:     enum.Slice(awstypes.PartnerAppStatusAvailable),
+		Refresh:    statusPartnerApp(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      2 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribePartnerAppOutput); ok {
+		setPartnerAppLastError(err, output)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPartnerAppUpdated(ctx context.Context, conn *sagemaker.Client, arn string, timeout time.Duration) (*sagemaker.DescribePartnerAppOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.PartnerAppStatusUpdating),
+		Target:     enum.Slice(awstypes.PartnerAppStatusAvailable),
+		Refresh:    statusPartnerApp(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribePartnerAppOutput); ok {
+		setPartnerAppLastError(err, output)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPartnerAppDeleted(ctx context.Context, conn *sagemaker.Client, arn string, timeout time.Duration) (*sagemaker.DescribePartnerAppOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.PartnerAppStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusPartnerApp(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribePartnerAppOutput); ok {
+		setPartnerAppLastError(err, output)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func setPartnerAppLastError(err error, output *sagemaker.DescribePartnerAppOutput) {
+	if v := output.Error; v != nil {
+		tfresource.SetLastError(err, errors.New(aws.ToString(v.Reason)))
+	}
+}
+
+type partnerAppResourceModel struct {
+	ApplicationConfig             fwtypes.ListNestedObjectValueOf[partnerAppConfigModel]            `tfsdk:"application_config"`
+	ARN                           types.String                                                      `tfsdk:"arn"`
+	AuthType                      fwtypes.StringEnum[awstypes.PartnerAppAuthType]                   `tfsdk:"auth_type"`
+	BaseURL                       types.String                                                      `tfsdk:"base_url"`
+	EnableIAMSessionBasedIdentity types.Bool                                                        `tfsdk:"enable_iam_session_based_identity"`
+	ExecutionRoleARN              fwtypes.ARN                                                       `tfsdk:"execution_role_arn"`
+	ID                            types.String                                                      `tfsdk:"id"`
+	MaintenanceConfig             fwtypes.ListNestedObjectValueOf[partnerAppMaintenanceConfigModel] `tfsdk:"maintenance_config"`
+	Name                          types.String                                                      `tfsdk:"name"`
+	Tags                          types.Map                                                         `tfsdk:"tags"`
+	TagsAll                       types.Map                                                         `tfsdk:"tags_all"`
+	Tier                          types.String                                                      `tfsdk:"tier"`
+	Timeouts                      timeouts.Value                                                    `tfsdk:"timeouts"`
+	Type                          fwtypes.StringEnum[awstypes.PartnerAppType]                       `tfsdk:"type"`
+	Version                       types.String                                                      `tfsdk:"version"`
+}
+
+type partnerAppConfigModel struct {
+	AdminUsers fwtypes.SetValueOf[types.String] `tfsdk:"admin_users"`
+	Arguments  fwtypes.MapValueOf[types.String] `tfsdk:"arguments"`
+}
+
+type partnerAppMaintenanceConfigModel struct {
+	MaintenanceWindowStart types.String `tfsdk:"maintenance_window_start"`
+}
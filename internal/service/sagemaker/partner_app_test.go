@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsagemaker "github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSageMakerPartnerApp_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var partnerapp sagemaker.DescribePartnerAppOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_partner_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPartnerAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnerAppConfig_basic(rName, "small"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &partnerapp),
+					resource.TestCheckResourceAttr(resourceName, "application_config.#", "0"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "sagemaker", regexache.MustCompile(`partner-app/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "IAM"),
+					resource.TestCheckResourceAttrSet(resourceName, "base_url"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tier", "small"),
+					resource.TestCheckResourceAttr(resourceName, "type", "lakera-guard"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func TestAccSageMakerPartnerApp_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var partnerapp sagemaker.DescribePartnerAppOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_partner_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPartnerAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnerAppConfig_basic(rName, "small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &partnerapp),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsagemaker.ResourcePartnerApp, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerPartnerApp_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var partnerapp sagemaker.DescribePartnerAppOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_partner_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPartnerAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnerAppConfig_applicationConfig(rName, "small", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &partnerapp),
+					resource.TestCheckResourceAttr(resourceName, "application_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_config.0.admin_users.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enable_iam_session_based_identity", "false"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_config.0.maintenance_window_start", "Sun:01:00"),
+					resource.TestCheckResourceAttr(resourceName, "tier", "small"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				Config: testAccPartnerAppConfig_applicationConfig(rName, "medium", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &partnerapp),
+					resource.TestCheckResourceAttr(resourceName, "application_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enable_iam_session_based_identity", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tier", "medium"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPartnerAppDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sagemaker_partner_app" {
+				continue
+			}
+
+			_, err := tfsagemaker.FindPartnerAppByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SageMaker Partner App %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPartnerAppExists(ctx context.Context, n string, v *sagemaker.DescribePartnerAppOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerClient(ctx)
+
+		output, err := tfsagemaker.FindPartnerAppByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPartnerAppConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "sagemaker.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonSageMakerFullAccess"
+}
+`, rName)
+}
+
+func testAccPartnerAppConfig_basic(rName, tier string) string {
+	return acctest.ConfigCompose(testAccPartnerAppConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_partner_app" "test" {
+  name               = %[1]q
+  type               = "lakera-guard"
+  tier               = %[2]q
+  auth_type          = "IAM"
+  execution_role_arn = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tier))
+}
+
+func testAccPartnerAppConfig_applicationConfig(rName, tier string, enableIAMSessionBasedIdentity bool) string {
+	return acctest.ConfigCompose(testAccPartnerAppConfig_base(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_sagemaker_partner_app" "test" {
+  name               = %[1]q
+  type               = "lakera-guard"
+  tier               = %[2]q
+  auth_type          = "IAM"
+  execution_role_arn = aws_iam_role.test.arn
+
+  enable_iam_session_based_identity = %[3]t
+
+  application_config {
+    admin_users = [data.aws_caller_identity.current.user_id]
+  }
+
+  maintenance_config {
+    maintenance_window_start = "Sun:01:00"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tier, enableIAMSessionBasedIdentity))
+}
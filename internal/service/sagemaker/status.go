@@ -254,6 +254,22 @@ func StatusSpace(ctx context.Context, conn *sagemaker.SageMaker, domainId, name
 	}
 }
 
+func StatusInferenceComponent(ctx context.Context, conn *sagemaker.SageMaker, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindInferenceComponentByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.InferenceComponentStatus), nil
+	}
+}
+
 func StatusMonitoringSchedule(ctx context.Context, conn *sagemaker.SageMaker, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindMonitoringScheduleByName(ctx, conn, name)
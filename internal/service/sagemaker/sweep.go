@@ -60,12 +60,18 @@ func RegisterSweepers() {
 	resource.AddTestSweepers("aws_sagemaker_endpoint", &resource.Sweeper{
 		Name: "aws_sagemaker_endpoint",
 		Dependencies: []string{
+			"aws_sagemaker_inference_component",
 			"aws_sagemaker_model",
 			"aws_sagemaker_endpoint_configuration",
 		},
 		F: sweepEndpoints,
 	})
 
+	resource.AddTestSweepers("aws_sagemaker_inference_component", &resource.Sweeper{
+		Name: "aws_sagemaker_inference_component",
+		F:    sweepInferenceComponents,
+	})
+
 	resource.AddTestSweepers("aws_sagemaker_feature_group", &resource.Sweeper{
 		Name: "aws_sagemaker_feature_group",
 		F:    sweepFeatureGroups,
@@ -483,6 +489,44 @@ func sweepEndpoints(region string) error {
 	return nil
 }
 
+func sweepInferenceComponents(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.SageMakerConn(ctx)
+
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListInferenceComponentsPagesWithContext(ctx, &sagemaker.ListInferenceComponentsInput{}, func(page *sagemaker.ListInferenceComponentsOutput, lastPage bool) bool {
+		for _, component := range page.InferenceComponents {
+			r := ResourceInferenceComponent()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(component.InferenceComponentName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SageMaker Inference Component sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SageMaker Inference Components: %w", err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("sweeping SageMaker Inference Components: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
 func sweepFeatureGroups(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -42,6 +42,8 @@ const (
 	SpaceInServiceTimeout              = 10 * time.Minute
 	MonitoringScheduleScheduledTimeout = 2 * time.Minute
 	MonitoringScheduleStoppedTimeout   = 2 * time.Minute
+	InferenceComponentInServiceTimeout = 60 * time.Minute
+	InferenceComponentDeletedTimeout   = 30 * time.Minute
 )
 
 // WaitNotebookInstanceInService waits for a NotebookInstance to return InService
@@ -650,6 +652,48 @@ func WaitSpaceDeleted(ctx context.Context, conn *sagemaker.SageMaker, domainId,
 	return nil, err
 }
 
+func WaitInferenceComponentInService(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeInferenceComponentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{sagemaker.InferenceComponentStatusCreating, sagemaker.InferenceComponentStatusUpdating},
+		Target:  []string{sagemaker.InferenceComponentStatusInService},
+		Refresh: StatusInferenceComponent(ctx, conn, name),
+		Timeout: InferenceComponentInServiceTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeInferenceComponentOutput); ok {
+		if status, reason := aws.StringValue(output.InferenceComponentStatus), aws.StringValue(output.FailureReason); status == sagemaker.InferenceComponentStatusFailed && reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitInferenceComponentDeleted(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeInferenceComponentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{sagemaker.InferenceComponentStatusDeleting},
+		Target:  []string{},
+		Refresh: StatusInferenceComponent(ctx, conn, name),
+		Timeout: InferenceComponentDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeInferenceComponentOutput); ok {
+		if status, reason := aws.StringValue(output.InferenceComponentStatus), aws.StringValue(output.FailureReason); status == sagemaker.InferenceComponentStatusFailed && reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitMonitoringScheduleScheduled(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeMonitoringScheduleOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{sagemaker.ScheduleStatusPending},
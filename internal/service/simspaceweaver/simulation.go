@@ -0,0 +1,529 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package simspaceweaver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/simspaceweaver"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_simspaceweaver_simulation", name="Simulation")
+// @Tags(identifierAttribute="arn")
+func resourceSimulation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSimulationCreate,
+		ReadWithoutTimeout:   resourceSimulationRead,
+		UpdateWithoutTimeout: resourceSimulationUpdate,
+		DeleteWithoutTimeout: resourceSimulationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"clock_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(simspaceweaver.ClockTargetStatus_Values(), false),
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"execution_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"maximum_duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^\d{1,2}[mMhHdD]$`), "must be a number of minutes (m), hours (h) or days (d), for example 14D"),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"schema_s3_location": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				Elem:         s3LocationSchema(),
+				ExactlyOneOf: []string{"schema_s3_location", "snapshot_s3_location"},
+			},
+			"snapshot_s3_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     s3LocationSchema(),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func s3LocationSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"object_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+func resourceSimulationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SimSpaceWeaverConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &simspaceweaver.StartSimulationInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Name:        aws.String(name),
+		RoleArn:     aws.String(d.Get("role_arn").(string)),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("maximum_duration"); ok {
+		input.MaximumDuration = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("schema_s3_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SchemaS3Location = expandS3Location(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("snapshot_s3_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SnapshotS3Location = expandS3Location(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.StartSimulationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting SimSpace Weaver Simulation (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitSimulationStarted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SimSpace Weaver Simulation (%s) start: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("clock_status"); ok && v.(string) == simspaceweaver.ClockTargetStatusStarted {
+		if err := startClock(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceSimulationRead(ctx, d, meta)...)
+}
+
+func resourceSimulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SimSpaceWeaverConn(ctx)
+
+	simulation, err := findSimulationByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SimSpace Weaver Simulation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SimSpace Weaver Simulation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", simulation.Arn)
+	if clock := simulationClock(simulation); clock != nil {
+		d.Set("clock_status", clock.Status)
+	} else {
+		d.Set("clock_status", nil)
+	}
+	if simulation.CreationTime != nil {
+		d.Set("creation_time", aws.TimeValue(simulation.CreationTime).Format(time.RFC3339))
+	} else {
+		d.Set("creation_time", nil)
+	}
+	d.Set("description", simulation.Description)
+	d.Set("execution_id", simulation.ExecutionId)
+	d.Set("maximum_duration", simulation.MaximumDuration)
+	d.Set("name", simulation.Name)
+	d.Set("role_arn", simulation.RoleArn)
+	if err := d.Set("schema_s3_location", flattenS3Location(simulation.SchemaS3Location)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting schema_s3_location: %s", err)
+	}
+	if err := d.Set("snapshot_s3_location", flattenS3Location(simulation.SnapshotS3Location)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting snapshot_s3_location: %s", err)
+	}
+	d.Set("status", simulation.Status)
+
+	return diags
+}
+
+func resourceSimulationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SimSpaceWeaverConn(ctx)
+
+	if d.HasChange("clock_status") {
+		switch d.Get("clock_status").(string) {
+		case simspaceweaver.ClockTargetStatusStarted:
+			if err := startClock(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		case simspaceweaver.ClockTargetStatusStopped:
+			if err := stopClock(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceSimulationRead(ctx, d, meta)...)
+}
+
+func resourceSimulationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SimSpaceWeaverConn(ctx)
+
+	simulation, err := findSimulationByName(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SimSpace Weaver Simulation (%s): %s", d.Id(), err)
+	}
+
+	// A running simulation must be stopped before it can be deleted.
+	switch aws.StringValue(simulation.Status) {
+	case simspaceweaver.SimulationStatusStarting, simspaceweaver.SimulationStatusStarted, simspaceweaver.SimulationStatusSnapshotInProgress:
+		log.Printf("[DEBUG] Stopping SimSpace Weaver Simulation: %s", d.Id())
+		_, err := conn.StopSimulationWithContext(ctx, &simspaceweaver.StopSimulationInput{
+			Simulation: aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrCodeEquals(err, simspaceweaver.ErrCodeResourceNotFoundException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "stopping SimSpace Weaver Simulation (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitSimulationStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SimSpace Weaver Simulation (%s) stop: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting SimSpace Weaver Simulation: %s", d.Id())
+	_, err = conn.DeleteSimulationWithContext(ctx, &simspaceweaver.DeleteSimulationInput{
+		Simulation: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, simspaceweaver.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SimSpace Weaver Simulation (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitSimulationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SimSpace Weaver Simulation (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func startClock(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string, timeout time.Duration) error {
+	_, err := conn.StartClockWithContext(ctx, &simspaceweaver.StartClockInput{
+		Simulation: aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("starting SimSpace Weaver Simulation (%s) clock: %w", name, err)
+	}
+
+	if _, err := waitSimulationClockStarted(ctx, conn, name, timeout); err != nil {
+		return fmt.Errorf("waiting for SimSpace Weaver Simulation (%s) clock start: %w", name, err)
+	}
+
+	return nil
+}
+
+func stopClock(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string, timeout time.Duration) error {
+	_, err := conn.StopClockWithContext(ctx, &simspaceweaver.StopClockInput{
+		Simulation: aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("stopping SimSpace Weaver Simulation (%s) clock: %w", name, err)
+	}
+
+	if _, err := waitSimulationClockStopped(ctx, conn, name, timeout); err != nil {
+		return fmt.Errorf("waiting for SimSpace Weaver Simulation (%s) clock stop: %w", name, err)
+	}
+
+	return nil
+}
+
+func findSimulationByName(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string) (*simspaceweaver.DescribeSimulationOutput, error) {
+	input := &simspaceweaver.DescribeSimulationInput{
+		Simulation: aws.String(name),
+	}
+
+	output, err := conn.DescribeSimulationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, simspaceweaver.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Status); status == simspaceweaver.SimulationStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func simulationClock(simulation *simspaceweaver.DescribeSimulationOutput) *simspaceweaver.SimulationClock {
+	if simulation.LiveSimulationState == nil || len(simulation.LiveSimulationState.Clocks) == 0 {
+		return nil
+	}
+
+	return simulation.LiveSimulationState.Clocks[0]
+}
+
+func statusSimulation(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSimulationByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusSimulationClock(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSimulationByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		clock := simulationClock(output)
+
+		if clock == nil {
+			return output, simspaceweaver.ClockStatusUnknown, nil
+		}
+
+		return output, aws.StringValue(clock.Status), nil
+	}
+}
+
+func waitSimulationStarted(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string, timeout time.Duration) (*simspaceweaver.DescribeSimulationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{simspaceweaver.SimulationStatusUnknown, simspaceweaver.SimulationStatusStarting},
+		Target:  []string{simspaceweaver.SimulationStatusStarted},
+		Refresh: statusSimulation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*simspaceweaver.DescribeSimulationOutput); ok {
+		if status := aws.StringValue(output.Status); status == simspaceweaver.SimulationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StartError)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSimulationStopped(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string, timeout time.Duration) (*simspaceweaver.DescribeSimulationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{simspaceweaver.SimulationStatusStarting, simspaceweaver.SimulationStatusStarted, simspaceweaver.SimulationStatusSnapshotInProgress, simspaceweaver.SimulationStatusStopping},
+		Target:  []string{simspaceweaver.SimulationStatusStopped, simspaceweaver.SimulationStatusFailed},
+		Refresh: statusSimulation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*simspaceweaver.DescribeSimulationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSimulationDeleted(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string, timeout time.Duration) (*simspaceweaver.DescribeSimulationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{simspaceweaver.SimulationStatusStopped, simspaceweaver.SimulationStatusFailed, simspaceweaver.SimulationStatusDeleting},
+		Target:  []string{},
+		Refresh: statusSimulation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*simspaceweaver.DescribeSimulationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSimulationClockStarted(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string, timeout time.Duration) (*simspaceweaver.DescribeSimulationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{simspaceweaver.ClockStatusUnknown, simspaceweaver.ClockStatusStopped, simspaceweaver.ClockStatusStarting},
+		Target:  []string{simspaceweaver.ClockStatusStarted},
+		Refresh: statusSimulationClock(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*simspaceweaver.DescribeSimulationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSimulationClockStopped(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string, timeout time.Duration) (*simspaceweaver.DescribeSimulationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{simspaceweaver.ClockStatusUnknown, simspaceweaver.ClockStatusStarted, simspaceweaver.ClockStatusStopping},
+		Target:  []string{simspaceweaver.ClockStatusStopped},
+		Refresh: statusSimulationClock(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*simspaceweaver.DescribeSimulationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandS3Location(tfMap map[string]interface{}) *simspaceweaver.S3Location {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &simspaceweaver.S3Location{
+		BucketName: aws.String(tfMap["bucket_name"].(string)),
+		ObjectKey:  aws.String(tfMap["object_key"].(string)),
+	}
+}
+
+func flattenS3Location(apiObject *simspaceweaver.S3Location) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_name": aws.StringValue(apiObject.BucketName),
+		"object_key":  aws.StringValue(apiObject.ObjectKey),
+	}
+
+	return []interface{}{tfMap}
+}
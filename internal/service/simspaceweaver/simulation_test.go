@@ -0,0 +1,327 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package simspaceweaver_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/simspaceweaver"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsimspaceweaver "github.com/hashicorp/terraform-provider-aws/internal/service/simspaceweaver"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSimSpaceWeaverSimulation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v simspaceweaver.DescribeSimulationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_simspaceweaver_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SimSpaceWeaverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSimulationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSimulationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSimulationExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "simspaceweaver", regexache.MustCompile(`simulation/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "clock_status", "STOPPED"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "execution_id"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_duration", "2H"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "schema_s3_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "schema_s3_location.0.bucket_name", "aws_s3_object.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "schema_s3_location.0.object_key", "aws_s3_object.test", "key"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_s3_location.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", "STARTED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSimSpaceWeaverSimulation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v simspaceweaver.DescribeSimulationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_simspaceweaver_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SimSpaceWeaverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSimulationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSimulationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSimulationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsimspaceweaver.ResourceSimulation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSimSpaceWeaverSimulation_clockStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v simspaceweaver.DescribeSimulationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_simspaceweaver_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SimSpaceWeaverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSimulationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSimulationConfig_clockStatus(rName, "STARTED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSimulationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "clock_status", "STARTED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSimulationConfig_clockStatus(rName, "STOPPED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSimulationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "clock_status", "STOPPED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSimSpaceWeaverSimulation_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v simspaceweaver.DescribeSimulationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_simspaceweaver_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SimSpaceWeaverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSimulationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSimulationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSimulationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSimulationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSimulationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSimulationConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSimulationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSimulationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SimSpaceWeaverConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_simspaceweaver_simulation" {
+				continue
+			}
+
+			_, err := tfsimspaceweaver.FindSimulationByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SimSpace Weaver Simulation %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSimulationExists(ctx context.Context, n string, v *simspaceweaver.DescribeSimulationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SimSpaceWeaverConn(ctx)
+
+		output, err := tfsimspaceweaver.FindSimulationByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSimulationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "schema.yaml"
+
+  content = <<-EOT
+sdk_version: "1.17"
+simulation_properties:
+  default_entity_index_key_type: "Vector3<f32>"
+  default_image: "${aws_s3_bucket.test.bucket}/image.zip"
+domains:
+  MyViewDomain:
+    launch_apps_via_start_app_call: {}
+    app_config:
+      package: "s3://${aws_s3_bucket.test.bucket}/app.zip"
+      launch_command: ["app"]
+      required_resource_units:
+        compute: 1
+EOT
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "simspaceweaver.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetObject",
+        "s3:ListBucket",
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+        "cloudwatch:PutMetricData",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccSimulationConfig_simulation(rName, extra string) string {
+	return acctest.ConfigCompose(testAccSimulationConfig_base(rName), fmt.Sprintf(`
+resource "aws_simspaceweaver_simulation" "test" {
+  name             = %[1]q
+  role_arn         = aws_iam_role.test.arn
+  maximum_duration = "2H"
+
+  schema_s3_location {
+    bucket_name = aws_s3_object.test.bucket
+    object_key  = aws_s3_object.test.key
+  }
+
+%[2]s
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, extra))
+}
+
+func testAccSimulationConfig_basic(rName string) string {
+	return testAccSimulationConfig_simulation(rName, "")
+}
+
+func testAccSimulationConfig_clockStatus(rName, clockStatus string) string {
+	return testAccSimulationConfig_simulation(rName, fmt.Sprintf(`
+  clock_status = %[1]q
+`, clockStatus))
+}
+
+func testAccSimulationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return testAccSimulationConfig_simulation(rName, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1))
+}
+
+func testAccSimulationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccSimulationConfig_simulation(rName, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
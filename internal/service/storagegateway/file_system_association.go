@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -65,6 +66,26 @@ func ResourceFileSystemAssociation() *schema.Resource {
 				},
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 			},
+			"endpoint_network_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_addresses": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsIPv4Address,
+							},
+						},
+					},
+				},
+			},
 			"gateway_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -122,6 +143,10 @@ func resourceFileSystemAssociationCreate(ctx context.Context, d *schema.Resource
 		input.CacheAttributes = expandFileSystemAssociationCacheAttributes(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("endpoint_network_configuration"); ok {
+		input.EndpointNetworkConfiguration = expandFileSystemAssociationEndpointNetworkConfiguration(v.([]interface{}))
+	}
+
 	output, err := conn.AssociateFileSystemWithContext(ctx, input)
 
 	if err != nil {
@@ -155,6 +180,9 @@ func resourceFileSystemAssociationRead(ctx context.Context, d *schema.ResourceDa
 
 	d.Set("arn", filesystem.FileSystemAssociationARN)
 	d.Set("audit_destination_arn", filesystem.AuditDestinationARN)
+	if err := d.Set("endpoint_network_configuration", flattenFileSystemAssociationEndpointNetworkConfiguration(filesystem.EndpointNetworkConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting endpoint_network_configuration: %s", err)
+	}
 	d.Set("gateway_arn", filesystem.GatewayARN)
 	d.Set("location_arn", filesystem.LocationARN)
 
@@ -171,7 +199,7 @@ func resourceFileSystemAssociationUpdate(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).StorageGatewayConn(ctx)
 
-	if d.HasChangesExcept("tags_all") {
+	if d.HasChangesExcept("tags", "tags_all") {
 		input := &storagegateway.UpdateFileSystemAssociationInput{
 			AuditDestinationARN:      aws.String(d.Get("audit_destination_arn").(string)),
 			Password:                 aws.String(d.Get("password").(string)),
@@ -248,3 +276,29 @@ func flattenFileSystemAssociationCacheAttributes(ca *storagegateway.CacheAttribu
 
 	return []interface{}{m}
 }
+
+func expandFileSystemAssociationEndpointNetworkConfiguration(l []interface{}) *storagegateway.EndpointNetworkConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	enc := &storagegateway.EndpointNetworkConfiguration{
+		IpAddresses: flex.ExpandStringList(m["ip_addresses"].([]interface{})),
+	}
+
+	return enc
+}
+
+func flattenFileSystemAssociationEndpointNetworkConfiguration(enc *storagegateway.EndpointNetworkConfiguration) []interface{} {
+	if enc == nil || len(enc.IpAddresses) == 0 {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"ip_addresses": aws.StringValueSlice(enc.IpAddresses),
+	}
+
+	return []interface{}{m}
+}
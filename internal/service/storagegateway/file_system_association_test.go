@@ -195,6 +195,39 @@ func TestAccStorageGatewayFileSystemAssociation_auditDestination(t *testing.T) {
 	})
 }
 
+func TestAccStorageGatewayFileSystemAssociation_endpointNetworkConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fileSystemAssociation storagegateway.FileSystemAssociationInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_file_system_association.test"
+	domainName := acctest.RandomDomainName()
+	username := "Admin"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, storagegateway.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFileSystemAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFileSystemAssociationConfig_endpointNetworkConfiguration(rName, domainName, username),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFileSystemAssociationExists(ctx, resourceName, &fileSystemAssociation),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_network_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_network_configuration.0.ip_addresses.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_network_configuration.0.ip_addresses.0", "aws_instance.test", "private_ip"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"username", "password"},
+			},
+		},
+	})
+}
+
 func TestAccStorageGatewayFileSystemAssociation_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fileSystemAssociation storagegateway.FileSystemAssociationInfo
@@ -445,3 +478,18 @@ resource "aws_storagegateway_file_system_association" "test" {
 }
 `, username, cache)
 }
+
+func testAccFileSystemAssociationConfig_endpointNetworkConfiguration(rName, domainName, username string) string {
+	return testAccFileSystemAssociationBase(rName, domainName, username) + fmt.Sprintf(`
+resource "aws_storagegateway_file_system_association" "test" {
+  gateway_arn  = aws_storagegateway_gateway.test.arn
+  location_arn = aws_fsx_windows_file_system.test.arn
+  username     = %[1]q
+  password     = aws_directory_service_directory.test.password
+
+  endpoint_network_configuration {
+    ip_addresses = [aws_instance.test.private_ip]
+  }
+}
+`, username)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_timestreaminfluxdb_db_instance", &resource.Sweeper{
+		Name: "aws_timestreaminfluxdb_db_instance",
+		F:    sweepDBInstances,
+	})
+}
+
+func sweepDBInstances(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.TimestreamInfluxDBClient(ctx)
+	input := &timestreaminfluxdb.ListDbInstancesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := timestreaminfluxdb.NewListDbInstancesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Timestream for InfluxDB DB Instance sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("listing Timestream for InfluxDB DB Instances (%s): %w", region, err)
+		}
+
+		for _, v := range page.Items {
+			r := resourceDBInstance()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping Timestream for InfluxDB DB Instances (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,434 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_translate_parallel_data", name="Parallel Data")
+// @Tags(identifierAttribute="arn")
+func resourceParallelData() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParallelDataCreate,
+		ReadWithoutTimeout:   resourceParallelDataRead,
+		UpdateWithoutTimeout: resourceParallelDataUpdate,
+		DeleteWithoutTimeout: resourceParallelDataDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"encryption_key": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      translate.EncryptionKeyTypeKms,
+							ValidateFunc: validation.StringInSlice(translate.EncryptionKeyType_Values(), false),
+						},
+					},
+				},
+			},
+			"failed_record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"imported_data_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"imported_record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexache.MustCompile(`^([0-9A-Za-z_-]+)$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"parallel_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(translate.ParallelDataFormat_Values(), false),
+						},
+						"s3_uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^s3://[a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9](/.*)?$`), "must be an S3 URI"),
+						},
+					},
+				},
+			},
+			"skipped_record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_language_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_language_codes": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceParallelDataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &translate.CreateParallelDataInput{
+		ClientToken:        aws.String(id.UniqueId()),
+		Name:               aws.String(name),
+		ParallelDataConfig: expandParallelDataConfig(d.Get("parallel_data_config").([]interface{})[0].(map[string]interface{})),
+		Tags:               getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_key"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncryptionKey = expandEncryptionKey(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreateParallelDataWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Translate Parallel Data (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitParallelDataCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Translate Parallel Data (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceParallelDataRead(ctx, d, meta)...)
+}
+
+func resourceParallelDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn(ctx)
+
+	output, err := findParallelDataByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Translate Parallel Data (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Translate Parallel Data (%s): %s", d.Id(), err)
+	}
+
+	properties := output.ParallelDataProperties
+	d.Set("arn", properties.Arn)
+	d.Set("description", properties.Description)
+	if err := d.Set("encryption_key", flattenEncryptionKey(properties.EncryptionKey)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting encryption_key: %s", err)
+	}
+	d.Set("failed_record_count", properties.FailedRecordCount)
+	d.Set("imported_data_size", properties.ImportedDataSize)
+	d.Set("imported_record_count", properties.ImportedRecordCount)
+	d.Set("name", properties.Name)
+	if err := d.Set("parallel_data_config", flattenParallelDataConfig(properties.ParallelDataConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parallel_data_config: %s", err)
+	}
+	d.Set("skipped_record_count", properties.SkippedRecordCount)
+	d.Set("source_language_code", properties.SourceLanguageCode)
+	d.Set("status", properties.Status)
+	d.Set("target_language_codes", aws.StringValueSlice(properties.TargetLanguageCodes))
+
+	return diags
+}
+
+func resourceParallelDataUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn(ctx)
+
+	// A change to source_hash signals that the S3 content has changed and must be re-imported.
+	if d.HasChanges("description", "parallel_data_config", "source_hash") {
+		input := &translate.UpdateParallelDataInput{
+			ClientToken:        aws.String(id.UniqueId()),
+			Description:        aws.String(d.Get("description").(string)),
+			Name:               aws.String(d.Id()),
+			ParallelDataConfig: expandParallelDataConfig(d.Get("parallel_data_config").([]interface{})[0].(map[string]interface{})),
+		}
+
+		_, err := conn.UpdateParallelDataWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Translate Parallel Data (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitParallelDataUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Translate Parallel Data (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceParallelDataRead(ctx, d, meta)...)
+}
+
+func resourceParallelDataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Translate Parallel Data: %s", d.Id())
+	_, err := conn.DeleteParallelDataWithContext(ctx, &translate.DeleteParallelDataInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, translate.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Translate Parallel Data (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitParallelDataDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Translate Parallel Data (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findParallelDataByName(ctx context.Context, conn *translate.Translate, name string) (*translate.GetParallelDataOutput, error) {
+	input := &translate.GetParallelDataInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetParallelDataWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, translate.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ParallelDataProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusParallelData(ctx context.Context, conn *translate.Translate, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findParallelDataByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ParallelDataProperties.Status), nil
+	}
+}
+
+func statusParallelDataLatestUpdateAttempt(ctx context.Context, conn *translate.Translate, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findParallelDataByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ParallelDataProperties.LatestUpdateAttemptStatus), nil
+	}
+}
+
+func waitParallelDataCreated(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.GetParallelDataOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusCreating},
+		Target:  []string{translate.ParallelDataStatusActive},
+		Refresh: statusParallelData(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.GetParallelDataOutput); ok {
+		if status := aws.StringValue(output.ParallelDataProperties.Status); status == translate.ParallelDataStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ParallelDataProperties.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitParallelDataUpdated(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.GetParallelDataOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusUpdating},
+		Target:  []string{translate.ParallelDataStatusActive},
+		Refresh: statusParallelDataLatestUpdateAttempt(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.GetParallelDataOutput); ok {
+		if status := aws.StringValue(output.ParallelDataProperties.LatestUpdateAttemptStatus); status == translate.ParallelDataStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ParallelDataProperties.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitParallelDataDeleted(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.GetParallelDataOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusActive, translate.ParallelDataStatusFailed, translate.ParallelDataStatusDeleting},
+		Target:  []string{},
+		Refresh: statusParallelData(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.GetParallelDataOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandParallelDataConfig(tfMap map[string]interface{}) *translate.ParallelDataConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &translate.ParallelDataConfig{
+		Format: aws.String(tfMap["format"].(string)),
+		S3Uri:  aws.String(tfMap["s3_uri"].(string)),
+	}
+}
+
+func flattenParallelDataConfig(apiObject *translate.ParallelDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"format": aws.StringValue(apiObject.Format),
+		"s3_uri": aws.StringValue(apiObject.S3Uri),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandEncryptionKey(tfMap map[string]interface{}) *translate.EncryptionKey {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &translate.EncryptionKey{
+		Id:   aws.String(tfMap["id"].(string)),
+		Type: aws.String(tfMap["type"].(string)),
+	}
+}
+
+func flattenEncryptionKey(apiObject *translate.EncryptionKey) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"id":   aws.StringValue(apiObject.Id),
+		"type": aws.StringValue(apiObject.Type),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/translate"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranslate "github.com/hashicorp/terraform-provider-aws/internal/service/translate"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTranslateParallelData_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v translate.GetParallelDataOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_parallel_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName, "en,fr\nhello,bonjour\n", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "translate", regexache.MustCompile(`parallel-data/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "imported_record_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.0.format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "source_language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "target_language_codes.*", "fr"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_hash"},
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v translate.GetParallelDataOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_parallel_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName, "en,fr\nhello,bonjour\n", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftranslate.ResourceParallelData(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_sourceHash(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v translate.GetParallelDataOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_parallel_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName, "en,fr\nhello,bonjour\n", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "imported_record_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_hash", "v1"),
+				),
+			},
+			{
+				Config: testAccParallelDataConfig_basic(rName, "en,fr\nhello,bonjour\ngoodbye,au revoir\n", "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "imported_record_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "source_hash", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v translate.GetParallelDataOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_parallel_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_hash"},
+			},
+			{
+				Config: testAccParallelDataConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccParallelDataConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParallelDataDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_translate_parallel_data" {
+				continue
+			}
+
+			_, err := tftranslate.FindParallelDataByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Translate Parallel Data %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParallelDataExists(ctx context.Context, n string, v *translate.GetParallelDataOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateConn(ctx)
+
+		output, err := tftranslate.FindParallelDataByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccParallelDataConfig_base(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "parallel-data.csv"
+  content = %[2]q
+}
+`, rName, content)
+}
+
+func testAccParallelDataConfig_basic(rName, content, sourceHash string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName, content), fmt.Sprintf(`
+resource "aws_translate_parallel_data" "test" {
+  name        = %[1]q
+  source_hash = %[2]q
+
+  parallel_data_config {
+    format = "CSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+}
+`, rName, sourceHash))
+}
+
+func testAccParallelDataConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName, "en,fr\nhello,bonjour\n"), fmt.Sprintf(`
+resource "aws_translate_parallel_data" "test" {
+  name = %[1]q
+
+  parallel_data_config {
+    format = "CSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccParallelDataConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName, "en,fr\nhello,bonjour\n"), fmt.Sprintf(`
+resource "aws_translate_parallel_data" "test" {
+  name = %[1]q
+
+  parallel_data_config {
+    format = "CSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -50,3 +50,12 @@ type ServicePackageSDKResource struct {
 	Name     string
 	Tags     *ServicePackageResourceTags
 }
+
+// ServicePackageResourceHint represents a warning, with migration guidance, about a resource attribute.
+type ServicePackageResourceHint struct {
+	// Attribute is the name of the hinted top-level attribute or block.
+	// The hint is emitted when an update changes the attribute's value.
+	Attribute string
+	Summary   string
+	Detail    string
+}
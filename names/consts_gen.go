@@ -28,6 +28,7 @@ const (
 	Batch                        = "batch"
 	Bedrock                      = "bedrock"
 	BedrockAgent                 = "bedrockagent"
+	Braket                       = "braket"
 	Budgets                      = "budgets"
 	CE                           = "ce"
 	CUR                          = "cur"
@@ -142,6 +143,7 @@ const (
 	MQ                           = "mq"
 	MWAA                         = "mwaa"
 	Macie2                       = "macie2"
+	ManagedBlockchain            = "managedblockchain"
 	MediaConnect                 = "mediaconnect"
 	MediaConvert                 = "mediaconvert"
 	MediaLive                    = "medialive"
@@ -150,6 +152,7 @@ const (
 	MediaStore                   = "mediastore"
 	MemoryDB                     = "memorydb"
 	Neptune                      = "neptune"
+	NeptuneGraph                 = "neptunegraph"
 	NetworkFirewall              = "networkfirewall"
 	NetworkManager               = "networkmanager"
 	ObservabilityAccessManager   = "oam"
@@ -213,12 +216,15 @@ const (
 	ServiceQuotas                = "servicequotas"
 	Shield                       = "shield"
 	Signer                       = "signer"
+	SimSpaceWeaver               = "simspaceweaver"
 	SimpleDB                     = "simpledb"
 	StorageGateway               = "storagegateway"
 	Synthetics                   = "synthetics"
+	TimestreamInfluxDB           = "timestreaminfluxdb"
 	TimestreamWrite              = "timestreamwrite"
 	Transcribe                   = "transcribe"
 	Transfer                     = "transfer"
+	Translate                    = "translate"
 	VPCLattice                   = "vpclattice"
 	VerifiedPermissions          = "verifiedpermissions"
 	WAF                          = "waf"
@@ -259,6 +265,7 @@ const (
 	BatchServiceID                        = "Batch"
 	BedrockServiceID                      = "Bedrock"
 	BedrockAgentServiceID                 = "Bedrock Agent"
+	BraketServiceID                       = "Braket"
 	BudgetsServiceID                      = "Budgets"
 	CEServiceID                           = "Cost Explorer"
 	CURServiceID                          = "Cost and Usage Report Service"
@@ -373,6 +380,7 @@ const (
 	MQServiceID                           = "mq"
 	MWAAServiceID                         = "MWAA"
 	Macie2ServiceID                       = "Macie2"
+	ManagedBlockchainServiceID            = "ManagedBlockchain"
 	MediaConnectServiceID                 = "MediaConnect"
 	MediaConvertServiceID                 = "MediaConvert"
 	MediaLiveServiceID                    = "MediaLive"
@@ -381,6 +389,7 @@ const (
 	MediaStoreServiceID                   = "MediaStore"
 	MemoryDBServiceID                     = "MemoryDB"
 	NeptuneServiceID                      = "Neptune"
+	NeptuneGraphServiceID                 = "Neptune Graph"
 	NetworkFirewallServiceID              = "Network Firewall"
 	NetworkManagerServiceID               = "NetworkManager"
 	ObservabilityAccessManagerServiceID   = "OAM"
@@ -444,12 +453,15 @@ const (
 	ServiceQuotasServiceID                = "Service Quotas"
 	ShieldServiceID                       = "Shield"
 	SignerServiceID                       = "signer"
+	SimSpaceWeaverServiceID               = "SimSpaceWeaver"
 	SimpleDBServiceID                     = "SimpleDB"
 	StorageGatewayServiceID               = "Storage Gateway"
 	SyntheticsServiceID                   = "synthetics"
+	TimestreamInfluxDBServiceID           = "Timestream InfluxDB"
 	TimestreamWriteServiceID              = "Timestream Write"
 	TranscribeServiceID                   = "Transcribe"
 	TransferServiceID                     = "Transfer"
+	TranslateServiceID                    = "Translate"
 	VPCLatticeServiceID                   = "VPC Lattice"
 	VerifiedPermissionsServiceID          = "VerifiedPermissions"
 	WAFServiceID                          = "WAF"